@@ -55,6 +55,21 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the
+	// end of a past block. It requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error)
+	// SupplyAt queries the supply of a single coin at the end of a past block. It
+	// requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	SupplyAt(ctx context.Context, in *QuerySupplyAtRequest, opts ...grpc.CallOption) (*QuerySupplyAtResponse, error)
+	// SupplyHistory queries the changes of the supply of a single coin within a
+	// window of heights. It requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	SupplyHistory(ctx context.Context, in *QuerySupplyHistoryRequest, opts ...grpc.CallOption) (*QuerySupplyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -155,6 +170,33 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error) {
+	out := new(QueryBalanceAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/BalanceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyAt(ctx context.Context, in *QuerySupplyAtRequest, opts ...grpc.CallOption) (*QuerySupplyAtResponse, error) {
+	out := new(QuerySupplyAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHistory(ctx context.Context, in *QuerySupplyHistoryRequest, opts ...grpc.CallOption) (*QuerySupplyHistoryResponse, error) {
+	out := new(QuerySupplyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -192,6 +234,21 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the
+	// end of a past block. It requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error)
+	// SupplyAt queries the supply of a single coin at the end of a past block. It
+	// requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	SupplyAt(context.Context, *QuerySupplyAtRequest) (*QuerySupplyAtResponse, error)
+	// SupplyHistory queries the changes of the supply of a single coin within a
+	// window of heights. It requires the app to track the balance history.
+	//
+	// Since: cosmos-sdk 0.47
+	SupplyHistory(context.Context, *QuerySupplyHistoryRequest) (*QuerySupplyHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -229,6 +286,15 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAt not implemented")
+}
+func (UnimplementedQueryServer) SupplyAt(context.Context, *QuerySupplyAtRequest) (*QuerySupplyAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyAt not implemented")
+}
+func (UnimplementedQueryServer) SupplyHistory(context.Context, *QuerySupplyHistoryRequest) (*QuerySupplyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -422,6 +488,60 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/BalanceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAt(ctx, req.(*QueryBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SupplyAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyAt(ctx, req.(*QuerySupplyAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SupplyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHistory(ctx, req.(*QuerySupplyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -469,6 +589,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BalanceAt",
+			Handler:    _Query_BalanceAt_Handler,
+		},
+		{
+			MethodName: "SupplyAt",
+			Handler:    _Query_SupplyAt_Handler,
+		},
+		{
+			MethodName: "SupplyHistory",
+			Handler:    _Query_SupplyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
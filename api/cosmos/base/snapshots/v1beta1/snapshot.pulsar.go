@@ -704,15 +704,74 @@ func (x *_Metadata_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Metadata_3_list)(nil)
+
+type _Metadata_3_list struct {
+	list *[]*SnapshotLink
+}
+
+func (x *_Metadata_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Metadata_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotLink)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotLink)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_3_list) AppendMutable() protoreflect.Value {
+	v := new(SnapshotLink)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_3_list) NewElement() protoreflect.Value {
+	v := new(SnapshotLink)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_base_height  protoreflect.FieldDescriptor
+	fd_Metadata_chain        protoreflect.FieldDescriptor
+	fd_Metadata_signer       protoreflect.FieldDescriptor
+	fd_Metadata_signature    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
 	md_Metadata = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_base_height = md_Metadata.Fields().ByName("base_height")
+	fd_Metadata_chain = md_Metadata.Fields().ByName("chain")
+	fd_Metadata_signer = md_Metadata.Fields().ByName("signer")
+	fd_Metadata_signature = md_Metadata.Fields().ByName("signature")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -786,6 +845,30 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.BaseHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseHeight)
+		if !f(fd_Metadata_base_height, value) {
+			return
+		}
+	}
+	if len(x.Chain) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_3_list{list: &x.Chain})
+		if !f(fd_Metadata_chain, value) {
+			return
+		}
+	}
+	if len(x.Signer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signer)
+		if !f(fd_Metadata_signer, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_Metadata_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -803,6 +886,14 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		return x.BaseHeight != uint64(0)
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		return len(x.Chain) != 0
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		return len(x.Signer) != 0
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		return len(x.Signature) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -821,6 +912,14 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		x.BaseHeight = uint64(0)
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		x.Chain = nil
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		x.Signer = nil
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		x.Signature = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -843,6 +942,21 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		value := x.BaseHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		if len(x.Chain) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_3_list{})
+		}
+		listValue := &_Metadata_3_list{list: &x.Chain}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		value := x.Signer
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -867,6 +981,16 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		x.BaseHeight = value.Uint()
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		lv := value.List()
+		clv := lv.(*_Metadata_3_list)
+		x.Chain = *clv.list
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		x.Signer = value.Bytes()
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		x.Signature = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -893,6 +1017,18 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		if x.Chain == nil {
+			x.Chain = []*SnapshotLink{}
+		}
+		value := &_Metadata_3_list{list: &x.Chain}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		panic(fmt.Errorf("field base_height of message cosmos.base.snapshots.v1beta1.Metadata is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		panic(fmt.Errorf("field signer of message cosmos.base.snapshots.v1beta1.Metadata is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		panic(fmt.Errorf("field signature of message cosmos.base.snapshots.v1beta1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -909,6 +1045,15 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.base.snapshots.v1beta1.Metadata.base_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.snapshots.v1beta1.Metadata.chain":
+		list := []*SnapshotLink{}
+		return protoreflect.ValueOfList(&_Metadata_3_list{list: &list})
+	case "cosmos.base.snapshots.v1beta1.Metadata.signer":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.snapshots.v1beta1.Metadata.signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -984,6 +1129,23 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.BaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseHeight))
+		}
+		if len(x.Chain) > 0 {
+			for _, e := range x.Chain {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1013,6 +1175,41 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Chain) > 0 {
+			for iNdEx := len(x.Chain) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Chain[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.BaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseHeight))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.ChunkHashes) > 0 {
 			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ChunkHashes[iNdEx])
@@ -1103,6 +1300,127 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
 				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+				}
+				x.BaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = append(x.Chain, &SnapshotLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Chain[len(x.Chain)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = append(x.Signer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signer == nil {
+					x.Signer = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1139,35 +1457,31 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotItem                   protoreflect.MessageDescriptor
-	fd_SnapshotItem_store             protoreflect.FieldDescriptor
-	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
-	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
-	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
-	fd_SnapshotItem_kv                protoreflect.FieldDescriptor
-	fd_SnapshotItem_schema            protoreflect.FieldDescriptor
+	md_SnapshotLink        protoreflect.MessageDescriptor
+	fd_SnapshotLink_height protoreflect.FieldDescriptor
+	fd_SnapshotLink_format protoreflect.FieldDescriptor
+	fd_SnapshotLink_chunks protoreflect.FieldDescriptor
+	fd_SnapshotLink_hash   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
-	md_SnapshotItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotItem")
-	fd_SnapshotItem_store = md_SnapshotItem.Fields().ByName("store")
-	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
-	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
-	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
-	fd_SnapshotItem_kv = md_SnapshotItem.Fields().ByName("kv")
-	fd_SnapshotItem_schema = md_SnapshotItem.Fields().ByName("schema")
+	md_SnapshotLink = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotLink")
+	fd_SnapshotLink_height = md_SnapshotLink.Fields().ByName("height")
+	fd_SnapshotLink_format = md_SnapshotLink.Fields().ByName("format")
+	fd_SnapshotLink_chunks = md_SnapshotLink.Fields().ByName("chunks")
+	fd_SnapshotLink_hash = md_SnapshotLink.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotLink)(nil)
 
-type fastReflection_SnapshotItem SnapshotItem
+type fastReflection_SnapshotLink SnapshotLink
 
-func (x *SnapshotItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotItem)(x)
+func (x *SnapshotLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotLink)(x)
 }
 
-func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
+func (x *SnapshotLink) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,43 +1493,43 @@ func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotItem_messageType fastReflection_SnapshotItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotItem_messageType{}
+var _fastReflection_SnapshotLink_messageType fastReflection_SnapshotLink_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotLink_messageType{}
 
-type fastReflection_SnapshotItem_messageType struct{}
+type fastReflection_SnapshotLink_messageType struct{}
 
-func (x fastReflection_SnapshotItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotItem)(nil)
+func (x fastReflection_SnapshotLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotLink)(nil)
 }
-func (x fastReflection_SnapshotItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotItem)
+func (x fastReflection_SnapshotLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotLink)
 }
-func (x fastReflection_SnapshotItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotItem
+func (x fastReflection_SnapshotLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotLink
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotItem
+func (x *fastReflection_SnapshotLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotLink
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotItem_messageType
+func (x *fastReflection_SnapshotLink) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotLink_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotItem)
+func (x *fastReflection_SnapshotLink) New() protoreflect.Message {
+	return new(fastReflection_SnapshotLink)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotItem)(x)
+func (x *fastReflection_SnapshotLink) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotLink)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1223,45 +1537,29 @@ func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Item != nil {
-		switch o := x.Item.(type) {
-		case *SnapshotItem_Store:
-			v := o.Store
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_store, value) {
-				return
-			}
-		case *SnapshotItem_Iavl:
-			v := o.Iavl
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_iavl, value) {
-				return
-			}
-		case *SnapshotItem_Extension:
-			v := o.Extension
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_extension, value) {
-				return
-			}
-		case *SnapshotItem_ExtensionPayload:
-			v := o.ExtensionPayload
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_extension_payload, value) {
-				return
-			}
-		case *SnapshotItem_Kv:
-			v := o.Kv
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_kv, value) {
-				return
-			}
-		case *SnapshotItem_Schema:
-			v := o.Schema
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_SnapshotItem_schema, value) {
-				return
-			}
+func (x *fastReflection_SnapshotLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_SnapshotLink_height, value) {
+			return
+		}
+	}
+	if x.Format != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Format)
+		if !f(fd_SnapshotLink_format, value) {
+			return
+		}
+	}
+	if x.Chunks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Chunks)
+		if !f(fd_SnapshotLink_chunks, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SnapshotLink_hash, value) {
+			return
 		}
 	}
 }
@@ -1277,61 +1575,21 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotLink) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Store); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Iavl); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Extension); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Kv); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		if x.Item == nil {
-			return false
-		} else if _, ok := x.Item.(*SnapshotItem_Schema); ok {
-			return true
-		} else {
-			return false
-		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		return x.Height != uint64(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		return x.Format != uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		return x.Chunks != uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		return len(x.Hash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1341,25 +1599,21 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotLink) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		x.Item = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		x.Item = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		x.Item = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		x.Item = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		x.Item = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		x.Height = uint64(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		x.Format = uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		x.Chunks = uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		x.Hash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1369,61 +1623,25 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Store); ok {
-			return protoreflect.ValueOfMessage(v.Store.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Iavl); ok {
-			return protoreflect.ValueOfMessage(v.Iavl.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Extension); ok {
-			return protoreflect.ValueOfMessage(v.Extension.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
-			return protoreflect.ValueOfMessage(v.ExtensionPayload.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Kv); ok {
-			return protoreflect.ValueOfMessage(v.Kv.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		if x.Item == nil {
-			return protoreflect.ValueOfMessage((*SnapshotSchema)(nil).ProtoReflect())
-		} else if v, ok := x.Item.(*SnapshotItem_Schema); ok {
-			return protoreflect.ValueOfMessage(v.Schema.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*SnapshotSchema)(nil).ProtoReflect())
-		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		value := x.Format
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		value := x.Chunks
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1437,31 +1655,21 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		cv := value.Message().Interface().(*SnapshotStoreItem)
-		x.Item = &SnapshotItem_Store{Store: cv}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		cv := value.Message().Interface().(*SnapshotIAVLItem)
-		x.Item = &SnapshotItem_Iavl{Iavl: cv}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		cv := value.Message().Interface().(*SnapshotExtensionMeta)
-		x.Item = &SnapshotItem_Extension{Extension: cv}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		cv := value.Message().Interface().(*SnapshotExtensionPayload)
-		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		cv := value.Message().Interface().(*SnapshotKVItem)
-		x.Item = &SnapshotItem_Kv{Kv: cv}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		cv := value.Message().Interface().(*SnapshotSchema)
-		x.Item = &SnapshotItem_Schema{Schema: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		x.Height = value.Uint()
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		x.Format = uint32(value.Uint())
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		x.Chunks = uint32(value.Uint())
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		x.Hash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1475,168 +1683,52 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		if x.Item == nil {
-			value := &SnapshotStoreItem{}
-			oneofValue := &SnapshotItem_Store{Store: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Store:
-			return protoreflect.ValueOfMessage(m.Store.ProtoReflect())
-		default:
-			value := &SnapshotStoreItem{}
-			oneofValue := &SnapshotItem_Store{Store: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		if x.Item == nil {
-			value := &SnapshotIAVLItem{}
-			oneofValue := &SnapshotItem_Iavl{Iavl: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Iavl:
-			return protoreflect.ValueOfMessage(m.Iavl.ProtoReflect())
-		default:
-			value := &SnapshotIAVLItem{}
-			oneofValue := &SnapshotItem_Iavl{Iavl: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		if x.Item == nil {
-			value := &SnapshotExtensionMeta{}
-			oneofValue := &SnapshotItem_Extension{Extension: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Extension:
-			return protoreflect.ValueOfMessage(m.Extension.ProtoReflect())
-		default:
-			value := &SnapshotExtensionMeta{}
-			oneofValue := &SnapshotItem_Extension{Extension: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		if x.Item == nil {
-			value := &SnapshotExtensionPayload{}
-			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		panic(fmt.Errorf("field height of message cosmos.base.snapshots.v1beta1.SnapshotLink is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		panic(fmt.Errorf("field format of message cosmos.base.snapshots.v1beta1.SnapshotLink is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		panic(fmt.Errorf("field chunks of message cosmos.base.snapshots.v1beta1.SnapshotLink is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		panic(fmt.Errorf("field hash of message cosmos.base.snapshots.v1beta1.SnapshotLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_ExtensionPayload:
-			return protoreflect.ValueOfMessage(m.ExtensionPayload.ProtoReflect())
-		default:
-			value := &SnapshotExtensionPayload{}
-			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.format":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.chunks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotLink.hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotLink"))
 		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		if x.Item == nil {
-			value := &SnapshotKVItem{}
-			oneofValue := &SnapshotItem_Kv{Kv: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Kv:
-			return protoreflect.ValueOfMessage(m.Kv.ProtoReflect())
-		default:
-			value := &SnapshotKVItem{}
-			oneofValue := &SnapshotItem_Kv{Kv: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		if x.Item == nil {
-			value := &SnapshotSchema{}
-			oneofValue := &SnapshotItem_Schema{Schema: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Item.(type) {
-		case *SnapshotItem_Schema:
-			return protoreflect.ValueOfMessage(m.Schema.ProtoReflect())
-		default:
-			value := &SnapshotSchema{}
-			oneofValue := &SnapshotItem_Schema{Schema: value}
-			x.Item = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
-		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
-		value := &SnapshotStoreItem{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
-		value := &SnapshotIAVLItem{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
-		value := &SnapshotExtensionMeta{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
-		value := &SnapshotExtensionPayload{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
-		value := &SnapshotKVItem{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
-		value := &SnapshotSchema{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
-		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotLink does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotItem.item":
-		if x.Item == nil {
-			return nil
-		}
-		switch x.Item.(type) {
-		case *SnapshotItem_Store:
-			return x.Descriptor().Fields().ByName("store")
-		case *SnapshotItem_Iavl:
-			return x.Descriptor().Fields().ByName("iavl")
-		case *SnapshotItem_Extension:
-			return x.Descriptor().Fields().ByName("extension")
-		case *SnapshotItem_ExtensionPayload:
-			return x.Descriptor().Fields().ByName("extension_payload")
-		case *SnapshotItem_Kv:
-			return x.Descriptor().Fields().ByName("kv")
-		case *SnapshotItem_Schema:
-			return x.Descriptor().Fields().ByName("schema")
-		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotLink", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1644,7 +1736,7 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotLink) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1655,7 +1747,7 @@ func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotLink) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1667,7 +1759,7 @@ func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotItem) IsValid() bool {
+func (x *fastReflection_SnapshotLink) IsValid() bool {
 	return x != nil
 }
 
@@ -1677,9 +1769,9 @@ func (x *fastReflection_SnapshotItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotLink) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotLink)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1691,42 +1783,17 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		switch x := x.Item.(type) {
-		case *SnapshotItem_Store:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Store)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Iavl:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Iavl)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Extension:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Extension)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_ExtensionPayload:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.ExtensionPayload)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Kv:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Kv)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *SnapshotItem_Schema:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.Schema)
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Format != 0 {
+			n += 1 + runtime.Sov(uint64(x.Format))
+		}
+		if x.Chunks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Chunks))
+		}
+		l = len(x.Hash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1739,7 +1806,7 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotLink)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1758,85 +1825,27 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		switch x := x.Item.(type) {
-		case *SnapshotItem_Store:
-			encoded, err := options.Marshal(x.Store)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		case *SnapshotItem_Iavl:
-			encoded, err := options.Marshal(x.Iavl)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		case *SnapshotItem_Extension:
-			encoded, err := options.Marshal(x.Extension)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		case *SnapshotItem_ExtensionPayload:
-			encoded, err := options.Marshal(x.ExtensionPayload)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0x22
-		case *SnapshotItem_Kv:
-			encoded, err := options.Marshal(x.Kv)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.Chunks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Chunks))
 			i--
-			dAtA[i] = 0x2a
-		case *SnapshotItem_Schema:
-			encoded, err := options.Marshal(x.Schema)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[i] = 0x18
+		}
+		if x.Format != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Format))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1849,7 +1858,7 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotItem)
+		x := input.Message.Interface().(*SnapshotLink)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1881,17 +1890,17 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotLink: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotLink: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1901,32 +1910,16 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 				}
-				v := &SnapshotStoreItem{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Store{v}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Iavl", wireType)
-				}
-				var msglen int
+				x.Format = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1936,32 +1929,16 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Format |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &SnapshotIAVLItem{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Iavl{v}
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 				}
-				var msglen int
+				x.Chunks = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1971,32 +1948,16 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Chunks |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &SnapshotExtensionMeta{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Extension{v}
-				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2006,67 +1967,1517 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotExtensionPayload{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
 				}
-				x.Item = &SnapshotItem_ExtensionPayload{v}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotItem                   protoreflect.MessageDescriptor
+	fd_SnapshotItem_store             protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
+	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
+	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_kv                protoreflect.FieldDescriptor
+	fd_SnapshotItem_schema            protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl_subtree      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
+	md_SnapshotItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotItem")
+	fd_SnapshotItem_store = md_SnapshotItem.Fields().ByName("store")
+	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
+	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
+	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_kv = md_SnapshotItem.Fields().ByName("kv")
+	fd_SnapshotItem_schema = md_SnapshotItem.Fields().ByName("schema")
+	fd_SnapshotItem_iavl_subtree = md_SnapshotItem.Fields().ByName("iavl_subtree")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
+
+type fastReflection_SnapshotItem SnapshotItem
+
+func (x *SnapshotItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotItem)(x)
+}
+
+func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotItem_messageType fastReflection_SnapshotItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotItem_messageType{}
+
+type fastReflection_SnapshotItem_messageType struct{}
+
+func (x fastReflection_SnapshotItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotItem)(nil)
+}
+func (x fastReflection_SnapshotItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotItem)
+}
+func (x fastReflection_SnapshotItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Item != nil {
+		switch o := x.Item.(type) {
+		case *SnapshotItem_Store:
+			v := o.Store
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_store, value) {
+				return
+			}
+		case *SnapshotItem_Iavl:
+			v := o.Iavl
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl, value) {
+				return
+			}
+		case *SnapshotItem_Extension:
+			v := o.Extension
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_extension, value) {
+				return
+			}
+		case *SnapshotItem_ExtensionPayload:
+			v := o.ExtensionPayload
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_extension_payload, value) {
+				return
+			}
+		case *SnapshotItem_Kv:
+			v := o.Kv
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_kv, value) {
+				return
+			}
+		case *SnapshotItem_Schema:
+			v := o.Schema
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_schema, value) {
+				return
+			}
+		case *SnapshotItem_IavlSubtree:
+			v := o.IavlSubtree
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl_subtree, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Store); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Iavl); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Extension); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Kv); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Schema); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_IavlSubtree); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		x.Item = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		x.Item = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Store); ok {
+			return protoreflect.ValueOfMessage(v.Store.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotStoreItem)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Iavl); ok {
+			return protoreflect.ValueOfMessage(v.Iavl.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLItem)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Extension); ok {
+			return protoreflect.ValueOfMessage(v.Extension.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionMeta)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_ExtensionPayload); ok {
+			return protoreflect.ValueOfMessage(v.ExtensionPayload.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Kv); ok {
+			return protoreflect.ValueOfMessage(v.Kv.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotSchema)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Schema); ok {
+			return protoreflect.ValueOfMessage(v.Schema.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotSchema)(nil).ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLSubtreeItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_IavlSubtree); ok {
+			return protoreflect.ValueOfMessage(v.IavlSubtree.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLSubtreeItem)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		cv := value.Message().Interface().(*SnapshotStoreItem)
+		x.Item = &SnapshotItem_Store{Store: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		cv := value.Message().Interface().(*SnapshotIAVLItem)
+		x.Item = &SnapshotItem_Iavl{Iavl: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		cv := value.Message().Interface().(*SnapshotExtensionMeta)
+		x.Item = &SnapshotItem_Extension{Extension: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		cv := value.Message().Interface().(*SnapshotExtensionPayload)
+		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		cv := value.Message().Interface().(*SnapshotKVItem)
+		x.Item = &SnapshotItem_Kv{Kv: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		cv := value.Message().Interface().(*SnapshotSchema)
+		x.Item = &SnapshotItem_Schema{Schema: cv}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		cv := value.Message().Interface().(*SnapshotIAVLSubtreeItem)
+		x.Item = &SnapshotItem_IavlSubtree{IavlSubtree: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		if x.Item == nil {
+			value := &SnapshotStoreItem{}
+			oneofValue := &SnapshotItem_Store{Store: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Store:
+			return protoreflect.ValueOfMessage(m.Store.ProtoReflect())
+		default:
+			value := &SnapshotStoreItem{}
+			oneofValue := &SnapshotItem_Store{Store: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		if x.Item == nil {
+			value := &SnapshotIAVLItem{}
+			oneofValue := &SnapshotItem_Iavl{Iavl: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Iavl:
+			return protoreflect.ValueOfMessage(m.Iavl.ProtoReflect())
+		default:
+			value := &SnapshotIAVLItem{}
+			oneofValue := &SnapshotItem_Iavl{Iavl: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		if x.Item == nil {
+			value := &SnapshotExtensionMeta{}
+			oneofValue := &SnapshotItem_Extension{Extension: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Extension:
+			return protoreflect.ValueOfMessage(m.Extension.ProtoReflect())
+		default:
+			value := &SnapshotExtensionMeta{}
+			oneofValue := &SnapshotItem_Extension{Extension: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		if x.Item == nil {
+			value := &SnapshotExtensionPayload{}
+			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_ExtensionPayload:
+			return protoreflect.ValueOfMessage(m.ExtensionPayload.ProtoReflect())
+		default:
+			value := &SnapshotExtensionPayload{}
+			oneofValue := &SnapshotItem_ExtensionPayload{ExtensionPayload: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		if x.Item == nil {
+			value := &SnapshotKVItem{}
+			oneofValue := &SnapshotItem_Kv{Kv: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Kv:
+			return protoreflect.ValueOfMessage(m.Kv.ProtoReflect())
+		default:
+			value := &SnapshotKVItem{}
+			oneofValue := &SnapshotItem_Kv{Kv: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		if x.Item == nil {
+			value := &SnapshotSchema{}
+			oneofValue := &SnapshotItem_Schema{Schema: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Schema:
+			return protoreflect.ValueOfMessage(m.Schema.ProtoReflect())
+		default:
+			value := &SnapshotSchema{}
+			oneofValue := &SnapshotItem_Schema{Schema: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		if x.Item == nil {
+			value := &SnapshotIAVLSubtreeItem{}
+			oneofValue := &SnapshotItem_IavlSubtree{IavlSubtree: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_IavlSubtree:
+			return protoreflect.ValueOfMessage(m.IavlSubtree.ProtoReflect())
+		default:
+			value := &SnapshotIAVLSubtreeItem{}
+			oneofValue := &SnapshotItem_IavlSubtree{IavlSubtree: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.store":
+		value := &SnapshotStoreItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl":
+		value := &SnapshotIAVLItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension":
+		value := &SnapshotExtensionMeta{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload":
+		value := &SnapshotExtensionPayload{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.kv":
+		value := &SnapshotKVItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.schema":
+		value := &SnapshotSchema{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree":
+		value := &SnapshotIAVLSubtreeItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotItem.item":
+		if x.Item == nil {
+			return nil
+		}
+		switch x.Item.(type) {
+		case *SnapshotItem_Store:
+			return x.Descriptor().Fields().ByName("store")
+		case *SnapshotItem_Iavl:
+			return x.Descriptor().Fields().ByName("iavl")
+		case *SnapshotItem_Extension:
+			return x.Descriptor().Fields().ByName("extension")
+		case *SnapshotItem_ExtensionPayload:
+			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_Kv:
+			return x.Descriptor().Fields().ByName("kv")
+		case *SnapshotItem_Schema:
+			return x.Descriptor().Fields().ByName("schema")
+		case *SnapshotItem_IavlSubtree:
+			return x.Descriptor().Fields().ByName("iavl_subtree")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Item.(type) {
+		case *SnapshotItem_Store:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Store)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Iavl:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Iavl)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Extension:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Extension)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_ExtensionPayload:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ExtensionPayload)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Kv:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Kv)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Schema:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Schema)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_IavlSubtree:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.IavlSubtree)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Item.(type) {
+		case *SnapshotItem_Store:
+			encoded, err := options.Marshal(x.Store)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *SnapshotItem_Iavl:
+			encoded, err := options.Marshal(x.Iavl)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *SnapshotItem_Extension:
+			encoded, err := options.Marshal(x.Extension)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		case *SnapshotItem_ExtensionPayload:
+			encoded, err := options.Marshal(x.ExtensionPayload)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *SnapshotItem_Kv:
+			encoded, err := options.Marshal(x.Kv)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		case *SnapshotItem_Schema:
+			encoded, err := options.Marshal(x.Schema)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		case *SnapshotItem_IavlSubtree:
+			encoded, err := options.Marshal(x.IavlSubtree)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotStoreItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Store{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Iavl", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Iavl{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionMeta{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Extension{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionPayload{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_ExtensionPayload{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotKVItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Kv{v}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotSchema{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Schema{v}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IavlSubtree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLSubtreeItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_IavlSubtree{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotStoreItem      protoreflect.MessageDescriptor
+	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
+	md_SnapshotStoreItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotStoreItem")
+	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+
+type fastReflection_SnapshotStoreItem SnapshotStoreItem
+
+func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(x)
+}
+
+func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+
+type fastReflection_SnapshotStoreItem_messageType struct{}
+
+func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(nil)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotStoreItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotStoreItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotStoreItem_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		return x.Name != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		x.Name = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		x.Name = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		panic(fmt.Errorf("field name of message cosmos.base.snapshots.v1beta1.SnapshotStoreItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotStoreItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotKVItem{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Item = &SnapshotItem_Kv{v}
-				iNdEx = postIndex
-			case 6:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2076,26 +3487,23 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotSchema{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_Schema{v}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2133,26 +3541,32 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotStoreItem      protoreflect.MessageDescriptor
-	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+	md_SnapshotIAVLItem         protoreflect.MessageDescriptor
+	fd_SnapshotIAVLItem_key     protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_value   protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_version protoreflect.FieldDescriptor
+	fd_SnapshotIAVLItem_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
-	md_SnapshotStoreItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotStoreItem")
-	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+	md_SnapshotIAVLItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotIAVLItem")
+	fd_SnapshotIAVLItem_key = md_SnapshotIAVLItem.Fields().ByName("key")
+	fd_SnapshotIAVLItem_value = md_SnapshotIAVLItem.Fields().ByName("value")
+	fd_SnapshotIAVLItem_version = md_SnapshotIAVLItem.Fields().ByName("version")
+	fd_SnapshotIAVLItem_height = md_SnapshotIAVLItem.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLItem)(nil)
 
-type fastReflection_SnapshotStoreItem SnapshotStoreItem
+type fastReflection_SnapshotIAVLItem SnapshotIAVLItem
 
-func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(x)
+func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLItem)(x)
 }
 
-func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
+func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,43 +3577,43 @@ func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+var _fastReflection_SnapshotIAVLItem_messageType fastReflection_SnapshotIAVLItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLItem_messageType{}
 
-type fastReflection_SnapshotStoreItem_messageType struct{}
+type fastReflection_SnapshotIAVLItem_messageType struct{}
 
-func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(nil)
+func (x fastReflection_SnapshotIAVLItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLItem)(nil)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x fastReflection_SnapshotIAVLItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLItem)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x fastReflection_SnapshotIAVLItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x *fastReflection_SnapshotIAVLItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotStoreItem_messageType
+func (x *fastReflection_SnapshotIAVLItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x *fastReflection_SnapshotIAVLItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotStoreItem)(x)
+func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2207,10 +3621,28 @@ func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotStoreItem_name, value) {
+func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SnapshotIAVLItem_key, value) {
+			return
+		}
+	}
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfBytes(x.Value)
+		if !f(fd_SnapshotIAVLItem_value, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_SnapshotIAVLItem_version, value) {
+			return
+		}
+	}
+	if x.Height != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Height)
+		if !f(fd_SnapshotIAVLItem_height, value) {
 			return
 		}
 	}
@@ -2227,15 +3659,21 @@ func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		return x.Name != ""
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		return len(x.Key) != 0
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		return len(x.Value) != 0
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		return x.Version != int64(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		return x.Height != int32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2245,15 +3683,21 @@ func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		x.Name = ""
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		x.Key = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		x.Value = nil
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		x.Version = int64(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		x.Height = int32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2263,16 +3707,25 @@ func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		value := x.Value
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		value := x.Height
+		return protoreflect.ValueOfInt32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2286,15 +3739,21 @@ func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		x.Name = value.Interface().(string)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		x.Key = value.Bytes()
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		x.Value = value.Bytes()
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		x.Version = value.Int()
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		x.Height = int32(value.Int())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2308,40 +3767,52 @@ func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		panic(fmt.Errorf("field name of message cosmos.base.snapshots.v1beta1.SnapshotStoreItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		panic(fmt.Errorf("field key of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		panic(fmt.Errorf("field value of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		panic(fmt.Errorf("field version of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		panic(fmt.Errorf("field height of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotStoreItem.name":
-		return protoreflect.ValueOfString("")
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
+		return protoreflect.ValueOfInt32(int32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotStoreItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotIAVLItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2349,7 +3820,7 @@ func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2360,7 +3831,7 @@ func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2372,7 +3843,7 @@ func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
 	return x != nil
 }
 
@@ -2382,9 +3853,9 @@ func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotIAVLItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2396,10 +3867,20 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2410,7 +3891,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotIAVLItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2429,10 +3910,27 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2447,7 +3945,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotIAVLItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2479,17 +3977,17 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2499,24 +3997,98 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
+				if x.Value == nil {
+					x.Value = []byte{}
+				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2553,32 +4125,30 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotIAVLItem         protoreflect.MessageDescriptor
-	fd_SnapshotIAVLItem_key     protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_value   protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_version protoreflect.FieldDescriptor
-	fd_SnapshotIAVLItem_height  protoreflect.FieldDescriptor
+	md_SnapshotIAVLSubtreeItem         protoreflect.MessageDescriptor
+	fd_SnapshotIAVLSubtreeItem_key     protoreflect.FieldDescriptor
+	fd_SnapshotIAVLSubtreeItem_version protoreflect.FieldDescriptor
+	fd_SnapshotIAVLSubtreeItem_nodes   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
-	md_SnapshotIAVLItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotIAVLItem")
-	fd_SnapshotIAVLItem_key = md_SnapshotIAVLItem.Fields().ByName("key")
-	fd_SnapshotIAVLItem_value = md_SnapshotIAVLItem.Fields().ByName("value")
-	fd_SnapshotIAVLItem_version = md_SnapshotIAVLItem.Fields().ByName("version")
-	fd_SnapshotIAVLItem_height = md_SnapshotIAVLItem.Fields().ByName("height")
+	md_SnapshotIAVLSubtreeItem = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotIAVLSubtreeItem")
+	fd_SnapshotIAVLSubtreeItem_key = md_SnapshotIAVLSubtreeItem.Fields().ByName("key")
+	fd_SnapshotIAVLSubtreeItem_version = md_SnapshotIAVLSubtreeItem.Fields().ByName("version")
+	fd_SnapshotIAVLSubtreeItem_nodes = md_SnapshotIAVLSubtreeItem.Fields().ByName("nodes")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotIAVLItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLSubtreeItem)(nil)
 
-type fastReflection_SnapshotIAVLItem SnapshotIAVLItem
+type fastReflection_SnapshotIAVLSubtreeItem SnapshotIAVLSubtreeItem
 
-func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotIAVLItem)(x)
+func (x *SnapshotIAVLSubtreeItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLSubtreeItem)(x)
 }
 
-func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
+func (x *SnapshotIAVLSubtreeItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,43 +4159,43 @@ func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotIAVLItem_messageType fastReflection_SnapshotIAVLItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotIAVLItem_messageType{}
+var _fastReflection_SnapshotIAVLSubtreeItem_messageType fastReflection_SnapshotIAVLSubtreeItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLSubtreeItem_messageType{}
 
-type fastReflection_SnapshotIAVLItem_messageType struct{}
+type fastReflection_SnapshotIAVLSubtreeItem_messageType struct{}
 
-func (x fastReflection_SnapshotIAVLItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotIAVLItem)(nil)
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLSubtreeItem)(nil)
 }
-func (x fastReflection_SnapshotIAVLItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotIAVLItem)
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLSubtreeItem)
 }
-func (x fastReflection_SnapshotIAVLItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotIAVLItem
+func (x fastReflection_SnapshotIAVLSubtreeItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLSubtreeItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotIAVLItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotIAVLItem
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLSubtreeItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotIAVLItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotIAVLItem_messageType
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLSubtreeItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotIAVLItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotIAVLItem)
+func (x *fastReflection_SnapshotIAVLSubtreeItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLSubtreeItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotIAVLItem)(x)
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLSubtreeItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2633,28 +4203,22 @@ func (x *fastReflection_SnapshotIAVLItem) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Key) != 0 {
 		value := protoreflect.ValueOfBytes(x.Key)
-		if !f(fd_SnapshotIAVLItem_key, value) {
-			return
-		}
-	}
-	if len(x.Value) != 0 {
-		value := protoreflect.ValueOfBytes(x.Value)
-		if !f(fd_SnapshotIAVLItem_value, value) {
+		if !f(fd_SnapshotIAVLSubtreeItem_key, value) {
 			return
 		}
 	}
 	if x.Version != int64(0) {
 		value := protoreflect.ValueOfInt64(x.Version)
-		if !f(fd_SnapshotIAVLItem_version, value) {
+		if !f(fd_SnapshotIAVLSubtreeItem_version, value) {
 			return
 		}
 	}
-	if x.Height != int32(0) {
-		value := protoreflect.ValueOfInt32(x.Height)
-		if !f(fd_SnapshotIAVLItem_height, value) {
+	if x.Nodes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nodes)
+		if !f(fd_SnapshotIAVLSubtreeItem_nodes, value) {
 			return
 		}
 	}
@@ -2671,21 +4235,19 @@ func (x *fastReflection_SnapshotIAVLItem) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
 		return len(x.Key) != 0
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
-		return len(x.Value) != 0
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
 		return x.Version != int64(0)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		return x.Height != int32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		return x.Nodes != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2695,21 +4257,19 @@ func (x *fastReflection_SnapshotIAVLItem) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
 		x.Key = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
-		x.Value = nil
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
 		x.Version = int64(0)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		x.Height = int32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		x.Nodes = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2719,25 +4279,22 @@ func (x *fastReflection_SnapshotIAVLItem) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
 		value := x.Key
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
-		value := x.Value
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
 		value := x.Version
 		return protoreflect.ValueOfInt64(value)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		value := x.Height
-		return protoreflect.ValueOfInt32(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		value := x.Nodes
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2751,21 +4308,19 @@ func (x *fastReflection_SnapshotIAVLItem) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
 		x.Key = value.Bytes()
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
-		x.Value = value.Bytes()
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
 		x.Version = value.Int()
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		x.Height = int32(value.Int())
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		x.Nodes = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2779,52 +4334,48 @@ func (x *fastReflection_SnapshotIAVLItem) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
-		panic(fmt.Errorf("field key of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
-		panic(fmt.Errorf("field value of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
-		panic(fmt.Errorf("field version of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		panic(fmt.Errorf("field height of message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
+		panic(fmt.Errorf("field key of message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
+		panic(fmt.Errorf("field version of message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		panic(fmt.Errorf("field nodes of message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotIAVLItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.key":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.value":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.key":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.version":
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.version":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem.height":
-		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem.nodes":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem"))
 		}
-		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotIAVLItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2832,7 +4383,7 @@ func (x *fastReflection_SnapshotIAVLItem) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2843,7 +4394,7 @@ func (x *fastReflection_SnapshotIAVLItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2855,7 +4406,7 @@ func (x *fastReflection_SnapshotIAVLItem) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) IsValid() bool {
 	return x != nil
 }
 
@@ -2865,9 +4416,9 @@ func (x *fastReflection_SnapshotIAVLItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotIAVLSubtreeItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2883,15 +4434,11 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Value)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.Version != 0 {
 			n += 1 + runtime.Sov(uint64(x.Version))
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		if x.Nodes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nodes))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2903,7 +4450,7 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2922,22 +4469,15 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if x.Nodes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nodes))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x18
 		}
 		if x.Version != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
-			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
 		if len(x.Key) > 0 {
 			i -= len(x.Key)
@@ -2957,7 +4497,7 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotIAVLItem)
+		x := input.Message.Interface().(*SnapshotIAVLSubtreeItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2989,10 +4529,10 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLSubtreeItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLSubtreeItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3030,40 +4570,6 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
-				if x.Value == nil {
-					x.Value = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
@@ -3082,11 +4588,11 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 4:
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 				}
-				x.Height = 0
+				x.Nodes = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3096,7 +4602,7 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= int32(b&0x7F) << shift
+					x.Nodes |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3158,7 +4664,7 @@ func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,7 +5130,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4048,7 +5554,7 @@ func (x *SnapshotKVItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotKVItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4580,7 +6086,7 @@ func (x *SnapshotSchema) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotSchema) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5082,6 +6588,24 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// base_height is the height of the snapshot a delta snapshot is based on, it is 0 for full snapshots.
+	//
+	// Since: cosmos-sdk 0.47
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// chain lists the snapshots making up an advertised delta snapshot, in the order of their chunks:
+	// the delta snapshots from the newest to the oldest, followed by the full snapshot they are based on.
+	//
+	// Since: cosmos-sdk 0.47
+	Chain []*SnapshotLink `protobuf:"bytes,3,rep,name=chain,proto3" json:"chain,omitempty"`
+	// signer is the ed25519 public key of the node which signed a snapshot whose hash is the Merkle
+	// root of its chunk hashes.
+	//
+	// Since: cosmos-sdk 0.47
+	Signer []byte `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signature is the signature of the height, format, chunks and hash of the snapshot by the signer.
+	//
+	// Since: cosmos-sdk 0.47
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -5111,6 +6635,96 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetBaseHeight() uint64 {
+	if x != nil {
+		return x.BaseHeight
+	}
+	return 0
+}
+
+func (x *Metadata) GetChain() []*SnapshotLink {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *Metadata) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *Metadata) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// SnapshotLink describes a snapshot of a delta snapshot chain.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format uint32 `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunks uint32 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Hash   []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SnapshotLink) Reset() {
+	*x = SnapshotLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotLink) ProtoMessage() {}
+
+// Deprecated: Use SnapshotLink.ProtoReflect.Descriptor instead.
+func (*SnapshotLink) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnapshotLink) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SnapshotLink) GetFormat() uint32 {
+	if x != nil {
+		return x.Format
+	}
+	return 0
+}
+
+func (x *SnapshotLink) GetChunks() uint32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *SnapshotLink) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -5128,13 +6742,14 @@ type SnapshotItem struct {
 	//	*SnapshotItem_ExtensionPayload
 	//	*SnapshotItem_Kv
 	//	*SnapshotItem_Schema
+	//	*SnapshotItem_IavlSubtree
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
 func (x *SnapshotItem) Reset() {
 	*x = SnapshotItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5148,7 +6763,7 @@ func (*SnapshotItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotItem.ProtoReflect.Descriptor instead.
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SnapshotItem) GetItem() isSnapshotItem_Item {
@@ -5200,6 +6815,13 @@ func (x *SnapshotItem) GetSchema() *SnapshotSchema {
 	return nil
 }
 
+func (x *SnapshotItem) GetIavlSubtree() *SnapshotIAVLSubtreeItem {
+	if x, ok := x.GetItem().(*SnapshotItem_IavlSubtree); ok {
+		return x.IavlSubtree
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -5228,6 +6850,10 @@ type SnapshotItem_Schema struct {
 	Schema *SnapshotSchema `protobuf:"bytes,6,opt,name=schema,proto3,oneof"`
 }
 
+type SnapshotItem_IavlSubtree struct {
+	IavlSubtree *SnapshotIAVLSubtreeItem `protobuf:"bytes,7,opt,name=iavl_subtree,json=iavlSubtree,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -5240,6 +6866,8 @@ func (*SnapshotItem_Kv) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Schema) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_IavlSubtree) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 //
 // Since: cosmos-sdk 0.46
@@ -5254,7 +6882,7 @@ type SnapshotStoreItem struct {
 func (x *SnapshotStoreItem) Reset() {
 	*x = SnapshotStoreItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5268,7 +6896,7 @@ func (*SnapshotStoreItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotStoreItem.ProtoReflect.Descriptor instead.
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SnapshotStoreItem) GetName() string {
@@ -5297,7 +6925,7 @@ type SnapshotIAVLItem struct {
 func (x *SnapshotIAVLItem) Reset() {
 	*x = SnapshotIAVLItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5311,7 +6939,7 @@ func (*SnapshotIAVLItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotIAVLItem.ProtoReflect.Descriptor instead.
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SnapshotIAVLItem) GetKey() []byte {
@@ -5342,6 +6970,62 @@ func (x *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLSubtreeItem references a subtree of an IAVL tree which is unchanged since the
+// base snapshot of a delta snapshot. The subtree is made of the given number of nodes, exported in
+// post-order by the base snapshot starting with the leaf of the given key and version.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotIAVLSubtreeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Nodes   uint64 `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *SnapshotIAVLSubtreeItem) Reset() {
+	*x = SnapshotIAVLSubtreeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotIAVLSubtreeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotIAVLSubtreeItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotIAVLSubtreeItem.ProtoReflect.Descriptor instead.
+func (*SnapshotIAVLSubtreeItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SnapshotIAVLSubtreeItem) GetNodes() uint64 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -5357,7 +7041,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5371,7 +7055,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -5402,7 +7086,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5416,7 +7100,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -5441,7 +7125,7 @@ type SnapshotKVItem struct {
 func (x *SnapshotKVItem) Reset() {
 	*x = SnapshotKVItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5455,7 +7139,7 @@ func (*SnapshotKVItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotKVItem.ProtoReflect.Descriptor instead.
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SnapshotKVItem) GetKey() []byte {
@@ -5486,7 +7170,7 @@ type SnapshotSchema struct {
 func (x *SnapshotSchema) Reset() {
 	*x = SnapshotSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5500,7 +7184,7 @@ func (*SnapshotSchema) ProtoMessage() {}
 
 // Deprecated: Use SnapshotSchema.ProtoReflect.Descriptor instead.
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SnapshotSchema) GetKeys() [][]byte {
@@ -5530,83 +7214,112 @@ var file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61,
 	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x4f, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d,
-	0x42, 0x08, 0xe2, 0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61,
-	0x76, 0x6c, 0x12, 0x54, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcd, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x61,
+	0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0xef, 0x04, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x47, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4f,
+	0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73,
 	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xe2, 0xde, 0x1f,
-	0x02, 0x4b, 0x56, 0x48, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2,
+	0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12,
+	0x54, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a,
+	0x02, 0x6b, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
 	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
-	0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x38, 0x0a, 0x0e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x8a, 0x02, 0x0a,
-	0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x53, 0xaa, 0x02, 0x1d, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1d, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x29, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x4b, 0x56,
+	0x48, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x6c, 0x0a, 0x0c, 0x69, 0x61, 0x76, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41,
+	0x56, 0x4c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0f, 0xe2,
+	0xde, 0x1f, 0x0b, 0x49, 0x41, 0x56, 0x4c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x69, 0x61, 0x76, 0x6c, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c,
+	0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5b, 0x0a, 0x17,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x53, 0x75, 0x62, 0x74,
+	0x72, 0x65, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34,
+	0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x38, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24,
+	0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x42, 0x8a, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x42, 0x53, 0xaa, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65,
+	0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x29, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65,
+	0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5621,31 +7334,35 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_cosmos_base_snapshots_v1beta1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.base.snapshots.v1beta1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.base.snapshots.v1beta1.Metadata
-	(*SnapshotItem)(nil),             // 2: cosmos.base.snapshots.v1beta1.SnapshotItem
-	(*SnapshotStoreItem)(nil),        // 3: cosmos.base.snapshots.v1beta1.SnapshotStoreItem
-	(*SnapshotIAVLItem)(nil),         // 4: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
-	(*SnapshotKVItem)(nil),           // 7: cosmos.base.snapshots.v1beta1.SnapshotKVItem
-	(*SnapshotSchema)(nil),           // 8: cosmos.base.snapshots.v1beta1.SnapshotSchema
+	(*SnapshotLink)(nil),             // 2: cosmos.base.snapshots.v1beta1.SnapshotLink
+	(*SnapshotItem)(nil),             // 3: cosmos.base.snapshots.v1beta1.SnapshotItem
+	(*SnapshotStoreItem)(nil),        // 4: cosmos.base.snapshots.v1beta1.SnapshotStoreItem
+	(*SnapshotIAVLItem)(nil),         // 5: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
+	(*SnapshotIAVLSubtreeItem)(nil),  // 6: cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem
+	(*SnapshotExtensionMeta)(nil),    // 7: cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 8: cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
+	(*SnapshotKVItem)(nil),           // 9: cosmos.base.snapshots.v1beta1.SnapshotKVItem
+	(*SnapshotSchema)(nil),           // 10: cosmos.base.snapshots.v1beta1.SnapshotSchema
 }
 var file_cosmos_base_snapshots_v1beta1_snapshot_proto_depIdxs = []int32{
-	1, // 0: cosmos.base.snapshots.v1beta1.Snapshot.metadata:type_name -> cosmos.base.snapshots.v1beta1.Metadata
-	3, // 1: cosmos.base.snapshots.v1beta1.SnapshotItem.store:type_name -> cosmos.base.snapshots.v1beta1.SnapshotStoreItem
-	4, // 2: cosmos.base.snapshots.v1beta1.SnapshotItem.iavl:type_name -> cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
-	5, // 3: cosmos.base.snapshots.v1beta1.SnapshotItem.extension:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
-	6, // 4: cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
-	7, // 5: cosmos.base.snapshots.v1beta1.SnapshotItem.kv:type_name -> cosmos.base.snapshots.v1beta1.SnapshotKVItem
-	8, // 6: cosmos.base.snapshots.v1beta1.SnapshotItem.schema:type_name -> cosmos.base.snapshots.v1beta1.SnapshotSchema
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: cosmos.base.snapshots.v1beta1.Snapshot.metadata:type_name -> cosmos.base.snapshots.v1beta1.Metadata
+	2,  // 1: cosmos.base.snapshots.v1beta1.Metadata.chain:type_name -> cosmos.base.snapshots.v1beta1.SnapshotLink
+	4,  // 2: cosmos.base.snapshots.v1beta1.SnapshotItem.store:type_name -> cosmos.base.snapshots.v1beta1.SnapshotStoreItem
+	5,  // 3: cosmos.base.snapshots.v1beta1.SnapshotItem.iavl:type_name -> cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
+	7,  // 4: cosmos.base.snapshots.v1beta1.SnapshotItem.extension:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
+	8,  // 5: cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
+	9,  // 6: cosmos.base.snapshots.v1beta1.SnapshotItem.kv:type_name -> cosmos.base.snapshots.v1beta1.SnapshotKVItem
+	10, // 7: cosmos.base.snapshots.v1beta1.SnapshotItem.schema:type_name -> cosmos.base.snapshots.v1beta1.SnapshotSchema
+	6,  // 8: cosmos.base.snapshots.v1beta1.SnapshotItem.iavl_subtree:type_name -> cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() }
@@ -5679,7 +7396,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotItem); i {
+			switch v := v.(*SnapshotLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5691,7 +7408,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotStoreItem); i {
+			switch v := v.(*SnapshotItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5703,7 +7420,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotIAVLItem); i {
+			switch v := v.(*SnapshotStoreItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5715,7 +7432,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5727,7 +7444,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionPayload); i {
+			switch v := v.(*SnapshotIAVLSubtreeItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5739,7 +7456,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotKVItem); i {
+			switch v := v.(*SnapshotExtensionMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5751,6 +7468,30 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotKVItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotSchema); i {
 			case 0:
 				return &v.state
@@ -5763,13 +7504,14 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 	}
-	file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*SnapshotItem_Store)(nil),
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
 		(*SnapshotItem_Kv)(nil),
 		(*SnapshotItem_Schema)(nil),
+		(*SnapshotItem_IavlSubtree)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5777,7 +7519,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
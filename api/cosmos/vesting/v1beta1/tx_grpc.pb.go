@@ -35,6 +35,28 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account whose unvested coins can be clawed back by its funder.
+	//
+	// Since: cosmos-sdk 0.47
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to claw back its unvested coins.
+	//
+	// Since: cosmos-sdk 0.47
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// AddVestingGrant defines a method that enables adding a grant of coins to
+	// an existing periodic vesting account, merging its schedule into the one of
+	// the account. Only the funder of the account can add grants to it.
+	//
+	// Since: cosmos-sdk 0.47
+	AddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error)
+	// AssignVestingFunder defines a method that enables a periodic vesting
+	// account without funder, such as one created in the genesis, to assign once
+	// the funder which can add grants to it.
+	//
+	// Since: cosmos-sdk 0.47
+	AssignVestingFunder(ctx context.Context, in *MsgAssignVestingFunder, opts ...grpc.CallOption) (*MsgAssignVestingFunderResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +94,42 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error) {
+	out := new(MsgAddVestingGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AddVestingGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AssignVestingFunder(ctx context.Context, in *MsgAssignVestingFunder, opts ...grpc.CallOption) (*MsgAssignVestingFunderResponse, error) {
+	out := new(MsgAssignVestingFunderResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AssignVestingFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +147,28 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account whose unvested coins can be clawed back by its funder.
+	//
+	// Since: cosmos-sdk 0.47
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to claw back its unvested coins.
+	//
+	// Since: cosmos-sdk 0.47
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// AddVestingGrant defines a method that enables adding a grant of coins to
+	// an existing periodic vesting account, merging its schedule into the one of
+	// the account. Only the funder of the account can add grants to it.
+	//
+	// Since: cosmos-sdk 0.47
+	AddVestingGrant(context.Context, *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error)
+	// AssignVestingFunder defines a method that enables a periodic vesting
+	// account without funder, such as one created in the genesis, to assign once
+	// the funder which can add grants to it.
+	//
+	// Since: cosmos-sdk 0.47
+	AssignVestingFunder(context.Context, *MsgAssignVestingFunder) (*MsgAssignVestingFunderResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +185,18 @@ func (UnimplementedMsgServer) CreatePermanentLockedAccount(context.Context, *Msg
 func (UnimplementedMsgServer) CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (UnimplementedMsgServer) Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (UnimplementedMsgServer) AddVestingGrant(context.Context, *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVestingGrant not implemented")
+}
+func (UnimplementedMsgServer) AssignVestingFunder(context.Context, *MsgAssignVestingFunder) (*MsgAssignVestingFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignVestingFunder not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +264,78 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddVestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddVestingGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddVestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AddVestingGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddVestingGrant(ctx, req.(*MsgAddVestingGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AssignVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignVestingFunder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AssignVestingFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AssignVestingFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignVestingFunder(ctx, req.(*MsgAssignVestingFunder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +355,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "AddVestingGrant",
+			Handler:    _Msg_AddVestingGrant_Handler,
+		},
+		{
+			MethodName: "AssignVestingFunder",
+			Handler:    _Msg_AssignVestingFunder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -13,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -173,6 +174,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithBlockHeight(req.Header.Height)
 	}
 
+	if app.witness != nil {
+		app.witness.witness.BeginBlock = req
+	}
+
+	return app.beginBlock(req)
+}
+
+// beginBlock executes the BeginBlock of a block on the DeliverTx state.
+func (app *BaseApp) beginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
 	// add block gas meter
 	var gasMeter sdk.GasMeter
 	if maxGas := app.getMaximumBlockGas(app.deliverState.ctx); maxGas > 0 {
@@ -196,31 +206,42 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithHeaderHash(req.Hash)
 	}
 
+	// the spans of the block are children of the span of the block, which is
+	// ended on Commit
+	app.deliverState.ctx, app.blockSpan = startSpan(app.deliverState.ctx, "Block", attribute.Int64("height", req.Header.Height))
+	ctx, span := startSpan(app.deliverState.ctx, "BeginBlock")
+	defer span.End()
+
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		res = app.beginBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 
 	// call the hooks with the BeginBlock messages
-	for _, streamingListener := range app.abciListeners {
-		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
-		}
-	}
+	app.callABCIListeners("BeginBlock", req.Header.Height, func(listener ABCIListener) error {
+		return listener.ListenBeginBlock(app.deliverState.ctx, req, res)
+	})
 
 	return res
 }
 
 // EndBlock implements the ABCI interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
+	if app.witness != nil {
+		app.witness.witness.EndBlock = req
+	}
+
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	ctx, span := startSpan(app.deliverState.ctx, "EndBlock")
+	defer span.End()
+
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		res = app.endBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
@@ -229,11 +250,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	// call the streaming service hooks with the EndBlock messages
-	for _, streamingListener := range app.abciListeners {
-		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
-		}
-	}
+	app.callABCIListeners("EndBlock", req.Height, func(listener ABCIListener) error {
+		return listener.ListenEndBlock(app.deliverState.ctx, req, res)
+	})
 
 	return res
 }
@@ -273,15 +292,74 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	}
 }
 
+// SelectTxs returns the encoded transactions of the app-side mempool in the
+// order it defines, stopping once adding another transaction would exceed
+// maxBytes or the maximum block gas set in the consensus parameters. A maxBytes
+// value <= 0 means no byte limit is applied.
+//
+// NOTE: SelectTxs is only an extension point, which nothing in the SDK calls.
+// Tendermint v0.34 has no PrepareProposal ABCI method and always builds blocks
+// from its own mempool, so the app-side mempool doesn't affect the blocks built.
+func (app *BaseApp) SelectTxs(maxBytes int64) ([][]byte, error) {
+	if app.txEncoder == nil {
+		return nil, errors.New("cannot select txs from mempool: no TxEncoder set on BaseApp")
+	}
+
+	ctx := app.checkState.ctx
+	maxGas := app.getMaximumBlockGas(ctx)
+
+	var (
+		txs        [][]byte
+		totalBytes int64
+		totalGas   uint64
+	)
+
+	for it := app.mempool.Select(ctx, nil); it != nil; it = it.Next() {
+		bz, err := app.txEncoder(it.Tx())
+		if err != nil {
+			return nil, err
+		}
+
+		if maxBytes > 0 && totalBytes+int64(len(bz)) > maxBytes {
+			break
+		}
+
+		if feeTx, ok := it.Tx().(sdk.FeeTx); ok && maxGas > 0 {
+			if totalGas+feeTx.GetGas() > maxGas {
+				break
+			}
+
+			totalGas += feeTx.GetGas()
+		}
+
+		totalBytes += int64(len(bz))
+		txs = append(txs, bz)
+	}
+
+	return txs, nil
+}
+
 // DeliverTx implements the ABCI interface and executes a tx in DeliverTx mode.
 // State only gets persisted if all messages are valid and get executed successfully.
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+}
+
+// deliverTxResponse builds the ResponseDeliverTx of a transaction executed in
+// DeliverTx mode, records its telemetry and calls the DeliverTx listening hooks.
+func (app *BaseApp) deliverTxResponse(
+	req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error,
+) (res abci.ResponseDeliverTx) {
 	resultStr := "successful"
 
+	if app.witness != nil {
+		app.witness.witness.Txs = append(app.witness.witness.Txs, req.Tx)
+	}
+
 	defer func() {
 		telemetry.IncrCounter(1, "tx", "count")
 		telemetry.IncrCounter(1, "tx", resultStr)
@@ -289,7 +367,13 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	defer func() {
+		// call the hooks with the DeliverTx messages
+		app.callABCIListeners("DeliverTx", app.deliverState.ctx.BlockHeight(), func(listener ABCIListener) error {
+			return listener.ListenDeliverTx(app.deliverState.ctx, req, res)
+		})
+	}()
+
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -315,10 +399,26 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	_, span := startSpan(app.deliverState.ctx, "Commit")
+	defer func() {
+		span.End()
+		if app.blockSpan != nil {
+			app.blockSpan.End()
+			app.blockSpan = nil
+		}
+	}()
+
+	// Wait for the listeners to acknowledge the block before persisting it, so
+	// that a block they did not receive is replayed on restart.
+	app.waitABCIListeners(app.deliverState.ctx)
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	app.deliverState.ms.Write()
+	if app.witness != nil {
+		app.commitWitness()
+	}
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,14 +11,19 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"go.opentelemetry.io/otel/trace"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/history"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/witness"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -55,19 +61,26 @@ type BaseApp struct { //nolint: maligned
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry codectypes.InterfaceRegistry
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
-
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	postHandler    sdk.AnteHandler  // post handler, optional, e.g. for tips
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
+
+	mempool         mempool.Mempool  // application side mempool
+	anteHandler     sdk.AnteHandler  // ante handler for fee and auth
+	postHandler     sdk.AnteHandler  // post handler, optional, e.g. for tips
+	initChainer     sdk.InitChainer  // initialize state with validators and state blob
+	beginBlocker    sdk.BeginBlocker // logic to run before any txs
+	endBlocker      sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
+	gasScheduler    sdk.GasScheduler // gas schedule of the KVStores, optional
+	stateOverriders []StateOverrider // overriders of the state of simulations, optional
+	addrPeerFilter  sdk.PeerFilter   // filter peers by address and port
+	idPeerFilter    sdk.PeerFilter   // filter peers by node ID
+	fauxMerkleMode  bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// saves the witnesses of the blocks, optional
+	witnessStore *witness.Store
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -81,6 +94,13 @@ type BaseApp struct { //nolint: maligned
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
+	// witness records the witness of the block being executed, if witnesses
+	// are saved
+	witness *witnessRecorder
+
+	// blockSpan is the tracing span of the block being executed, ended on Commit
+	blockSpan trace.Span
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -132,7 +152,12 @@ type BaseApp struct { //nolint: maligned
 
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
-	abciListeners []ABCIListener
+	abciListeners []abciListener
+
+	// parallelDeliverTxWorkers is the number of workers used by DeliverTxs to
+	// execute the transactions of a block speculatively. A value lower than 2
+	// disables parallel execution.
+	parallelDeliverTxWorkers int
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -155,6 +180,7 @@ func NewBaseApp(
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
+		mempool:          mempool.NoOpMempool{},
 	}
 
 	for _, option := range options {
@@ -283,11 +309,9 @@ func DefaultStoreLoader(ms sdk.CommitMultiStore) error {
 
 // CommitMultiStore returns the root multi-store.
 // App constructor can use this to access the `cms`.
-// UNSAFE: only safe to use during app initialization.
+// UNSAFE: must not be used during the ABCI life cycle, i.e. only by the app
+// constructor and by the commands operating on the state of a stopped node.
 func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
-	if app.sealed {
-		panic("cannot call CommitMultiStore() after baseapp is sealed")
-	}
 	return app.cms
 }
 
@@ -331,11 +355,7 @@ func (app *BaseApp) Init() error {
 	app.setCheckState(tmproto.Header{})
 	app.Seal()
 
-	rms, ok := app.cms.(*rootmulti.Store)
-	if !ok {
-		return fmt.Errorf("invalid commit multi-store; expected %T, got: %T", &rootmulti.Store{}, app.cms)
-	}
-	return rms.GetPruning().Validate()
+	return app.cms.GetPruning().Validate()
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
@@ -354,6 +374,43 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+func (app *BaseApp) setHistory(history *history.Store) {
+	if history == nil {
+		return
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("state history requires a %T commit multi-store, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	rms.SetHistory(history)
+}
+
+func (app *BaseApp) setStorePruning(opts map[string]pruningtypes.PruningOptions) {
+	if len(opts) == 0 {
+		return
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("store pruning requires a %T commit multi-store, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	for name, storeOpts := range opts {
+		rms.SetStorePruning(name, storeOpts)
+	}
+}
+
+func (app *BaseApp) setStoreMetrics(names []string) {
+	if len(names) == 0 {
+		return
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("store metrics require a %T commit multi-store, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	for _, name := range names {
+		rms.EnableMetrics(name)
+	}
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -398,7 +455,7 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ctx: app.withGasSchedule(sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices)),
 	}
 }
 
@@ -407,11 +464,27 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 // and provided header. It is set on InitChain and BeginBlock and set to nil on
 // Commit.
 func (app *BaseApp) setDeliverState(header tmproto.Header) {
-	ms := app.cms.CacheMultiStore()
+	var ms sdk.CacheMultiStore
+	if app.witnessStore != nil && app.LastBlockHeight() > 0 {
+		// the blocks executed on the state of InitChain have no witness, as
+		// it is not committed
+		ms = app.recordWitness()
+	} else {
+		ms = app.cms.CacheMultiStore()
+	}
 	app.deliverState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger),
+		ctx: app.withGasSchedule(sdk.NewContext(ms, header, false, app.logger)),
+	}
+}
+
+// withGasSchedule returns the context with the gas schedule of the state it reads
+// from, if a gas scheduler is set, so that the schedule is fixed for the block.
+func (app *BaseApp) withGasSchedule(ctx sdk.Context) sdk.Context {
+	if app.gasScheduler == nil {
+		return ctx
 	}
+	return ctx.WithGasSchedule(app.gasScheduler(ctx))
 }
 
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
@@ -593,12 +666,33 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	ctx := app.getContextForTx(mode, txBytes)
+	if mode == runTxModeDeliver {
+		var span trace.Span
+		ctx, span = startTxSpan(ctx, "DeliverTx", txBytes)
+		defer func() { endTxSpan(span, gInfo, err) }()
+	}
+
+	return app.runTxWithContext(ctx, mode, txBytes)
+}
+
+// removeFromMempool removes a tx from the app-side mempool. The mempool is local
+// to each node, so failing to remove the tx is logged and must not affect the
+// result of the tx, which has to be the same on every node.
+func (app *BaseApp) removeFromMempool(tx sdk.Tx) {
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		app.logger.Error("failed to remove tx from mempool", "err", err)
+	}
+}
+
+// runTxWithContext is the implementation of runTx executing the transaction
+// against the provided Context instead of the one of the mode's state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -679,14 +773,48 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			if mode == runTxModeReCheck {
+				// The tx is no longer valid and will be evicted from Tendermint's
+				// mempool, so it must not be proposed from the app-side mempool.
+				app.removeFromMempool(tx)
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
 		priority = ctx.Priority()
+
+		// The tx is inserted before the AnteHandler state is written, so that a
+		// tx rejected by the mempool doesn't bump the sequences of its signers in
+		// checkState, which would fail their next txs.
+		if mode == runTxModeCheck {
+			if err := app.mempool.Insert(ctx, tx); err != nil {
+				return gInfo, nil, nil, priority, err
+			}
+		}
+
 		msCache.Write()
 		anteEvents = events.ToABCIEvents()
 	}
 
+	switch mode {
+	case runTxModeCheck:
+		if app.anteHandler == nil {
+			if err := app.mempool.Insert(ctx, tx); err != nil {
+				return gInfo, nil, nil, priority, err
+			}
+		}
+
+	case runTxModeDeliver:
+		// A speculative execution may be discarded, so the tx is only removed
+		// once its execution is committed.
+		if stx, ok := ctx.Value(speculativeTxKey{}).(*speculativeTx); ok {
+			stx.tx = tx
+		} else {
+			app.removeFromMempool(tx)
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -709,11 +837,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
 
-		if mode == runTxModeDeliver {
+		switch mode {
+		case runTxModeDeliver:
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
 			msCache.Write()
+
+		case runTxModeSimulate:
+			// The simulation runs on a branch of the state, which is written so
+			// that the next transactions of a simulated bundle observe it.
+			msCache.Write()
 		}
 
 		if len(anteEvents) > 0 && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
@@ -765,7 +899,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(withMsgGasSchedule(ctx, sdk.MsgTypeURL(msg)), msg)
 		} else {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
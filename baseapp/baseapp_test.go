@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -22,15 +24,18 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -530,6 +535,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetGasScheduler(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -966,6 +974,178 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// counterMempool is a mempool that orders txTest transactions by descending
+// counter.
+type counterMempool struct {
+	txs map[int64]sdk.Tx
+	// removeErr is returned by Remove if set
+	removeErr error
+	// maxTxs bounds the number of txs if positive
+	maxTxs int
+}
+
+func (mp *counterMempool) Insert(_ sdk.Context, tx sdk.Tx) error {
+	if mp.maxTxs > 0 && len(mp.txs) >= mp.maxTxs {
+		return mempool.ErrMempoolTxMaxCapacity
+	}
+	mp.txs[tx.(txTest).Counter] = tx
+	return nil
+}
+
+func (mp *counterMempool) Select(_ sdk.Context, _ [][]byte) mempool.Iterator {
+	txs := make([]sdk.Tx, 0, len(mp.txs))
+	for _, tx := range mp.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].(txTest).Counter > txs[j].(txTest).Counter })
+
+	return (&counterIterator{txs: txs, pos: -1}).Next()
+}
+
+func (mp *counterMempool) CountTx() int { return len(mp.txs) }
+
+func (mp *counterMempool) Remove(tx sdk.Tx) error {
+	if mp.removeErr != nil {
+		return mp.removeErr
+	}
+	if _, ok := mp.txs[tx.(txTest).Counter]; !ok {
+		return mempool.ErrTxNotFound
+	}
+	delete(mp.txs, tx.(txTest).Counter)
+	return nil
+}
+
+type counterIterator struct {
+	txs []sdk.Tx
+	pos int
+}
+
+func (it *counterIterator) Next() mempool.Iterator {
+	if it.pos+1 >= len(it.txs) {
+		return nil
+	}
+	return &counterIterator{txs: it.txs, pos: it.pos + 1}
+}
+
+func (it *counterIterator) Tx() sdk.Tx { return it.txs[it.pos] }
+
+func TestMempool(t *testing.T) {
+	mp := &counterMempool{txs: make(map[int64]sdk.Tx)}
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if tx.(txTest).FailOnAnte {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	app.SetTxEncoder(aminoTxEncoder())
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nTxs := int64(3)
+	txs := make([][]byte, nTxs)
+	for i := int64(0); i < nTxs; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		txs[i] = txBytes
+
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+
+	// a tx failing the AnteHandler is not inserted
+	failTx := newTxCounter(nTxs, nTxs)
+	failTx.setFailOnAnte(true)
+	failTxBytes, err := codec.Marshal(failTx)
+	require.NoError(t, err)
+	r := app.CheckTx(abci.RequestCheckTx{Tx: failTxBytes})
+	require.False(t, r.IsOK())
+	require.Equal(t, int(nTxs), mp.CountTx())
+
+	// selected txs follow the mempool ordering and respect the byte limit
+	selected, err := app.SelectTxs(0)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{txs[2], txs[1], txs[0]}, selected)
+
+	selected, err = app.SelectTxs(int64(len(txs[2]) + len(txs[1])))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{txs[2], txs[1]}, selected)
+
+	// delivered txs are removed from the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	for _, txBytes := range selected {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, int64(0), mp.Select(sdk.Context{}, nil).Tx().(txTest).Counter)
+
+	// failing to remove a tx from the mempool doesn't affect its result
+	mp.removeErr = errors.New("mempool failure")
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txs[0]})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, 1, mp.CountTx())
+}
+
+// Test that a tx rejected by a full mempool leaves the CheckTx state
+// untouched, so that the next txs of its sender still pass CheckTx.
+func TestMempoolFull(t *testing.T) {
+	mp := &counterMempool{txs: make(map[int64]sdk.Tx), maxTxs: 1}
+
+	// the counter stored by the AnteHandler acts as the sequence of the sender
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	checkTx := func(counter int64) abci.ResponseCheckTx {
+		txBytes, err := codec.Marshal(newTxCounter(counter, counter))
+		require.NoError(t, err)
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	}
+
+	r := checkTx(0)
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+
+	// the mempool is full
+	r = checkTx(1)
+	require.False(t, r.IsOK())
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, int64(1), getIntFromStore(app.checkState.ctx.KVStore(capKey1), counterKey))
+
+	// the same tx passes once the mempool has room again
+	mp.maxTxs = 2
+	r = checkTx(1)
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 2, mp.CountTx())
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1014,6 +1194,62 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestGasScheduler(t *testing.T) {
+	scheduleKey := []byte("schedule-key")
+	var anteSchedule, msgSchedule sdk.GasSchedule
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			anteSchedule = ctx.GasSchedule()
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			msgSchedule = ctx.GasSchedule()
+			setIntOnStore(ctx.KVStore(capKey1), scheduleKey, msg.(*msgCounter).Counter)
+			return &sdk.Result{}, nil
+		}))
+	}
+	// the schedule is read from the state
+	schedulerOpt := func(bapp *BaseApp) {
+		bapp.SetGasScheduler(func(ctx sdk.Context) sdk.GasSchedule {
+			cost := getIntFromStore(ctx.KVStore(capKey1), scheduleKey)
+			return sdk.GasSchedule{StoreConfigs: map[string]sdk.GasConfig{capKey1.Name(): {ReadCostFlat: uint64(cost)}}}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, schedulerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+	readCost := func(schedule sdk.GasSchedule) sdk.Gas {
+		return schedule.KVGasConfig(capKey1).ReadCostFlat
+	}
+
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		txBytes, err := codec.Marshal(newTxCounter(0, height*10))
+		require.NoError(t, err)
+
+		// the schedule written in a block applies from the next block
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		require.Equal(t, uint64(height-1)*10, readCost(anteSchedule))
+		require.Equal(t, "", anteSchedule.MsgTypeURL())
+		require.Equal(t, uint64(height-1)*10, readCost(msgSchedule))
+		require.Equal(t, sdk.MsgTypeURL(&msgCounter{}), msgSchedule.MsgTypeURL())
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		// the schedule of CheckTx is read from the committed state
+		res2 := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res2.IsOK(), fmt.Sprintf("%v", res2))
+		require.Equal(t, uint64(height)*10, readCost(anteSchedule))
+	}
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1648,6 +1884,14 @@ func TestGasConsumptionBadTx(t *testing.T) {
 
 // Test that we can only query from the latest committed state.
 func TestQuery(t *testing.T) {
+	testQuery(t)
+}
+
+func TestQuery_StoreV2(t *testing.T) {
+	testQuery(t, SetCMS(multi.NewAdapter(memdb.NewDB(), multi.DefaultStoreConfig())))
+}
+
+func testQuery(t *testing.T, options ...func(*BaseApp)) {
 	key, value := []byte("hello"), []byte("goodbye")
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1666,7 +1910,7 @@ func TestQuery(t *testing.T) {
 		bapp.Router().AddRoute(r)
 	}
 
-	app := setupBaseApp(t, anteOpt, routerOpt)
+	app := setupBaseApp(t, append(options, anteOpt, routerOpt)...)
 
 	app.InitChain(abci.RequestInitChain{})
 
@@ -0,0 +1,149 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/batch"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// batchQueryPath is the path of the Batch method, which cannot be executed
+// within a batch.
+const batchQueryPath = "/cosmos.base.batch.v1beta1.Service/Batch"
+
+// batchQueryServer executes the queries of a batch with the handlers of the
+// gRPC query router of a BaseApp.
+type batchQueryServer struct {
+	app *BaseApp
+}
+
+var _ batch.ServiceServer = batchQueryServer{}
+
+// Batch implements the Batch method of the batch ServiceServer interface. The
+// queries are executed against the Context of the batch query, i.e. against the
+// state of a single height. If proofs are requested, the reads of the queries
+// are recorded and each key read from a committed store is proven against the
+// state of the height once all the queries are executed.
+func (s batchQueryServer) Batch(goCtx context.Context, req *batch.BatchRequest) (*batch.BatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height := ctx.BlockHeight()
+
+	var (
+		keys      []storetypes.StoreKey
+		stores    map[storetypes.StoreKey]*rwset.Store
+		queryable sdk.Queryable
+	)
+	if req.Prove {
+		if height <= 1 {
+			return nil, status.Error(codes.InvalidArgument, "cannot query with proof when height <= 1; please provide a valid height")
+		}
+
+		var ok bool
+		keys = s.app.storeKeys()
+		queryable, ok = s.app.cms.(sdk.Queryable)
+		if keys == nil || !ok {
+			return nil, status.Error(codes.FailedPrecondition, "the multistore doesn't support proving batch queries")
+		}
+
+		stores = make(map[storetypes.StoreKey]*rwset.Store, len(keys))
+		branchStores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+		for _, key := range keys {
+			store := rwset.NewStore(ctx.MultiStore().GetKVStore(key))
+			stores[key] = store
+			branchStores[key] = store
+		}
+		ctx = ctx.WithMultiStore(cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, branchStores, nil, nil, nil, nil))
+	}
+
+	res := &batch.BatchResponse{
+		Height:  height,
+		Results: make([]*batch.QueryResult, len(req.Queries)),
+	}
+	for i, query := range req.Queries {
+		res.Results[i] = s.query(ctx, height, query)
+	}
+
+	for _, key := range keys {
+		// only the committed stores can be proven
+		if _, ok := key.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+
+		for _, readKey := range stores[key].ReadKeys() {
+			proof, err := proveKey(queryable, height, key.Name(), readKey)
+			if err != nil {
+				return nil, err
+			}
+			res.Proofs = append(res.Proofs, proof)
+		}
+	}
+
+	return res, nil
+}
+
+// query executes a query of a batch. Its errors, including panics, are returned
+// in its result rather than failing the batch.
+func (s batchQueryServer) query(ctx sdk.Context, height int64, query *batch.Query) (res *batch.QueryResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = s.errorResult(sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r))
+		}
+	}()
+
+	if query.Path == batchQueryPath {
+		return s.errorResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot nest batch queries"))
+	}
+
+	handler := s.app.grpcQueryRouter.Route(query.Path)
+	if handler == nil {
+		return s.errorResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", query.Path))
+	}
+
+	resp, err := handler(ctx, abci.RequestQuery{Data: query.Data, Path: query.Path, Height: height})
+	if err != nil {
+		return s.errorResult(gRPCErrorToSDKError(err))
+	}
+
+	return &batch.QueryResult{Value: resp.Value}
+}
+
+func (s batchQueryServer) errorResult(err error) *batch.QueryResult {
+	codespace, code, log := sdkerrors.ABCIInfo(err, s.app.trace)
+	return &batch.QueryResult{Code: code, Codespace: codespace, Log: log}
+}
+
+// proveKey returns the proof of the existence or absence of a key in a store at
+// the given height.
+func proveKey(queryable sdk.Queryable, height int64, storeName string, key []byte) (*batch.StoreProof, error) {
+	res := queryable.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if !res.IsOK() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ABCIError(res.Codespace, res.Code, res.Log), "failed to prove key %X of store %s", key, storeName)
+	}
+
+	return &batch.StoreProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     res.Value,
+		ProofOps:  res.ProofOps,
+	}, nil
+}
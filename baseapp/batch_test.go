@@ -0,0 +1,130 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/batch"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// storeQueryServer echoes the value of the key of the message in the first
+// store.
+type storeQueryServer struct {
+	testdata.QueryImpl
+}
+
+func (storeQueryServer) Echo(goCtx context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	value := ctx.KVStore(capKey1).Get([]byte(req.Message))
+	if value == nil {
+		panic("missing key")
+	}
+
+	return &testdata.EchoResponse{Message: string(value)}, nil
+}
+
+func setupBatchQueryApp(t *testing.T) *BaseApp {
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), storeQueryServer{})
+	})
+	app.SetInterfaceRegistry(codectypes.NewInterfaceRegistry())
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.deliverState.ms.GetKVStore(capKey1).Set([]byte("a"), []byte{byte('0' + height)})
+		app.deliverState.ms.GetKVStore(capKey1).Set([]byte("b"), []byte("value"))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	return app
+}
+
+func batchQuery(t *testing.T, app *BaseApp, height int64, prove bool, queries ...*batch.Query) (*batch.BatchResponse, abci.ResponseQuery) {
+	req, err := (&batch.BatchRequest{Queries: queries, Prove: prove}).Marshal()
+	require.NoError(t, err)
+
+	resQuery := app.Query(abci.RequestQuery{Path: batchQueryPath, Data: req, Height: height})
+	if !resQuery.IsOK() {
+		return nil, resQuery
+	}
+
+	var res batch.BatchResponse
+	require.NoError(t, res.Unmarshal(resQuery.Value))
+	return &res, resQuery
+}
+
+func echoQuery(t *testing.T, message string) *batch.Query {
+	data, err := (&testdata.EchoRequest{Message: message}).Marshal()
+	require.NoError(t, err)
+
+	return &batch.Query{Path: "/testdata.Query/Echo", Data: data}
+}
+
+func TestBatchQuery(t *testing.T) {
+	app := setupBatchQueryApp(t)
+
+	res, _ := batchQuery(t, app, 1, false,
+		echoQuery(t, "a"),
+		echoQuery(t, "missing"),
+		&batch.Query{Path: "/testdata.Query/Unknown"},
+		&batch.Query{Path: batchQueryPath},
+	)
+	require.Equal(t, int64(1), res.Height)
+	require.Empty(t, res.Proofs)
+	require.Len(t, res.Results, 4)
+
+	// the queries run against the state of the height of the batch
+	var echo testdata.EchoResponse
+	require.Zero(t, res.Results[0].Code)
+	require.NoError(t, echo.Unmarshal(res.Results[0].Value))
+	require.Equal(t, "1", echo.Message)
+
+	// the errors of a query don't fail the batch
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.Results[1].Code)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Results[2].Code)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Results[3].Code)
+	require.Empty(t, res.Results[3].Value)
+
+	// proofs cannot be requested at height 1
+	_, resQuery := batchQuery(t, app, 1, true, echoQuery(t, "a"))
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code)
+}
+
+func TestBatchQueryProofs(t *testing.T) {
+	app := setupBatchQueryApp(t)
+	appHash := app.LastCommitID().Hash
+
+	res, _ := batchQuery(t, app, 0, true, echoQuery(t, "a"), echoQuery(t, "missing"), echoQuery(t, "a"))
+	require.Equal(t, int64(2), res.Height)
+	require.Len(t, res.Results, 3)
+	require.Zero(t, res.Results[0].Code)
+	require.Equal(t, res.Results[0].Value, res.Results[2].Value)
+
+	// each key read is proven once, including those read by failed queries
+	require.Len(t, res.Proofs, 2)
+	require.Equal(t, capKey1.Name(), res.Proofs[0].StoreName)
+	require.Equal(t, []byte("a"), res.Proofs[0].Key)
+	require.Equal(t, []byte("2"), res.Proofs[0].Value)
+	require.Equal(t, []byte("missing"), res.Proofs[1].Key)
+	require.Empty(t, res.Proofs[1].Value)
+
+	for _, proof := range res.Proofs {
+		require.NoError(t, proof.Verify(appHash))
+	}
+
+	// the proofs don't verify other values
+	res.Proofs[0].Value = []byte("1")
+	require.Error(t, res.Proofs[0].Verify(appHash))
+	res.Proofs[1].Value = []byte("value")
+	require.Error(t, res.Proofs[1].Verify(appHash))
+}
@@ -106,8 +106,8 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
+		msr.routes[requestTypeName] = traceMsgServiceHandler(requestTypeName, func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			ctx = withMsgGasSchedule(ctx, requestTypeName).WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 				return handler(goCtx, req)
@@ -125,10 +125,19 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			}
 
 			return sdk.WrapServiceResult(ctx, resMsg, err)
-		}
+		})
 	}
 }
 
+// withMsgGasSchedule returns the context executing a message of the type URL. The
+// KVStores are charged the gas configs scheduled for the message type, and their
+// metrics are labelled by it. As the routed handlers set it, the messages
+// dispatched by other messages, such as the authz MsgExec, are charged their own
+// gas configs.
+func withMsgGasSchedule(ctx sdk.Context, msgTypeURL string) sdk.Context {
+	return ctx.WithGasSchedule(ctx.GasSchedule().ForMsg(msgTypeURL)).WithMsgTypeURL(msgTypeURL)
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -6,22 +6,43 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/batch"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/history"
+	"github.com/cosmos/cosmos-sdk/store/witness"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
 // for options that need access to non-exported fields of the BaseApp
 
+// SetCMS sets the CommitMultiStore of the app, in place of the rootmulti store backed by the app DB.
+// It must precede the options configuring the multistore.
+func SetCMS(cms store.CommitMultiStore) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetCMS(cms) }
+}
+
 // SetPruning sets a pruning option on the multistore associated with the app
 func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning options of the stores pruned differently from the
+// rest of the multistore, by store key name.
+func SetStorePruning(opts map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setStorePruning(opts) }
+}
+
+// SetStoreMetrics enables the metrics of the operations on the stores with the given key names.
+func SetStoreMetrics(names []string) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setStoreMetrics(names) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -70,11 +91,36 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetHistory sets the state history DB serving the queries at the heights pruned from the
+// IAVL stores.
+func SetHistory(history *history.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.setHistory(history) }
+}
+
+// SetWitnessStore sets the DB saving the witnesses of the blocks executed by the app.
+func SetWitnessStore(ws *witness.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.setWitnessStore(ws) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetMempool sets the application-side mempool on the BaseApp.
+func SetMempool(mp mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mp) }
+}
+
+// SetParallelDeliverTx sets the number of workers used by DeliverTxs to execute
+// the transactions of a block optimistically in parallel. A value lower than 2
+// disables parallel execution. As the DeliverTx ABCI method executes one
+// transaction at a time, it has no effect on the blocks delivered by Tendermint,
+// which is why no app.toml option sets it.
+func SetParallelDeliverTx(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.parallelDeliverTxWorkers = workers }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -115,7 +161,7 @@ func (app *BaseApp) SetDB(db dbm.DB) {
 
 func (app *BaseApp) SetCMS(cms store.CommitMultiStore) {
 	if app.sealed {
-		panic("SetEndBlocker() on sealed BaseApp")
+		panic("SetCMS() on sealed BaseApp")
 	}
 
 	app.cms = cms
@@ -145,6 +191,27 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+// SetGasScheduler sets the scheduler of the gas configs charged by the KVStores,
+// which reads the schedule from the state at the beginning of each block, and
+// when the state of CheckTx is reset on Commit.
+func (app *BaseApp) SetGasScheduler(gs sdk.GasScheduler) {
+	if app.sealed {
+		panic("SetGasScheduler() on sealed BaseApp")
+	}
+
+	app.gasScheduler = gs
+}
+
+// AddStateOverrider adds an overrider of the state the bundles of transactions
+// are simulated against, which applies the overrides handled by a module.
+func (app *BaseApp) AddStateOverrider(o StateOverrider) {
+	if app.sealed {
+		panic("AddStateOverrider() on sealed BaseApp")
+	}
+
+	app.stateOverriders = append(app.stateOverriders, o)
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -208,12 +275,13 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	app.router = router
 }
 
-// SetSnapshot sets the snapshot store and options.
+// SetSnapshot sets the snapshot store and options. Snapshots are only taken if the snapshot interval
+// is not 0, but the snapshots of the store are served and can be restored regardless.
 func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) {
 	if app.sealed {
 		panic("SetSnapshot() on sealed BaseApp")
 	}
-	if snapshotStore == nil || opts.Interval == snapshottypes.SnapshotIntervalOff {
+	if snapshotStore == nil {
 		app.snapshotManager = nil
 		return
 	}
@@ -221,25 +289,55 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
-// SetInterfaceRegistry sets the InterfaceRegistry.
+// SetInterfaceRegistry sets the InterfaceRegistry. This will also register the
+// batch query gRPC service.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
 	app.grpcQueryRouter.SetInterfaceRegistry(registry)
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
+	batch.RegisterServiceServer(app.grpcQueryRouter, batchQueryServer{app: app})
 }
 
-// SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
+// SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore.
+// The errors returned by its listening hooks are logged.
 func (app *BaseApp) SetStreamingService(s StreamingService) {
+	app.SetStreamingServiceWithPolicy(s, ListenerPolicyFireAndForget)
+}
+
+// SetStreamingServiceWithPolicy is used to set a streaming service into the BaseApp hooks and load the listeners into
+// the multistore, applying the given ListenerPolicy to the errors returned by its listening hooks. It panics if the
+// ListenerPolicyBlockCommit policy is requested for a streaming service which does not implement CommitListener.
+func (app *BaseApp) SetStreamingServiceWithPolicy(s StreamingService, policy ListenerPolicy) {
+	if _, ok := s.(CommitListener); policy == ListenerPolicyBlockCommit && !ok {
+		panic(fmt.Sprintf("streaming service %T must implement CommitListener to use the %s policy", s, policy))
+	}
+
 	// add the listeners for each StoreKey
 	for key, lis := range s.Listeners() {
 		app.cms.AddListeners(key, lis)
 	}
 	// register the StreamingService within the BaseApp
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
-	app.abciListeners = append(app.abciListeners, s)
+	app.abciListeners = append(app.abciListeners, abciListener{ABCIListener: s, policy: policy})
 }
 
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
+
+// SetTxEncoder sets the TxEncoder used to encode the transactions selected
+// from the app-side mempool.
+func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
+	app.txEncoder = txEncoder
+}
+
+// SetMempool sets the application-side mempool used by CheckTx, DeliverTx and
+// block construction.
+func (app *BaseApp) SetMempool(mp mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mp
+}
@@ -0,0 +1,239 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// speculativeTxKey is the Context key under which a speculative execution
+// carries its speculativeTx.
+type speculativeTxKey struct{}
+
+// speculativeTx holds the outcome of a transaction executed speculatively
+// against the state left by BeginBlock.
+type speculativeTx struct {
+	// tx is the decoded transaction, set once it passed the AnteHandler so that
+	// it is removed from the app-side mempool when the execution is committed.
+	tx sdk.Tx
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+
+	// stores holds the read and write sets of the transaction, per store.
+	stores map[storetypes.StoreKey]*rwset.Store
+
+	// blockGasUsed is the gas the transaction consumed from the block gas meter.
+	blockGasUsed uint64
+
+	// ctxGasUsed is the gas the transaction consumed from the gas meter of the
+	// block context, which is only the case if no AnteHandler replaced it.
+	ctxGasUsed uint64
+
+	// aborted is set if the execution panicked outside of runTx's recovery.
+	aborted bool
+}
+
+// DeliverTxs executes the transactions of a block in order, as if DeliverTx
+// was called for each of them, and returns their responses. It must be called
+// between BeginBlock and EndBlock, in place of DeliverTx.
+//
+// DeliverTxs is a library API only: Tendermint v0.34 delivers the transactions
+// of a block one at a time through the DeliverTx ABCI method, so BaseApp never
+// calls DeliverTxs itself and a node run with the start command can't execute
+// blocks in parallel. It is meant for applications and tools executing whole
+// blocks at once, such as a custom ABCI wrapper buffering the transactions of a
+// block or a block replay.
+//
+// When parallel execution is enabled with SetParallelDeliverTx, transactions
+// are first executed speculatively and concurrently against the state left by
+// BeginBlock, each on its own branch recording its read and write sets. The
+// results are then validated in block order: a transaction whose reads are not
+// affected by the transactions preceding it gets its writes applied as is,
+// otherwise it is re-executed in order. The resulting state, and thus the
+// AppHash, is the same as with sequential execution.
+//
+// NOTE: Parallel execution requires the AnteHandler and message handlers to be
+// safe for concurrent use and to only keep state in the stores reachable from
+// the Context. Transactions are removed from the app-side mempool in block
+// order, once their execution is committed. Events emitted directly on the block
+// Context, rather than on the Context of a message handler, are not carried
+// over from one transaction to the next.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	keys := app.storeKeys()
+	if app.parallelDeliverTxWorkers <= 1 || len(reqs) < 2 || keys == nil {
+		res := make([]abci.ResponseDeliverTx, len(reqs))
+		for i, req := range reqs {
+			res[i] = app.DeliverTx(req)
+		}
+
+		return res
+	}
+
+	ctxGasMeter := app.deliverState.ctx.GasMeter()
+	ctxGasSeed := ctxGasMeter.GasConsumed()
+
+	stxs := make([]*speculativeTx, len(reqs))
+	indexes := make(chan int, len(reqs))
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallelDeliverTxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				stxs[i] = app.runSpeculativeTx(keys, ctxGasSeed, reqs[i].Tx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		stx := stxs[i]
+		if !app.commitSpeculativeTx(keys, ctxGasSeed, stx, req.Tx) {
+			stx.gInfo, stx.result, stx.anteEvents, _, stx.err = app.runTx(runTxModeDeliver, req.Tx)
+		}
+
+		res[i] = app.deliverTxResponse(req, stx.gInfo, stx.result, stx.anteEvents, stx.err)
+	}
+
+	return res
+}
+
+// runSpeculativeTx executes a transaction in DeliverTx mode on a branch of the
+// block state whose stores record the read and write sets of the transaction.
+// Nothing is written to the block state.
+func (app *BaseApp) runSpeculativeTx(keys []storetypes.StoreKey, ctxGasSeed uint64, txBytes []byte) (stx *speculativeTx) {
+	stx = &speculativeTx{stores: make(map[storetypes.StoreKey]*rwset.Store, len(keys))}
+
+	defer func() {
+		if r := recover(); r != nil {
+			stx.aborted = true
+		}
+	}()
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	metrics := make(map[string]bool)
+	for _, key := range keys {
+		store := rwset.NewStore(app.deliverState.ms.GetKVStore(key))
+		stx.stores[key] = store
+		stores[key] = store
+		if storetypes.MetricsEnabled(app.deliverState.ms, key) {
+			metrics[key.Name()] = true
+		}
+	}
+
+	branch := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, nil, nil, nil, nil).SetMetrics(metrics)
+
+	// The gas meters of the block Context are shared by all transactions, so
+	// each speculative execution works on a private copy of them.
+	gasMeter := sdk.NewInfiniteGasMeter()
+	gasMeter.ConsumeGas(ctxGasSeed, "block context gas")
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(branch).
+		WithGasMeter(gasMeter).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager()).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos).
+		WithValue(speculativeTxKey{}, stx)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	ctx, span := startTxSpan(ctx, "SpeculativeDeliverTx", txBytes)
+	defer func() { endTxSpan(span, stx.gInfo, stx.err) }()
+
+	stx.gInfo, stx.result, stx.anteEvents, _, stx.err = app.runTxWithContext(ctx, runTxModeDeliver, txBytes)
+
+	// flush the transaction's writes into the write sets
+	branch.Write()
+
+	stx.blockGasUsed = blockGasMeter.GasConsumed()
+	stx.ctxGasUsed = gasMeter.GasConsumed() - ctxGasSeed
+
+	return stx
+}
+
+// commitSpeculativeTx validates a speculatively executed transaction against
+// the current block state and, if its execution is still valid, applies its
+// writes and gas consumption. It returns false if the transaction must be
+// re-executed.
+func (app *BaseApp) commitSpeculativeTx(keys []storetypes.StoreKey, ctxGasSeed uint64, stx *speculativeTx, txBytes []byte) bool {
+	if stx.aborted {
+		return false
+	}
+
+	ctxGasMeter := app.deliverState.ctx.GasMeter()
+	if ctxGasMeter.GasConsumed() != ctxGasSeed {
+		return false
+	}
+
+	// A transaction running out of block gas fails and discards its writes.
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	if blockGasMeter.IsOutOfGas() || stx.blockGasUsed > blockGasMeter.Limit()-blockGasMeter.GasConsumed() {
+		return false
+	}
+
+	for _, key := range keys {
+		if !stx.stores[key].Validate(app.deliverState.ms.GetKVStore(key)) {
+			return false
+		}
+	}
+
+	// Write through a transaction branch so that tracing and listeners observe
+	// the writes as they would with sequential execution.
+	_, msCache := app.cacheTxContext(app.deliverState.ctx, txBytes)
+	for _, key := range keys {
+		if store := stx.stores[key]; store.HasWrites() {
+			store.WriteTo(msCache.GetKVStore(key))
+		}
+	}
+	msCache.Write()
+
+	blockGasMeter.ConsumeGas(stx.blockGasUsed, "block gas meter")
+	ctxGasMeter.ConsumeGas(stx.ctxGasUsed, "block context gas")
+
+	if stx.tx != nil {
+		app.removeFromMempool(stx.tx)
+	}
+
+	return true
+}
+
+// storeKeys returns the keys of all the stores mounted on the CommitMultiStore
+// sorted by name, or nil if the CommitMultiStore does not expose them.
+func (app *BaseApp) storeKeys() []storetypes.StoreKey {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil
+	}
+
+	keysByName := cms.StoreKeysByName()
+	keys := make([]storetypes.StoreKey, 0, len(keysByName))
+	for _, key := range keysByName {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	return keys
+}
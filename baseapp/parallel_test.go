@@ -0,0 +1,156 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestDeliverTxsParallel(t *testing.T) {
+	sumKey := []byte("sum")
+
+	// like the auth AnteHandler, give each tx its own gas meter
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(1_000_000)), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		// every counter message updates the same key, so counter txs conflict
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(*msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, sumKey, getIntFromStore(store, sumKey)+m.Counter)
+			ctx.EventManager().EmitEvents(counterEvent(sdk.EventTypeMessage, m.Counter))
+
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		}))
+		// key-value messages write distinct keys and do not conflict
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nBlocks := 3
+	txPerHeight := 20
+	blocks := make([][]abci.RequestDeliverTx, nBlocks)
+	for blockN := range blocks {
+		for i := 0; i < txPerHeight; i++ {
+			var tx sdk.Tx
+			switch {
+			case i%5 == 0:
+				counterTx := newTxCounter(int64(i), int64(i))
+				counterTx.setFailOnHandler(i%10 == 0)
+				tx = *counterTx
+			case i%3 == 0:
+				tx = *newTxCounter(int64(i), int64(i), int64(blockN))
+			default:
+				key := []byte(fmt.Sprintf("key-%d-%d", blockN, i))
+				tx = txTest{Msgs: []sdk.Msg{msgKeyValue{Key: key, Value: key}}}
+			}
+
+			txBytes, err := codec.Marshal(tx)
+			require.NoError(t, err)
+			blocks[blockN] = append(blocks[blockN], abci.RequestDeliverTx{Tx: txBytes})
+		}
+	}
+
+	run := func(workers int) ([][]abci.ResponseDeliverTx, []byte) {
+		app := setupBaseApp(t, anteOpt, routerOpt, SetParallelDeliverTx(workers))
+		app.InitChain(abci.RequestInitChain{})
+
+		var (
+			responses [][]abci.ResponseDeliverTx
+			appHash   []byte
+		)
+		for blockN, reqs := range blocks {
+			app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: int64(blockN) + 1}})
+			responses = append(responses, app.DeliverTxs(reqs))
+			app.EndBlock(abci.RequestEndBlock{})
+			appHash = app.Commit().Data
+		}
+
+		return responses, appHash
+	}
+
+	seqResponses, seqAppHash := run(1)
+	parResponses, parAppHash := run(4)
+
+	require.Equal(t, seqAppHash, parAppHash)
+	require.Equal(t, seqResponses, parResponses)
+
+	var failed int
+	for _, res := range seqResponses[0] {
+		if !res.IsOK() {
+			failed++
+		}
+	}
+	require.Equal(t, 2, failed)
+}
+
+func TestDeliverTxsParallelMempool(t *testing.T) {
+	mp := &counterMempool{txs: make(map[int64]sdk.Tx)}
+	stopKey := []byte("stop")
+
+	// the first tx makes the AnteHandler of the following ones fail, which
+	// their speculative executions do not see
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.KVStore(capKey1).Has(stopKey) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+			return ctx.WithGasMeter(sdk.NewGasMeter(1_000_000)), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey1).Set(stopKey, []byte{1})
+
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp), SetParallelDeliverTx(4))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nTxs := int64(10)
+	reqs := make([]abci.RequestDeliverTx, nTxs)
+	for i := int64(0); i < nTxs; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		reqs[i] = abci.RequestDeliverTx{Tx: txBytes}
+
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+	require.Equal(t, int(nTxs), mp.CountTx())
+
+	// only the tx whose execution passed the AnteHandler is removed
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTxs(reqs)
+	require.True(t, res[0].IsOK(), fmt.Sprintf("%v", res[0]))
+	for _, r := range res[1:] {
+		require.False(t, r.IsOK())
+	}
+	require.Equal(t, int(nTxs)-1, mp.CountTx())
+}
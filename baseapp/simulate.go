@@ -0,0 +1,124 @@
+package baseapp
+
+import (
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StateOverrider applies the overrides of the state a simulation runs against
+// which are handled by a module, e.g. the balances by the bank module.
+type StateOverrider func(ctx sdk.Context, overrides *txtypes.StateOverrides) error
+
+// SimulationResult is the result of a transaction simulated in a bundle.
+type SimulationResult struct {
+	GasInfo sdk.GasInfo
+	Result  *sdk.Result
+	Err     error
+}
+
+// SimulateBundle executes a bundle of transactions in simulate mode in sequence,
+// each against the state left by the previous ones. The bundle runs against the
+// state of the given height, or the state of CheckTx if the height is 0, with
+// the overrides applied. It returns the result of each transaction and the
+// changes of the state of the KVStores made by the bundle, not including the
+// overrides. Nothing is written to the state of the app.
+func (app *BaseApp) SimulateBundle(height int64, overrides *txtypes.StateOverrides, txs [][]byte) ([]SimulationResult, []*storetypes.StoreKVPair, error) {
+	keys := app.storeKeys()
+	if keys == nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the multistore doesn't support simulating bundles")
+	}
+
+	var ctx sdk.Context
+	if height == 0 {
+		ctx, _ = app.checkState.ctx.CacheContext()
+	} else {
+		var err error
+		ctx, err = app.createQueryContext(height, false)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := app.overrideState(ctx, keys, overrides); err != nil {
+		return nil, nil, err
+	}
+
+	// the writes of the bundle are recorded on top of the overridden state
+	stores := make(map[storetypes.StoreKey]*rwset.Store, len(keys))
+	branchStores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		store := rwset.NewStore(ctx.MultiStore().GetKVStore(key))
+		stores[key] = store
+		branchStores[key] = store
+	}
+	branch := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, branchStores, nil, nil, nil, nil)
+
+	ctx = ctx.WithMultiStore(branch).WithVoteInfos(app.voteInfos)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	results := make([]SimulationResult, len(txs))
+	for i, txBytes := range txs {
+		txCtx := ctx.WithTxBytes(txBytes).WithEventManager(sdk.NewEventManager())
+		results[i].GasInfo, results[i].Result, _, _, results[i].Err = app.runTxWithContext(txCtx, runTxModeSimulate, txBytes)
+	}
+	branch.Write()
+
+	var diff []*storetypes.StoreKVPair
+	for _, key := range keys {
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			diff = append(diff, stores[key].Changeset(key.Name())...)
+		}
+	}
+
+	return results, diff, nil
+}
+
+// overrideState applies the overrides to the state of the context, those handled
+// by the modules first, then the writes to the stores.
+func (app *BaseApp) overrideState(ctx sdk.Context, keys []storetypes.StoreKey, overrides *txtypes.StateOverrides) error {
+	if overrides == nil {
+		return nil
+	}
+
+	if (len(overrides.Balances) > 0 || len(overrides.Sequences) > 0) && len(app.stateOverriders) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the app doesn't support overriding balances and sequences")
+	}
+	for _, overrider := range app.stateOverriders {
+		if err := overrider(ctx, overrides); err != nil {
+			return err
+		}
+	}
+
+	keysByName := make(map[string]storetypes.StoreKey, len(keys))
+	for _, key := range keys {
+		keysByName[key.Name()] = key
+	}
+	for _, pair := range overrides.StoreKvPairs {
+		key, ok := keysByName[pair.StoreKey]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown store %s", pair.StoreKey)
+		}
+		if len(pair.Key) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty key overridden in store %s", pair.StoreKey)
+		}
+
+		store := ctx.MultiStore().GetKVStore(key)
+		if pair.Delete {
+			store.Delete(pair.Key)
+			continue
+		}
+		if pair.Value == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "nil value overridden in store %s", pair.StoreKey)
+		}
+		store.Set(pair.Key, pair.Value)
+	}
+
+	return nil
+}
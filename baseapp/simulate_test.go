@@ -0,0 +1,144 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestSimulateBundle(t *testing.T) {
+	sumKey := []byte("sum")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(1_000_000)), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(*msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, sumKey, getIntFromStore(store, sumKey)+m.Counter)
+			ctx.EventManager().EmitEvents(counterEvent(sdk.EventTypeMessage, m.Counter))
+
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+	marshal := func(tx sdk.Tx) []byte {
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: marshal(*newTxCounter(0, 5))})
+	require.True(t, res.IsOK(), res.Log)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	failingTx := newTxCounter(2, 7)
+	failingTx.setFailOnHandler(true)
+	bundle := [][]byte{
+		marshal(*newTxCounter(1, 3)),
+		marshal(*failingTx),
+		marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte("foo"), Value: []byte("bar")}}}),
+	}
+
+	// the sum is encoded as by setIntOnStore
+	encodeInt := func(i int64) []byte {
+		bz := make([]byte, binary.MaxVarintLen64)
+		return bz[:binary.PutVarint(bz, i)]
+	}
+	overriddenSum := encodeInt(100)
+
+	testCases := map[string]struct {
+		height    int64
+		overrides *txtypes.StateOverrides
+		sum       int64
+		expErr    bool
+	}{
+		"latest state": {
+			sum: 8,
+		},
+		"committed height": {
+			height: 1,
+			sum:    8,
+		},
+		"overridden store": {
+			overrides: &txtypes.StateOverrides{
+				StoreKvPairs: []*storetypes.StoreKVPair{{StoreKey: capKey1.Name(), Key: sumKey, Value: overriddenSum}},
+			},
+			sum: 103,
+		},
+		"future height": {
+			height: 2,
+			expErr: true,
+		},
+		"unknown store": {
+			overrides: &txtypes.StateOverrides{
+				StoreKvPairs: []*storetypes.StoreKVPair{{StoreKey: "unknown", Key: sumKey, Value: overriddenSum}},
+			},
+			expErr: true,
+		},
+		"balances without overrider": {
+			overrides: &txtypes.StateOverrides{
+				Balances: []*txtypes.BalanceOverride{{Address: "cosmos1"}},
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results, diff, err := app.SimulateBundle(tc.height, tc.overrides, bundle)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(bundle))
+
+			require.NoError(t, results[0].Err)
+			require.NotEmpty(t, results[0].Result.Events)
+			require.Positive(t, results[0].GasInfo.GasUsed)
+			require.Error(t, results[1].Err)
+			require.Nil(t, results[1].Result)
+			require.NoError(t, results[2].Err)
+
+			require.Equal(t, []*storetypes.StoreKVPair{
+				{StoreKey: capKey1.Name(), Key: sumKey, Value: encodeInt(tc.sum)},
+				{StoreKey: capKey2.Name(), Key: []byte("foo"), Value: []byte("bar")},
+			}, diff)
+
+			// nothing is written to the state of the app
+			require.Equal(t, int64(5), getIntFromStore(app.checkState.ctx.KVStore(capKey1), sumKey))
+			require.Nil(t, app.checkState.ctx.KVStore(capKey2).Get([]byte("foo")))
+		})
+	}
+}
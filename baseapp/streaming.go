@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"fmt"
 	"io"
 	"sync"
 
@@ -31,3 +32,78 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// CommitListener is implemented by the ABCIListeners able to acknowledge that
+// the messages they received have been delivered to their destination.
+type CommitListener interface {
+	// ListenCommit blocks until every message received by the listener so far has
+	// been delivered, and returns an error if they cannot be.
+	ListenCommit(ctx types.Context) error
+}
+
+// ListenerPolicy defines how the BaseApp reacts to the errors returned by an ABCIListener.
+type ListenerPolicy int
+
+const (
+	// ListenerPolicyFireAndForget logs the errors returned by the listener.
+	ListenerPolicyFireAndForget ListenerPolicy = iota
+	// ListenerPolicyHalt halts the node as soon as the listener returns an error,
+	// before the block is committed, so that the block is replayed on restart.
+	ListenerPolicyHalt
+	// ListenerPolicyBlockCommit logs the errors returned by the listening hooks,
+	// but blocks Commit until the listener, which must implement CommitListener,
+	// acknowledges the messages of the block. The node halts before the block is
+	// committed if the listener fails to acknowledge them.
+	ListenerPolicyBlockCommit
+)
+
+// String returns the name of a ListenerPolicy.
+func (p ListenerPolicy) String() string {
+	switch p {
+	case ListenerPolicyFireAndForget:
+		return "fire_and_forget"
+	case ListenerPolicyHalt:
+		return "halt"
+	case ListenerPolicyBlockCommit:
+		return "block_commit"
+	default:
+		return "unknown"
+	}
+}
+
+// abciListener is an ABCIListener registered with the policy applied to it.
+type abciListener struct {
+	ABCIListener
+	policy ListenerPolicy
+}
+
+// callABCIListeners calls a listening hook on every registered ABCIListener,
+// applying their policy to the errors returned.
+func (app *BaseApp) callABCIListeners(hook string, height int64, fn func(ABCIListener) error) {
+	for _, listener := range app.abciListeners {
+		err := fn(listener.ABCIListener)
+		if err == nil {
+			continue
+		}
+
+		if listener.policy == ListenerPolicyHalt {
+			panic(fmt.Errorf("%s listening hook failed at height %d: %w", hook, height, err))
+		}
+
+		app.logger.Error(fmt.Sprintf("%s listening hook failed", hook), "height", height, "err", err)
+	}
+}
+
+// waitABCIListeners blocks until every ABCIListener registered with the
+// ListenerPolicyBlockCommit policy acknowledged the messages of the block.
+func (app *BaseApp) waitABCIListeners(ctx types.Context) {
+	for _, listener := range app.abciListeners {
+		if listener.policy != ListenerPolicyBlockCommit {
+			continue
+		}
+
+		if err := listener.ABCIListener.(CommitListener).ListenCommit(ctx); err != nil {
+			panic(fmt.Errorf("listener failed to acknowledge block %d: %w", ctx.BlockHeight(), err))
+		}
+	}
+}
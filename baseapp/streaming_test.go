@@ -0,0 +1,125 @@
+package baseapp
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockStreamingService is a StreamingService whose listening hooks return
+// listenErr, and which acknowledges blocks with commitErr.
+type mockStreamingService struct {
+	listenErr error
+	commitErr error
+	commits   int
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return m.listenErr
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return m.listenErr
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return m.listenErr
+}
+
+func (m *mockStreamingService) Stream(*sync.WaitGroup) error { return nil }
+func (m *mockStreamingService) Close() error                 { return nil }
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// mockCommitStreamingService is a mockStreamingService implementing CommitListener.
+type mockCommitStreamingService struct {
+	mockStreamingService
+}
+
+func (m *mockCommitStreamingService) ListenCommit(sdk.Context) error {
+	m.commits++
+	return m.commitErr
+}
+
+func TestABCIListenerPolicies(t *testing.T) {
+	listenErr := errors.New("listener failure")
+
+	testCases := []struct {
+		name        string
+		service     StreamingService
+		policy      ListenerPolicy
+		expectPanic bool
+	}{
+		{
+			name:    "fire and forget logs errors",
+			service: &mockStreamingService{listenErr: listenErr},
+			policy:  ListenerPolicyFireAndForget,
+		},
+		{
+			name:        "halt on error",
+			service:     &mockStreamingService{listenErr: listenErr},
+			policy:      ListenerPolicyHalt,
+			expectPanic: true,
+		},
+		{
+			name:    "block commit until acknowledged",
+			service: &mockCommitStreamingService{mockStreamingService{listenErr: listenErr}},
+			policy:  ListenerPolicyBlockCommit,
+		},
+		{
+			name:        "block commit halts if not acknowledged",
+			service:     &mockCommitStreamingService{mockStreamingService{commitErr: listenErr}},
+			policy:      ListenerPolicyBlockCommit,
+			expectPanic: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupBaseApp(t)
+			app.SetStreamingServiceWithPolicy(tc.service, tc.policy)
+			app.InitChain(abci.RequestInitChain{})
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			block := func() {
+				app.BeginBlock(abci.RequestBeginBlock{Header: header})
+				app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+				app.Commit()
+			}
+
+			if tc.expectPanic {
+				require.Panics(t, block)
+				require.Equal(t, int64(0), app.LastBlockHeight())
+				return
+			}
+
+			require.NotPanics(t, block)
+			require.Equal(t, int64(1), app.LastBlockHeight())
+
+			if service, ok := tc.service.(*mockCommitStreamingService); ok {
+				require.Equal(t, 1, service.commits)
+			}
+		})
+	}
+}
+
+func TestSetStreamingServiceWithPolicy(t *testing.T) {
+	app := setupBaseApp(t)
+
+	// the block commit policy requires a CommitListener
+	require.Panics(t, func() {
+		app.SetStreamingServiceWithPolicy(&mockStreamingService{}, ListenerPolicyBlockCommit)
+	})
+	require.NotPanics(t, func() {
+		app.SetStreamingServiceWithPolicy(&mockCommitStreamingService{}, ListenerPolicyBlockCommit)
+	})
+}
@@ -0,0 +1,110 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// startSpan starts a span as a child of the span of the Context, returning the
+// Context of the span.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	spanCtx, span := telemetry.StartSpan(ctx.Context(), name, attrs...)
+	return ctx.WithContext(spanCtx), span
+}
+
+// startTxSpan starts the span of the execution of a transaction.
+func startTxSpan(ctx sdk.Context, name string, txBytes []byte) (sdk.Context, trace.Span) {
+	ctx, span := startSpan(ctx, name)
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("tx_hash", fmt.Sprintf("%X", tmhash.Sum(txBytes))))
+	}
+	return ctx, span
+}
+
+// endTxSpan ends the span of the execution of a transaction.
+func endTxSpan(span trace.Span, gInfo sdk.GasInfo, err error) {
+	span.SetAttributes(
+		attribute.Int64("gas_wanted", int64(gInfo.GasWanted)),
+		attribute.Int64("gas_used", int64(gInfo.GasUsed)),
+	)
+	telemetry.EndSpan(span, err)
+}
+
+// endSpanOnPanic ends a span with the panic recovered by the deferred function
+// calling it, if any, before panicking again.
+func endSpanOnPanic(span trace.Span, r interface{}) {
+	if r != nil {
+		telemetry.EndSpan(span, fmt.Errorf("panic: %v", r))
+		panic(r)
+	}
+}
+
+// traceMsgServiceHandler wraps a MsgServiceHandler to run it within a span
+// named after the type URL of its Msg.
+func traceMsgServiceHandler(typeURL string, handler MsgServiceHandler) MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+		ctx, span := startSpan(ctx, typeURL)
+		defer func() {
+			endSpanOnPanic(span, recover())
+			telemetry.EndSpan(span, err)
+		}()
+
+		return handler(ctx, msg)
+	}
+}
+
+// traceGRPCQueryHandler wraps a GRPCQueryHandler to run it within a span named
+// after its fully-qualified gRPC method.
+func traceGRPCQueryHandler(fqName string, handler GRPCQueryHandler) GRPCQueryHandler {
+	return func(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery, err error) {
+		ctx, span := startSpan(ctx, fqName, attribute.Int64("height", req.Height))
+		defer func() {
+			endSpanOnPanic(span, recover())
+			telemetry.EndSpan(span, err)
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// grpcTraceContext returns the context of the trace propagated by the client of
+// a gRPC query in the metadata of its request, if any.
+func grpcTraceContext(ctx context.Context, md metadata.MD) context.Context {
+	if !telemetry.TracingEnabled() {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier adapts the metadata of a gRPC request to a TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	values := metadata.MD(mc).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (mc metadataCarrier) Set(key, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for key := range mc {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,152 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type storeAnteDecorator struct{}
+
+func (storeAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.KVStore(capKey1).Get([]byte("ante"))
+	return next(ctx, tx, simulate)
+}
+
+// spanExporter keeps the exported spans on shutdown, unlike the in-memory
+// exporter it wraps.
+type spanExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func newSpanExporter() spanExporter {
+	return spanExporter{tracetest.NewInMemoryExporter()}
+}
+
+func (spanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// spansByName returns the ended spans by name, requiring the names to be unique.
+func spansByName(t *testing.T, exporter spanExporter) map[string]sdktrace.ReadOnlySpan {
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range exporter.GetSpans().Snapshots() {
+		require.NotContains(t, spans, span.Name())
+		spans[span.Name()] = span
+	}
+	return spans
+}
+
+func requireChildSpan(t *testing.T, parent, child sdktrace.ReadOnlySpan) {
+	require.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	require.Equal(t, parent.SpanContext().SpanID(), child.Parent().SpanID(), "%s is not a child of %s", child.Name(), parent.Name())
+}
+
+func TestTracing(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(storeAnteDecorator{}))
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey1).Set([]byte("handler"), []byte("value"))
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+	txBytes, err := codec.Marshal(*newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		traceStores bool
+	}{
+		"without stores": {},
+		"with stores":    {traceStores: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			exporter := newSpanExporter()
+			tracing := telemetry.NewTracingWithExporter(telemetry.Config{
+				TraceExporter:   telemetry.TraceExporterOTLP,
+				TraceSampleRate: 1,
+				TraceStores:     tc.traceStores,
+			}, exporter)
+
+			app := setupBaseApp(t, anteOpt, routerOpt)
+			app.InitChain(abci.RequestInitChain{})
+
+			app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, res.IsOK(), res.Log)
+			app.EndBlock(abci.RequestEndBlock{})
+			app.Commit()
+
+			// CheckTx is not traced
+			app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+
+			require.NoError(t, tracing.Shutdown(context.Background()))
+
+			spans := spansByName(t, exporter)
+			block := spans["Block"]
+			require.NotNil(t, block)
+			require.False(t, block.Parent().IsValid())
+			for _, name := range []string{"BeginBlock", "DeliverTx", "EndBlock", "Commit"} {
+				require.Contains(t, spans, name)
+				requireChildSpan(t, block, spans[name])
+			}
+
+			ante := spans["baseapp.storeAnteDecorator"]
+			require.NotNil(t, ante)
+			requireChildSpan(t, spans["DeliverTx"], ante)
+
+			if !tc.traceStores {
+				require.Len(t, spans, 6)
+				return
+			}
+
+			require.Len(t, spans, 8)
+			requireChildSpan(t, ante, spans["KVStore.Get"])
+			// the handler runs after the ante handler, not within
+			requireChildSpan(t, spans["DeliverTx"], spans["KVStore.Set"])
+		})
+	}
+}
+
+func TestTraceMsgServiceHandler(t *testing.T) {
+	exporter := newSpanExporter()
+	tracing := telemetry.NewTracingWithExporter(telemetry.Config{TraceSampleRate: 1}, exporter)
+
+	handlerErr := errors.New("handler failed")
+	handler := traceMsgServiceHandler("/test.Msg", func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return nil, handlerErr
+	})
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, err := handler(ctx, nil)
+	require.ErrorIs(t, err, handlerErr)
+
+	panicking := traceGRPCQueryHandler("/test.Query/Panic", func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		panic("query panicked")
+	})
+	require.PanicsWithValue(t, "query panicked", func() {
+		_, _ = panicking(ctx, abci.RequestQuery{Height: 3})
+	})
+
+	require.NoError(t, tracing.Shutdown(context.Background()))
+
+	spans := spansByName(t, exporter)
+	require.Len(t, spans, 2)
+	require.Equal(t, handlerErr.Error(), spans["/test.Msg"].Status().Description)
+	require.Equal(t, "panic: query panicked", spans["/test.Query/Panic"].Status().Description)
+}
@@ -0,0 +1,240 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/transient"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/witness"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// witnessRecorder records the requests of the block being executed, and the
+// reads and writes of the block to the stores of the app.
+type witnessRecorder struct {
+	witness storetypes.BlockWitness
+	keys    []storetypes.StoreKey
+	stores  map[storetypes.StoreKey]*rwset.Store
+}
+
+func (app *BaseApp) setWitnessStore(ws *witness.Store) {
+	if ws == nil {
+		return
+	}
+	if _, ok := app.cms.(*rootmulti.Store); !ok {
+		panic(fmt.Sprintf("block witnesses require a %T commit multi-store, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	app.witnessStore = ws
+}
+
+// recordWitness starts the recording of the witness of a block, and returns the
+// branch of the committed state the block is executed on. The stores of the
+// branch record the reads of the block and buffer its writes, which are only
+// written to the committed state on Commit.
+func (app *BaseApp) recordWitness() sdk.CacheMultiStore {
+	keys := app.storeKeys()
+	app.witness = &witnessRecorder{
+		keys:   keys,
+		stores: make(map[storetypes.StoreKey]*rwset.Store, len(keys)),
+	}
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	metrics := make(map[string]bool)
+	for _, key := range keys {
+		store := rwset.NewStore(app.cms.GetKVStore(key))
+		app.witness.stores[key] = store
+		stores[key] = store
+		if storetypes.MetricsEnabled(app.cms, key) {
+			metrics[key.Name()] = true
+		}
+	}
+
+	return cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, nil, nil, nil, nil).SetMetrics(metrics)
+}
+
+// commitWitness writes the writes of the block to the committed state, and saves
+// the witness of the block. The keys of the committed stores are proven against
+// the last committed state, i.e. the state before the block. A witness which
+// cannot be proven or saved is logged and skipped.
+func (app *BaseApp) commitWitness() {
+	rec := app.witness
+	app.witness = nil
+
+	for _, key := range rec.keys {
+		rec.stores[key].WriteTo(app.cms.GetKVStore(key))
+	}
+
+	height := rec.witness.BeginBlock.Header.Height
+	err := rec.prove(app.cms.(storetypes.Queryable), app.LastBlockHeight())
+	if err == nil {
+		err = app.witnessStore.Save(&rec.witness)
+	}
+	if err != nil {
+		app.logger.Error("failed to save the witness of the block", "height", height, "err", err)
+	}
+}
+
+// prove adds the witnesses of the stores read or written by the block to the
+// witness of the block. The transient stores are skipped, as they are empty at
+// the beginning of each block.
+func (rec *witnessRecorder) prove(queryable storetypes.Queryable, height int64) error {
+	for _, key := range rec.keys {
+		store := rec.stores[key]
+
+		var sw *storetypes.StoreWitness
+		switch key.(type) {
+		case *storetypes.TransientStoreKey:
+			continue
+
+		case *storetypes.KVStoreKey:
+			var err error
+			sw, err = witness.Prove(queryable, height, key.Name(), store)
+			if err != nil {
+				return err
+			}
+
+		default:
+			sw = witness.Record(key.Name(), store)
+		}
+
+		if len(sw.Entries) > 0 || len(sw.Writes) > 0 {
+			rec.witness.Stores = append(rec.witness.Stores, sw)
+		}
+	}
+
+	return nil
+}
+
+// VerifyWitness executes the block of a witness again, against the state of the
+// stores of the app served by the witness, and returns the writes of the block
+// to the stores other than the transient ones.
+//
+// The witness of each committed store is verified against the app hash of the
+// header of the block, i.e. the app hash of the previous height, and the
+// transactions of the block against the data hash of the header: the header
+// must be trusted, e.g. verified by a light client. The witnesses of the other
+// stores, e.g. the memory stores, are trusted. The witness is rejected if the
+// execution reads state missing from the witness, or if its writes don't match
+// the writes of the witness.
+//
+// The state of the app is neither read nor written, so that the witness can be
+// verified by an app without state, but the app must not be executing blocks.
+func (app *BaseApp) VerifyWitness(w *storetypes.BlockWitness) (writes []*storetypes.StoreKVPair, err error) {
+	if app.deliverState != nil {
+		return nil, errors.New("cannot verify a witness while executing a block")
+	}
+	keys := app.storeKeys()
+	if keys == nil {
+		return nil, errors.New("the multistore doesn't support verifying witnesses")
+	}
+
+	header := w.BeginBlock.Header
+	txs := make(tmtypes.Txs, len(w.Txs))
+	for i, tx := range w.Txs {
+		txs[i] = tx
+	}
+	if !bytes.Equal(txs.Hash(), header.DataHash) {
+		return nil, fmt.Errorf("the transactions of the witness don't match the data hash %X of the header", header.DataHash)
+	}
+
+	witnesses := make(map[string]*storetypes.StoreWitness, len(w.Stores))
+	for _, sw := range w.Stores {
+		witnesses[sw.Name] = sw
+	}
+
+	stores := make(map[storetypes.StoreKey]*rwset.Store, len(keys))
+	witnessStores := make(map[storetypes.StoreKey]*witness.KVStore, len(keys))
+	branchStores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		var parent storetypes.KVStore
+		if _, ok := key.(*storetypes.TransientStoreKey); ok {
+			parent = transient.NewStore()
+		} else {
+			sw, ok := witnesses[key.Name()]
+			if !ok {
+				sw = &storetypes.StoreWitness{Name: key.Name()}
+			}
+			if _, ok := key.(*storetypes.KVStoreKey); ok {
+				if err := witness.Verify(sw, header.AppHash); err != nil {
+					return nil, err
+				}
+			}
+
+			witnessStores[key] = witness.NewKVStore(sw)
+			parent = witnessStores[key]
+		}
+
+		stores[key] = rwset.NewStore(parent)
+		branchStores[key] = stores[key]
+	}
+
+	branch := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, branchStores, nil, nil, nil, nil)
+	app.deliverState = &state{
+		ms:  branch,
+		ctx: app.withGasSchedule(sdk.NewContext(branch, header, false, app.logger)),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			writes, err = nil, fmt.Errorf("failed to execute the block of the witness: %v", r)
+		}
+
+		app.deliverState = nil
+		if app.blockSpan != nil {
+			app.blockSpan.End()
+			app.blockSpan = nil
+		}
+	}()
+
+	app.beginBlock(w.BeginBlock)
+	for _, tx := range w.Txs {
+		app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+	app.EndBlock(w.EndBlock)
+	branch.Write()
+
+	for _, key := range keys {
+		if store, ok := witnessStores[key]; ok {
+			if err := store.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if _, ok := key.(*storetypes.TransientStoreKey); ok {
+			continue
+		}
+
+		changeset := stores[key].Changeset(key.Name())
+		if !equalChangesets(changeset, witnesses[key.Name()].GetWrites()) {
+			return nil, fmt.Errorf("the writes of the block to store %s don't match the witness", key.Name())
+		}
+		writes = append(writes, changeset...)
+	}
+
+	return writes, nil
+}
+
+func equalChangesets(a, b []*storetypes.StoreKVPair) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].StoreKey != b[i].StoreKey || a[i].Delete != b[i].Delete ||
+			!bytes.Equal(a[i].Key, b[i].Key) || !bytes.Equal(a[i].Value, b[i].Value) {
+			return false
+		}
+	}
+
+	return true
+}
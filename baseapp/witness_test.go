@@ -0,0 +1,190 @@
+package baseapp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/witness"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// witnessRouterOpt routes the counter messages to a handler iterating over and
+// reading both stores.
+func witnessRouterOpt(bapp *BaseApp) {
+	bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		counter := byte(msg.(*msgCounter).Counter)
+
+		// count the keys of the first store
+		store := ctx.KVStore(capKey1)
+		var n byte
+		it := store.Iterator([]byte("k"), sdk.PrefixEndBytes([]byte("k")))
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		it.Close()
+		store.Set([]byte{'k', counter}, []byte{n})
+
+		// copy the last counter key of the second store
+		other := ctx.KVStore(capKey2)
+		it = other.ReverseIterator([]byte("c"), []byte("d"))
+		if it.Valid() {
+			other.Set([]byte("last"), it.Key())
+		}
+		it.Close()
+		other.Get([]byte("absent"))
+		other.Set([]byte{'c', counter}, []byte("value"))
+
+		return &sdk.Result{}, nil
+	}))
+}
+
+func executeWitnessBlock(t *testing.T, app *BaseApp, height int64, counters ...int64) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	var txs tmtypes.Txs
+	for _, counter := range counters {
+		txBytes, err := codec.Marshal(*newTxCounter(counter, counter))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		Height:   height,
+		AppHash:  app.LastCommitID().Hash,
+		DataHash: txs.Hash(),
+	}})
+	for _, tx := range txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+}
+
+func storeWitness(w *storetypes.BlockWitness, name string) *storetypes.StoreWitness {
+	for _, sw := range w.Stores {
+		if sw.Name == name {
+			return sw
+		}
+	}
+	return nil
+}
+
+func removeEntry(sw *storetypes.StoreWitness, key []byte) {
+	for i, entry := range sw.Entries {
+		if bytes.Equal(entry.Key, key) {
+			sw.Entries = append(sw.Entries[:i], sw.Entries[i+1:]...)
+			return
+		}
+	}
+	panic("no such entry")
+}
+
+func TestWitness(t *testing.T) {
+	ws := witness.NewStore(dbm.NewMemDB())
+	app := setupBaseApp(t, witnessRouterOpt, SetWitnessStore(ws))
+	app.InitChain(abci.RequestInitChain{})
+	executeWitnessBlock(t, app, 1, 1, 2)
+	executeWitnessBlock(t, app, 2, 3, 4)
+
+	// the first block has no witness, as it is executed on the state of InitChain
+	heights, err := ws.Heights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, heights)
+
+	// the state is written as usual
+	require.Equal(t, []byte{3}, app.cms.GetKVStore(capKey1).Get([]byte{'k', 4}))
+	require.Equal(t, []byte{'c', 3}, app.cms.GetKVStore(capKey2).Get([]byte("last")))
+
+	w, err := ws.Get(2)
+	require.NoError(t, err)
+	require.Len(t, w.Txs, 2)
+	require.Len(t, w.Stores, 2)
+
+	var expected []*storetypes.StoreKVPair
+	for _, sw := range w.Stores {
+		expected = append(expected, sw.Writes...)
+	}
+
+	// the block is executed again by an app without state
+	verifier := setupBaseApp(t, witnessRouterOpt)
+	writes, err := verifier.VerifyWitness(w)
+	require.NoError(t, err)
+	require.Equal(t, expected, writes)
+	require.Equal(t, int64(0), verifier.LastBlockHeight())
+	require.Nil(t, verifier.deliverState)
+
+	testCases := map[string]struct {
+		malleate func(w *storetypes.BlockWitness)
+		err      string
+	}{
+		"tampered value": {
+			malleate: func(w *storetypes.BlockWitness) {
+				for _, entry := range storeWitness(w, capKey1.Name()).Entries {
+					if bytes.Equal(entry.Key, []byte{'k', 1}) {
+						entry.Value = []byte{9}
+					}
+				}
+			},
+			err: "invalid proof",
+		},
+		"other app hash": {
+			malleate: func(w *storetypes.BlockWitness) {
+				w.BeginBlock.Header.AppHash = make([]byte, 32)
+			},
+			err: "invalid proof",
+		},
+		"missing key of a range": {
+			malleate: func(w *storetypes.BlockWitness) {
+				// the proof of the absence of the keys following k2
+				removeEntry(storeWitness(w, capKey1.Name()), []byte{'k', 2, 0})
+			},
+			err: "has no entry",
+		},
+		"missing range": {
+			malleate: func(w *storetypes.BlockWitness) {
+				storeWitness(w, capKey1.Name()).Ranges = nil
+			},
+			err: "is missing the keys",
+		},
+		"missing key": {
+			malleate: func(w *storetypes.BlockWitness) {
+				removeEntry(storeWitness(w, capKey2.Name()), []byte("absent"))
+			},
+			err: "is missing the key 616273656E74",
+		},
+		"tampered writes": {
+			malleate: func(w *storetypes.BlockWitness) {
+				storeWitness(w, capKey2.Name()).Writes[0].Value = []byte("other")
+			},
+			err: "don't match the witness",
+		},
+		"missing tx": {
+			malleate: func(w *storetypes.BlockWitness) {
+				w.Txs = w.Txs[1:]
+			},
+			err: "don't match the data hash",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tampered, err := ws.Get(2)
+			require.NoError(t, err)
+			tc.malleate(tampered)
+
+			_, err = verifier.VerifyWitness(tampered)
+			require.ErrorContains(t, err, tc.err)
+			require.Nil(t, verifier.deliverState)
+		})
+	}
+}
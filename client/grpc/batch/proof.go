@@ -0,0 +1,23 @@
+package batch
+
+import (
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// Verify verifies the proof against the app hash of the height of the batch,
+// i.e. the app hash of the header of the next height. A proof with an empty
+// value is verified as a proof of the absence of the key.
+func (p *StoreProof) Verify(appHash []byte) error {
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.StoreName), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(p.ProofOps, appHash, keyPath.String())
+	}
+
+	return prt.VerifyValue(p.ProofOps, appHash, keyPath.String(), p.Value)
+}
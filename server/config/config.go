@@ -33,6 +33,19 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultMempoolMaxTxs defines the default maximum number of transactions
+	// held by the app-side mempool. A negative value disables the app-side
+	// mempool.
+	DefaultMempoolMaxTxs = -1
+
+	// DefaultSnapshotCompression defines the default compression of state sync
+	// snapshots.
+	DefaultSnapshotCompression = "zlib"
+
+	// DefaultSnapshotExportWorkers defines the default number of stores exported
+	// concurrently when taking a state sync snapshot.
+	DefaultSnapshotExportWorkers = 4
 )
 
 // BaseConfig defines the server's basic configuration
@@ -78,6 +91,20 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// StateHistory enables the state history DB, which saves the state changes
+	// of each height and serves the queries at the heights pruned from the
+	// application state.
+	StateHistory bool `mapstructure:"state-history"`
+
+	// BlockWitnesses enables the block witnesses, which save the keys read and
+	// written by each block along with their proofs, so that the blocks can be
+	// verified without the application state.
+	BlockWitnesses bool `mapstructure:"block-witnesses"`
+
+	// StoreV2 enables the SMT-based v2alpha1 multistore as the application
+	// state, in place of the IAVL-based one.
+	StoreV2 bool `mapstructure:"store-v2"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -89,6 +116,14 @@ type BaseConfig struct {
 	AppDBBackend string `mapstructure:"app-db-backend"`
 }
 
+// StorePruningConfig defines the pruning strategy of a store, in place of the
+// "pruning-*" configurations of the application state.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+}
+
 // APIConfig defines the API listener configuration.
 type APIConfig struct {
 	// Enable defines if the API server should be enabled.
@@ -190,6 +225,34 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxDeltas sets the number of delta snapshots taken after a full
+	// state sync snapshot before the next full one. 0 disables delta snapshots.
+	SnapshotMaxDeltas uint32 `mapstructure:"snapshot-max-deltas"`
+
+	// SnapshotCompression sets the compression of state sync snapshots, either
+	// "zlib" or "zstd". zstd snapshots carry a chunk manifest and can be signed.
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
+
+	// SnapshotExportWorkers sets the number of stores exported concurrently when
+	// taking a state sync snapshot.
+	SnapshotExportWorkers uint32 `mapstructure:"snapshot-export-workers"`
+
+	// SnapshotSigningKeyFile sets the node key file used to sign state sync
+	// snapshots, relative to the node home. Empty disables signing.
+	SnapshotSigningKeyFile string `mapstructure:"snapshot-signing-key-file"`
+
+	// SnapshotTrustedSigners sets the node IDs whose signed state sync snapshots
+	// are restored. If empty, unsigned snapshots are restored as well.
+	SnapshotTrustedSigners []string `mapstructure:"snapshot-trusted-signers"`
+}
+
+// MempoolConfig defines the configuration for the app-side mempool.
+type MempoolConfig struct {
+	// MaxTxs defines the maximum number of transactions held by the app-side
+	// mempool. A value of 0 means the mempool is unbounded and a negative value
+	// disables the app-side mempool.
+	MaxTxs int `mapstructure:"max-txs"`
 }
 
 // Config defines the server's top level configuration
@@ -203,6 +266,11 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Mempool   MempoolConfig    `mapstructure:"mempool"`
+
+	// StorePruning defines the pruning strategies of the stores pruned differently
+	// from the rest of the application state, by store key name.
+	StorePruning map[string]StorePruningConfig `mapstructure:"store-pruning"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -247,8 +315,10 @@ func DefaultConfig() *Config {
 			AppDBBackend:      "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:         false,
+			GlobalLabels:    [][]string{},
+			TraceExporter:   telemetry.TraceExporterNone,
+			TraceSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -280,9 +350,15 @@ func DefaultConfig() *Config {
 			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:      0,
+			SnapshotKeepRecent:    2,
+			SnapshotCompression:   DefaultSnapshotCompression,
+			SnapshotExportWorkers: DefaultSnapshotExportWorkers,
+		},
+		Mempool: MempoolConfig{
+			MaxTxs: DefaultMempoolMaxTxs,
 		},
+		StorePruning: make(map[string]StorePruningConfig),
 	}
 }
 
@@ -304,10 +380,24 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		}
 	}
 
+	// the app.toml files written before tracing was added sample every trace
+	traceSampleRate := 1.0
+	if v.IsSet("telemetry.trace-sample-rate") {
+		traceSampleRate = v.GetFloat64("telemetry.trace-sample-rate")
+	}
+
+	storePruning := make(map[string]StorePruningConfig)
+	if err := v.UnmarshalKey("store-pruning", &storePruning); err != nil {
+		return Config{}, fmt.Errorf("failed to parse store-pruning config: %w", err)
+	}
+
 	return Config{
 		BaseConfig: BaseConfig{
 			MinGasPrices:      v.GetString("minimum-gas-prices"),
 			InterBlockCache:   v.GetBool("inter-block-cache"),
+			StateHistory:      v.GetBool("state-history"),
+			BlockWitnesses:    v.GetBool("block-witnesses"),
+			StoreV2:           v.GetBool("store-v2"),
 			Pruning:           v.GetString("pruning"),
 			PruningKeepRecent: v.GetString("pruning-keep-recent"),
 			PruningInterval:   v.GetString("pruning-interval"),
@@ -326,6 +416,12 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			TraceExporter:           v.GetString("telemetry.trace-exporter"),
+			TraceEndpoint:           v.GetString("telemetry.trace-endpoint"),
+			TraceFile:               v.GetString("telemetry.trace-file"),
+			TraceSampleRate:         traceSampleRate,
+			TraceStores:             v.GetBool("telemetry.trace-stores"),
+			StoreMetrics:            v.GetStringSlice("telemetry.store-metrics"),
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -360,9 +456,18 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:       v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent:     v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotMaxDeltas:      v.GetUint32("state-sync.snapshot-max-deltas"),
+			SnapshotCompression:    v.GetString("state-sync.snapshot-compression"),
+			SnapshotExportWorkers:  v.GetUint32("state-sync.snapshot-export-workers"),
+			SnapshotSigningKeyFile: v.GetString("state-sync.snapshot-signing-key-file"),
+			SnapshotTrustedSigners: v.GetStringSlice("state-sync.snapshot-trusted-signers"),
 		},
+		Mempool: MempoolConfig{
+			MaxTxs: v.GetInt("mempool.max-txs"),
+		},
+		StorePruning: storePruning,
 	}, nil
 }
 
@@ -376,6 +481,13 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	for name, storePruning := range c.StorePruning {
+		if storePruning.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, name,
+			)
+		}
+	}
 
 	return nil
 }
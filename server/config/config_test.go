@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -128,3 +129,77 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestStorePruningWriteRead(t *testing.T) {
+	expected := map[string]StorePruningConfig{
+		"bank":    {Pruning: "nothing", PruningKeepRecent: "0", PruningInterval: "0"},
+		"staking": {Pruning: "custom", PruningKeepRecent: "100", PruningInterval: "10"},
+	}
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.StorePruning = expected
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, expected, cfg.StorePruning)
+	cfg2, err := GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, expected, cfg2.StorePruning)
+
+	// the default config has no store pruning strategy
+	WriteConfigFile(confFile, DefaultConfig())
+	vpr = viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err = ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Empty(t, cfg.StorePruning)
+}
+
+func TestTracingWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Telemetry.TraceExporter = telemetry.TraceExporterOTLP
+	conf.Telemetry.TraceEndpoint = "http://localhost:4318"
+	conf.Telemetry.TraceSampleRate = 0.25
+	conf.Telemetry.TraceStores = true
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err := GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, telemetry.TraceExporterOTLP, cfg.Telemetry.TraceExporter)
+	require.Equal(t, "http://localhost:4318", cfg.Telemetry.TraceEndpoint)
+	require.Equal(t, 0.25, cfg.Telemetry.TraceSampleRate)
+	require.True(t, cfg.Telemetry.TraceStores)
+
+	// tracing is disabled by default
+	WriteConfigFile(confFile, DefaultConfig())
+	vpr = viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err = GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, telemetry.TraceExporterNone, cfg.Telemetry.TraceExporter)
+	require.Equal(t, 1.0, cfg.Telemetry.TraceSampleRate)
+}
+
+func TestStoreMetricsWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Telemetry.StoreMetrics = []string{"bank", "staking"}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err := GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank", "staking"}, cfg.Telemetry.StoreMetrics)
+}
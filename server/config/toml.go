@@ -63,6 +63,23 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# StateHistory enables the state history DB, which saves the state changes of
+# each height to data/history.db and serves the queries at the heights pruned
+# from the application state, without proofs. It lets archive nodes keep a
+# small number of recent heights with the "pruning-*" configurations.
+state-history = {{ .BaseConfig.StateHistory }}
+
+# BlockWitnesses enables the block witnesses, which save the keys read and
+# written by each block along with their proofs against the previous app hash
+# to data/witness.db, so that the blocks can be verified statelessly.
+block-witnesses = {{ .BaseConfig.BlockWitnesses }}
+
+# StoreV2 enables the SMT-based v2alpha1 multistore as the application state,
+# in place of the IAVL-based one. Its state is kept in data/application_v2, and
+# can be migrated from the IAVL-based state with the "migrate-store" command.
+# It does not support the state history nor delta snapshots.
+store-v2 = {{ .BaseConfig.StoreV2 }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -115,6 +132,36 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# TraceExporter, when set, enables the tracing of the blocks, transactions and
+# queries, independently of enabled. The spans are exported to an OTLP/HTTP
+# collector with "otlp", or appended to trace-file as OTLP JSON with "file".
+trace-exporter = "{{ .Telemetry.TraceExporter }}"
+
+# TraceEndpoint is the URL of the OTLP/HTTP collector, e.g. "http://localhost:4318".
+trace-endpoint = "{{ .Telemetry.TraceEndpoint }}"
+
+# TraceFile is the path of the file the spans are appended to.
+trace-file = "{{ .Telemetry.TraceFile }}"
+
+# TraceSampleRate is the fraction of the blocks and queries traced, between 0 and 1.
+trace-sample-rate = {{ .Telemetry.TraceSampleRate }}
+
+# TraceStores enables a span for each operation of the KVStores, which are numerous.
+trace-stores = {{ .Telemetry.TraceStores }}
+
+# StoreMetrics are the names of the stores whose operations emit metrics, labelled by store,
+# message type and key prefix, along with the hits and misses of their IAVL node cache.
+# The metrics are only gathered if enabled is true.
+#
+# Each store emits a time series per operation for every combination of message type URL and
+# key prefix (up to 256 prefixes) it sees, so that the number of Prometheus series grows with
+# the number of stores times the number of message types executed. Enable it for a few stores
+# while investigating them, rather than permanently for every store.
+#
+# Example:
+# ["bank", "staking"]
+store-metrics = [{{ range .Telemetry.StoreMetrics }}{{ printf "%q, " . }}{{end}}]
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -231,6 +278,68 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-max-deltas specifies the number of delta snapshots, which only store the state changed
+# since the previous snapshot, taken after a full snapshot before the next full one (0 to disable).
+# The snapshots delta snapshots are based on are kept until the delta snapshots are pruned.
+snapshot-max-deltas = {{ .StateSync.SnapshotMaxDeltas }}
+
+# snapshot-compression specifies the compression of the snapshots taken, "zlib" (format 2) or
+# "zstd" (format 4). zstd snapshots carry a Merkle manifest of their chunks, which lets restoring
+# nodes reject a bad chunk before applying it, and can be signed.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
+# snapshot-export-workers specifies the number of stores exported and compressed concurrently
+# when taking a snapshot. The snapshot is the same whatever the number of workers.
+snapshot-export-workers = {{ .StateSync.SnapshotExportWorkers }}
+
+# snapshot-signing-key-file specifies the node key file, relative to the node home, used to sign
+# zstd snapshots (empty to disable signing), e.g. "config/node_key.json".
+snapshot-signing-key-file = "{{ .StateSync.SnapshotSigningKeyFile }}"
+
+# snapshot-trusted-signers specifies the node IDs whose signed snapshots are restored. If empty,
+# unsigned snapshots are restored as well.
+snapshot-trusted-signers = [{{ range .StateSync.SnapshotTrustedSigners }}{{ printf "%q, " . }}{{end}}]
+
+###############################################################################
+###                         Mempool Configuration                           ###
+###############################################################################
+
+# The app-side mempool keeps the transactions accepted by CheckTx ordered by
+# priority (e.g. gas price) and by nonce for every sender. It is only an
+# extension point: Tendermint always builds blocks from its own mempool, and
+# nothing selects transactions from the app-side mempool unless the application
+# reads it with BaseApp.SelectTxs.
+[mempool]
+
+# max-txs defines the maximum number of transactions held by the app-side mempool.
+# Setting max-txs to 0 allows an unbounded number of transactions, while a negative
+# value (-1) disables the app-side mempool.
+max-txs = {{ .Mempool.MaxTxs }}
+
+###############################################################################
+###                        Store Pruning Configuration                      ###
+###############################################################################
+
+# The pruning strategies of the stores pruned differently from the rest of the
+# application state, by store key name, e.g. to keep the full history of a store
+# queried historically while keeping a few states of a high-churn store. They take
+# the same options as the pruning strategy above:
+#
+# [store-pruning.bank]
+# pruning = "nothing"
+#
+# [store-pruning.mymodule]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+{{- range $name, $pruning := .StorePruning }}
+
+[store-pruning.{{ $name }}]
+pruning = "{{ $pruning.Pruning }}"
+pruning-keep-recent = "{{ $pruning.PruningKeepRecent }}"
+pruning-interval = "{{ $pruning.PruningInterval }}"
+{{- end }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+)
+
+// NewMigrateStoreCmd creates a command migrating the application state from the IAVL-based multistore to the
+// SMT-based v2alpha1 multistore, which is used when the store-v2 option is enabled.
+func NewMigrateStoreCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the application state to the v2alpha1 multistore",
+		Long: `Migrate the application state at the latest height from the IAVL-based multistore
+to the SMT-based v2alpha1 multistore, which is saved to data/application_v2. The node
+must be stopped, and the v2alpha1 multistore must be empty. Once migrated, the node
+uses the v2alpha1 multistore when started with the store-v2 option. The heights
+before the migrated one are not migrated.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			home := ctx.Config.RootDir
+
+			storeDB, err := openStoreV2DB(home)
+			if err != nil {
+				return err
+			}
+			defer storeDB.Close()
+			versions, err := storeDB.Versions()
+			if err != nil {
+				return err
+			}
+			if versions.Count() != 0 {
+				return fmt.Errorf("the v2alpha1 multistore already has a state at height %d", versions.Last())
+			}
+
+			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the app mounts its stores on the IAVL-based multistore, which is migrated
+			ctx.Viper.Set(FlagStoreV2, false)
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot migrate a %T multistore", app.CommitMultiStore())
+			}
+
+			store, err := multi.MigrateFromV1(rms, storeDB, multi.DefaultStoreConfig())
+			if err != nil {
+				return err
+			}
+			if err := store.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Migrated the application state at height %d\n", rms.LastCommitID().Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
@@ -14,16 +14,38 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(
+		appOpts.Get(FlagPruning),
+		appOpts.Get(FlagPruningKeepRecent),
+		appOpts.Get(FlagPruningInterval),
+	)
+}
+
+// GetStorePruningOptionsFromFlags parses the pruning strategies of the stores pruned differently from
+// the rest of the application state, configured by store key name under store-pruning with the same
+// options as the pruning strategy of the application state.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	storesOpts := make(map[string]pruningtypes.PruningOptions)
+	for name, storeOpts := range cast.ToStringMap(appOpts.Get(FlagStorePruning)) {
+		values := cast.ToStringMap(storeOpts)
+		opts, err := parsePruningOptions(values[FlagPruning], values[FlagPruningKeepRecent], values[FlagPruningInterval])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", name, err)
+		}
+		storesOpts[name] = opts
+	}
+	return storesOpts, nil
+}
 
-	switch strategy {
+func parsePruningOptions(strategy, keepRecent, interval interface{}) (pruningtypes.PruningOptions, error) {
+	switch strategy := strings.ToLower(cast.ToString(strategy)); strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
 		return pruningtypes.NewPruningOptionsFromString(strategy), nil
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecent),
+			cast.ToUint64(interval),
 		)
 
 		if err := opts.Validate(); err != nil {
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -66,3 +67,34 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+pruning = "everything"
+
+[store-pruning.bank]
+pruning = "nothing"
+
+[store-pruning.staking]
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "10"
+`)))
+
+	opts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"bank":    pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		"staking": pruningtypes.NewCustomPruningOptions(100, 10),
+	}, opts)
+
+	v.Set(FlagStorePruning+".staking."+FlagPruningInterval, "1")
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.Error(t, err)
+
+	opts, err = GetStorePruningOptionsFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, opts)
+}
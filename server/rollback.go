@@ -1,49 +1,219 @@
 package server
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
-	"github.com/spf13/cobra"
-	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
-func NewRollbackCmd(defaultNodeHome string) *cobra.Command {
+const (
+	flagRollbackHeight = "height"
+	flagRollbackDryRun = "dry-run"
+)
+
+// NewRollbackCmd creates a command to rollback tendermint and multistore state to a previous height.
+func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state to a previous height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
 progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting Tendermint the transactions in block n will be re-executed against the
-application.
+The application also rolls back to height n - 1, its later versions and snapshots
+being deleted. No blocks are removed, so upon restarting Tendermint the transactions
+in block n will be re-executed against the application.
+
+Tendermint can only roll back its state by one height, so the application and
+Tendermint are always rolled back together to height n - 1. --height only checks that
+this is the expected height, and is rejected otherwise.
+
+The height must exist in every IAVL store of the application, and its app hash must
+match the one agreed upon by Tendermint. With --dry-run, the rollback is only checked.
 `,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
-			cfg := ctx.Config
-			home := cfg.RootDir
-			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			if ctx.Viper.GetBool(FlagStoreV2) {
+				return errors.New("the v2alpha1 multistore cannot be rolled back")
+			}
+			wantHeight, _ := cmd.Flags().GetInt64(flagRollbackHeight)
+			dryRun, _ := cmd.Flags().GetBool(flagRollbackDryRun)
+			if wantHeight < 0 {
+				return fmt.Errorf("invalid rollback height %d", wantHeight)
+			}
+
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
-			// rollback tendermint state
-			height, hash, err := tmcmd.RollbackState(ctx.Config)
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("the multistore of the app cannot be rolled back")
+			}
+
+			rollback, err := openTendermintRollback(ctx.Config)
 			if err != nil {
 				return fmt.Errorf("failed to rollback tendermint state: %w", err)
 			}
-			// rollback the multistore
-			cms := rootmulti.NewStore(db, ctx.Logger)
-			cms.RollbackToVersion(height)
+			defer rollback.Close()
+			height := rollback.height
 
-			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
+			// rolling the app back further than tendermint would leave a state the node can't
+			// start from, as tendermint would replay the later blocks against their stored app hashes
+			if wantHeight != 0 && wantHeight != height {
+				return fmt.Errorf("cannot roll back to height %d, tendermint can only roll back to height %d", wantHeight, height)
+			}
+
+			// check the app state of the height against the tendermint state before writing anything
+			latest := cms.LastCommitID()
+			appHash := latest.Hash
+			if height > latest.Version {
+				return fmt.Errorf("the app state at height %d is below the rollback height %d", latest.Version, height)
+			}
+			if height < latest.Version {
+				cInfo, err := cms.CheckRollbackToVersion(height)
+				if err != nil {
+					return fmt.Errorf("failed to rollback the multistore: %w", err)
+				}
+				appHash = cInfo.CommitID().Hash
+			}
+			if !bytes.Equal(appHash, rollback.appHash) {
+				return fmt.Errorf("app hash %X at height %d doesn't match the app hash %X agreed upon by tendermint",
+					appHash, height, rollback.appHash)
+			}
+
+			if dryRun {
+				cmd.Printf("Would roll back app state from height %d to height %d and hash %X\n", latest.Version, height, appHash)
+				if rollback.latest.LastBlockHeight > height {
+					cmd.Printf("Would roll back tendermint state from height %d to height %d\n", rollback.latest.LastBlockHeight, height)
+				}
+				if manager := app.SnapshotManager(); manager != nil {
+					snapshots, err := manager.List()
+					if err != nil {
+						return err
+					}
+					for _, snapshot := range snapshots {
+						if snapshot.Height > uint64(height) {
+							cmd.Printf("Would delete snapshot at height %d, format %d\n", snapshot.Height, snapshot.Format)
+						}
+					}
+				}
+				return nil
+			}
+
+			// the app is rolled back first, so that it is replayed up to the tendermint state if the
+			// latter fails to be rolled back
+			if height < latest.Version {
+				if err := cms.RollbackToVersion(height); err != nil {
+					return fmt.Errorf("failed to rollback the multistore: %w", err)
+				}
+			}
+			if _, _, err := sm.Rollback(rollback.blockStore, rollback.stateStore); err != nil {
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+			if manager := app.SnapshotManager(); manager != nil {
+				deleted, err := manager.DeleteAbove(uint64(height))
+				if err != nil {
+					return err
+				}
+				for _, snapshot := range deleted {
+					cmd.Printf("Deleted snapshot at height %d, format %d\n", snapshot.Height, snapshot.Format)
+				}
+			}
+
+			cmd.Printf("Rolled back state to height %d and hash %X\n", height, appHash)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagRollbackHeight, 0, "Check that the rollback height is this one, the only height Tendermint can roll back to")
+	cmd.Flags().Bool(flagRollbackDryRun, false, "Check the rollback without writing anything")
 	return cmd
 }
+
+// tendermintRollback reads the Tendermint stores of the node to check a rollback to a height.
+type tendermintRollback struct {
+	blockStore *store.BlockStore
+	stateStore sm.Store
+
+	// latest is the latest Tendermint state
+	latest sm.State
+	// height is the height Tendermint rolls back to and appHash the app hash agreed upon for it
+	height  int64
+	appHash []byte
+}
+
+// openTendermintRollback opens the Tendermint stores of the node to check a rollback to the
+// height below the latest state, as rolled back by Tendermint.
+func openTendermintRollback(cfg *tmcfg.Config) (*tendermintRollback, error) {
+	blockStoreDB, stateDB, err := openTendermintDBs(cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	rollback := &tendermintRollback{
+		blockStore: store.NewBlockStore(blockStoreDB),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+	}
+	if err := rollback.load(); err != nil {
+		rollback.Close()
+		return nil, err
+	}
+	return rollback, nil
+}
+
+func (r *tendermintRollback) load() error {
+	latest, err := r.stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if latest.IsEmpty() {
+		return errors.New("no state found")
+	}
+	r.latest = latest
+
+	// NOTE: persistence of state and blocks don't happen atomically, the block store can be one
+	// block ahead of the state store, in which case the latest state is the one to roll back to.
+	blockHeight := r.blockStore.Height()
+	switch blockHeight {
+	case latest.LastBlockHeight + 1:
+		r.height = latest.LastBlockHeight
+		r.appHash = latest.AppHash
+		return nil
+
+	case latest.LastBlockHeight:
+		r.height = latest.LastBlockHeight - 1
+
+	default:
+		return fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			latest.LastBlockHeight, blockHeight)
+	}
+
+	// the app hash of a height is agreed upon in the header of the next block
+	meta := r.blockStore.LoadBlockMeta(r.height + 1)
+	if meta == nil {
+		return fmt.Errorf("block at height %d not found", r.height+1)
+	}
+	r.appHash = meta.Header.AppHash
+	return nil
+}
+
+// Close closes the Tendermint stores.
+func (r *tendermintRollback) Close() {
+	_ = r.blockStore.Close()
+	_ = r.stateStore.Close()
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// snapshotArchiveMetadata is the name of the archive entry holding the metadata of a snapshot
+	snapshotArchiveMetadata = "metadata"
+	// snapshotArchiveBootstrap is the name of the archive entry holding the bootstrap state of a height
+	snapshotArchiveBootstrap = "bootstrap"
+)
+
+// writeSnapshotArchive writes a snapshot of the store to w as a tar archive. The archive contains the
+// snapshots of the chain of the snapshot, from the full snapshot to the snapshot itself, each as a
+// <height>/<format>/metadata entry followed by its <height>/<format>/<chunk> entries, and the
+// bootstrap state of the height as a <height>/bootstrap entry if it is not nil.
+func writeSnapshotArchive(store *snapshots.Store, height uint64, format uint32, bootstrap []byte, w io.Writer) error {
+	chain, err := store.Chain(height, format)
+	if err != nil {
+		return err
+	}
+	if len(chain) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+
+	tw := tar.NewWriter(w)
+	writeEntry := func(name string, size int64, body io.Reader) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0o644,
+			Size: size,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, body)
+		return err
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		snapshot := chain[i]
+		dir := path.Join(strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10))
+		metadata, err := proto.Marshal(snapshot)
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+		}
+		if err := writeEntry(path.Join(dir, snapshotArchiveMetadata), int64(len(metadata)), bytes.NewReader(metadata)); err != nil {
+			return err
+		}
+		for chunk := uint32(0); chunk < snapshot.Chunks; chunk++ {
+			if err := writeSnapshotArchiveChunk(store, snapshot, chunk, dir, writeEntry); err != nil {
+				return err
+			}
+		}
+	}
+	if bootstrap != nil {
+		name := path.Join(strconv.FormatUint(height, 10), snapshotArchiveBootstrap)
+		if err := writeEntry(name, int64(len(bootstrap)), bytes.NewReader(bootstrap)); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// writeSnapshotArchiveChunk writes a chunk of a snapshot to a snapshot archive.
+func writeSnapshotArchiveChunk(
+	store *snapshots.Store, snapshot *snapshottypes.Snapshot, chunk uint32, dir string,
+	writeEntry func(name string, size int64, body io.Reader) error,
+) error {
+	reader, err := store.LoadChunk(snapshot.Height, snapshot.Format, chunk)
+	if err != nil {
+		return err
+	}
+	if reader == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "chunk %v of snapshot for height %v format %v",
+			chunk, snapshot.Height, snapshot.Format)
+	}
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return writeEntry(path.Join(dir, strconv.FormatUint(uint64(chunk), 10)), int64(len(body)), bytes.NewReader(body))
+}
+
+// loadSnapshotArchive loads the snapshots of an archive written by writeSnapshotArchive into the store,
+// and returns the last one. The snapshots already in the store are skipped if they are identical. The
+// chunks are checked against the snapshot metadata, and a snapshot whose chunks don't match is deleted.
+func loadSnapshotArchive(store *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+
+	var last *snapshottypes.Snapshot
+	for err == nil {
+		parts := strings.Split(header.Name, "/")
+		switch {
+		case len(parts) == 3 && parts[2] == snapshotArchiveMetadata:
+			var snapshot snapshottypes.Snapshot
+			if err := readSnapshotArchiveEntry(tr, header, &snapshot); err != nil {
+				return nil, err
+			}
+			if path.Join(strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10)) !=
+				path.Join(parts[0], parts[1]) {
+				return nil, fmt.Errorf("snapshot metadata entry %q doesn't match the snapshot", header.Name)
+			}
+			header, err = loadSnapshotArchiveChunks(store, &snapshot, tr)
+			last = &snapshot
+
+		case len(parts) == 2 && parts[1] == snapshotArchiveBootstrap:
+			if last == nil || parts[0] != strconv.FormatUint(last.Height, 10) {
+				return nil, fmt.Errorf("bootstrap state entry %q doesn't follow the snapshot of its height", header.Name)
+			}
+			bootstrap, readErr := io.ReadAll(tr)
+			if readErr != nil {
+				return nil, readErr
+			}
+			if err := store.SaveBootstrapState(last.Height, bootstrap); err != nil {
+				return nil, err
+			}
+			header, err = tr.Next()
+
+		default:
+			return nil, fmt.Errorf("unexpected snapshot archive entry %q", header.Name)
+		}
+	}
+	if err != io.EOF {
+		return nil, err
+	}
+	if last == nil {
+		return nil, fmt.Errorf("snapshot archive is empty")
+	}
+	return last, nil
+}
+
+// loadSnapshotArchiveChunks saves the snapshot from the chunk entries following its metadata entry,
+// and returns the next archive entry.
+func loadSnapshotArchiveChunks(
+	store *snapshots.Store, snapshot *snapshottypes.Snapshot, tr *tar.Reader,
+) (*tar.Header, error) {
+	existing, err := store.Get(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && !bytes.Equal(existing.Hash, snapshot.Hash) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict, "another snapshot exists for height %v format %v",
+			snapshot.Height, snapshot.Format)
+	}
+
+	created := existing == nil
+	chunks := make(chan io.ReadCloser)
+	saved := make(chan error, 1)
+	go func() {
+		if !created {
+			snapshots.DrainChunks(chunks)
+			saved <- nil
+			return
+		}
+		var err error
+		if snapshottypes.IsDeltaFormat(snapshot.Format) {
+			existing, err = store.SaveDelta(snapshot.Height, snapshot.Format, snapshot.Metadata.BaseHeight, chunks)
+		} else {
+			existing, err = store.Save(snapshot.Height, snapshot.Format, chunks)
+		}
+		saved <- err
+	}()
+
+	prefix := path.Join(strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10)) + "/"
+	chunk := uint32(0)
+	header, err := tr.Next()
+	for ; err == nil && strings.HasPrefix(header.Name, prefix); header, err = tr.Next() {
+		if header.Name != prefix+strconv.FormatUint(uint64(chunk), 10) {
+			err = fmt.Errorf("unexpected snapshot archive entry %q", header.Name)
+			break
+		}
+		var body []byte
+		if body, err = io.ReadAll(tr); err != nil {
+			break
+		}
+		chunks <- io.NopCloser(bytes.NewReader(body))
+		chunk++
+	}
+	close(chunks)
+	if saveErr := <-saved; saveErr != nil {
+		return nil, saveErr
+	}
+	if err == nil || err == io.EOF {
+		if existing.Chunks == snapshot.Chunks && bytes.Equal(existing.Hash, snapshot.Hash) {
+			return header, err
+		}
+		err = sdkerrors.Wrapf(snapshottypes.ErrChunkHashMismatch,
+			"chunks of snapshot for height %v format %v don't match its metadata", snapshot.Height, snapshot.Format)
+	}
+	// the snapshot saved from a bad archive is deleted
+	if created {
+		if deleteErr := store.Delete(existing.Height, existing.Format); deleteErr != nil {
+			return nil, deleteErr
+		}
+	}
+	return nil, err
+}
+
+// readSnapshotArchiveEntry decodes an archive entry holding a Protobuf message.
+func readSnapshotArchiveEntry(tr *tar.Reader, header *tar.Header, msg proto.Message) error {
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return err
+	}
+	return sdkerrors.Wrapf(proto.Unmarshal(bz, msg), "failed to decode snapshot archive entry %q", header.Name)
+}
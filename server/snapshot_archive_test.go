@@ -0,0 +1,92 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func makeSnapshotChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func readSnapshotChunks(t *testing.T, store *snapshots.Store, snapshot *snapshottypes.Snapshot) [][]byte {
+	chunks := [][]byte{}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		reader, err := store.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunk, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	source, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	_, err = source.Save(2, snapshottypes.ZstdFormat, makeSnapshotChunks([]byte{2, 0}, []byte{2, 1}))
+	require.NoError(t, err)
+	_, err = source.SaveDelta(4, snapshottypes.ZstdDeltaFormat, 2, makeSnapshotChunks([]byte{4, 0}))
+	require.NoError(t, err)
+	_, err = source.Save(6, snapshottypes.CurrentFormat, makeSnapshotChunks([]byte{6, 0}))
+	require.NoError(t, err)
+
+	// a delta snapshot is dumped along with the snapshots it is based on
+	var archive bytes.Buffer
+	err = writeSnapshotArchive(source, 4, snapshottypes.ZstdDeltaFormat, []byte("bootstrap"), &archive)
+	require.NoError(t, err)
+	err = writeSnapshotArchive(source, 3, snapshottypes.ZstdFormat, nil, io.Discard)
+	require.Error(t, err)
+
+	target, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	loaded, err := loadSnapshotArchive(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.EqualValues(t, 4, loaded.Height)
+	for _, height := range []uint64{2, 4} {
+		format := snapshottypes.ZstdFormat
+		if height == 4 {
+			format = snapshottypes.ZstdDeltaFormat
+		}
+		expected, err := source.Get(height, format)
+		require.NoError(t, err)
+		actual, err := target.Get(height, format)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		require.Equal(t, readSnapshotChunks(t, source, expected), readSnapshotChunks(t, target, actual))
+	}
+	snapshot, err := target.Get(6, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+	bootstrap, err := target.GetBootstrapState(4)
+	require.NoError(t, err)
+	require.Equal(t, []byte("bootstrap"), bootstrap)
+
+	// loading an archive again is a no-op
+	_, err = loadSnapshotArchive(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	// the snapshots of a corrupted archive are rejected
+	corrupted := bytes.Replace(archive.Bytes(), []byte{2, 1}, []byte{2, 9}, 1)
+	require.NotEqual(t, archive.Bytes(), corrupted)
+	other, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	_, err = loadSnapshotArchive(other, bytes.NewReader(corrupted))
+	require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+	snapshot, err = other.Get(2, snapshottypes.ZstdFormat)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// snapshotBootstrapMaxSize limits the size of the messages of a bootstrap state
+const snapshotBootstrapMaxSize = 64 << 20
+
+// exportBootstrapState returns the bootstrap state of a snapshot height, read from the Tendermint
+// stores of the node: the Tendermint state, block and commit at the height, encoded as length-prefixed
+// Protobuf messages. It lets a node restoring a snapshot of the height start without state syncing.
+// The block following the height must have been committed.
+func exportBootstrapState(cfg *tmcfg.Config, height int64) ([]byte, error) {
+	blockStore, stateStore, err := openTendermintStores(cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		return nil, fmt.Errorf("no block at height %d, the block following the snapshot height", height+1)
+	}
+	state, err := tendermintStateAt(blockStore, stateStore, height)
+	if err != nil {
+		return nil, err
+	}
+
+	stateProto, err := state.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	blockProto, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writer := protoio.NewDelimitedWriter(&buf)
+	for _, msg := range []proto.Message{stateProto, blockProto, commit.ToProto()} {
+		if err := writer.WriteMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// tendermintStateAt returns the Tendermint state after the block of the height, as built by state sync
+// from the headers of the following blocks. The block following the height must have been committed.
+func tendermintStateAt(blockStore *store.BlockStore, stateStore sm.Store, height int64) (sm.State, error) {
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return sm.State{}, fmt.Errorf("no block at height %d", height)
+	}
+	next := blockStore.LoadBlockMeta(height + 1)
+	if next == nil {
+		return sm.State{}, fmt.Errorf("no block at height %d, the block following height %d", height+1, height)
+	}
+	latest, err := stateStore.Load()
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state := sm.State{
+		Version: tmstate.Version{
+			Consensus: next.Header.Version,
+			Software:  tmversion.TMCoreSemVer,
+		},
+		ChainID:                          latest.ChainID,
+		InitialHeight:                    latest.InitialHeight,
+		LastBlockHeight:                  height,
+		LastBlockID:                      next.Header.LastBlockID,
+		LastBlockTime:                    meta.Header.Time,
+		LastHeightValidatorsChanged:      height + 2,
+		LastHeightConsensusParamsChanged: height + 1,
+		LastResultsHash:                  next.Header.LastResultsHash,
+		AppHash:                          next.Header.AppHash,
+	}
+	if state.LastValidators, err = stateStore.LoadValidators(height); err != nil {
+		return sm.State{}, err
+	}
+	if state.Validators, err = stateStore.LoadValidators(height + 1); err != nil {
+		return sm.State{}, err
+	}
+	if state.NextValidators, err = stateStore.LoadValidators(height + 2); err != nil {
+		return sm.State{}, err
+	}
+	if state.ConsensusParams, err = stateStore.LoadConsensusParams(height + 1); err != nil {
+		return sm.State{}, err
+	}
+	return state, nil
+}
+
+// bootstrapTendermint bootstraps the empty Tendermint stores of the node from the bootstrap state
+// of a snapshot height, once the snapshot has been restored with the given app hash.
+func bootstrapTendermint(cfg *tmcfg.Config, bootstrap []byte, appHash []byte) (int64, error) {
+	state, block, commit, err := decodeBootstrapState(bootstrap)
+	if err != nil {
+		return 0, err
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return 0, err
+	}
+	if state.ChainID != genDoc.ChainID {
+		return 0, fmt.Errorf("bootstrap state is for chain %q, expected %q", state.ChainID, genDoc.ChainID)
+	}
+	if !bytes.Equal(state.AppHash, appHash) {
+		return 0, fmt.Errorf("app hash %X of the restored snapshot doesn't match the app hash %X of the bootstrap state",
+			appHash, state.AppHash)
+	}
+	blockParts := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+	blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
+	if block.Height != state.LastBlockHeight || !blockID.Equals(state.LastBlockID) {
+		return 0, fmt.Errorf("bootstrap block %v doesn't match the bootstrap state", blockID)
+	}
+	if commit.Height != block.Height || !commit.BlockID.Equals(blockID) {
+		return 0, fmt.Errorf("bootstrap commit for block %v doesn't match the bootstrap block", commit.BlockID)
+	}
+
+	blockStore, stateStore, err := openTendermintStores(cfg, true)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+	latest, err := stateStore.Load()
+	if err != nil {
+		return 0, err
+	}
+	if blockStore.Height() != 0 || latest.LastBlockHeight != 0 {
+		return 0, fmt.Errorf("tendermint state is not empty, at height %d", latest.LastBlockHeight)
+	}
+
+	if err := stateStore.Bootstrap(*state); err != nil {
+		return 0, err
+	}
+	blockStore.SaveBlock(block, blockParts, commit)
+	return state.LastBlockHeight, nil
+}
+
+// decodeBootstrapState decodes a bootstrap state encoded by exportBootstrapState.
+func decodeBootstrapState(bootstrap []byte) (*sm.State, *tmtypes.Block, *tmtypes.Commit, error) {
+	var (
+		stateProto  tmstate.State
+		blockProto  tmproto.Block
+		commitProto tmproto.Commit
+	)
+	reader := protoio.NewDelimitedReader(bytes.NewReader(bootstrap), snapshotBootstrapMaxSize)
+	for _, msg := range []proto.Message{&stateProto, &blockProto, &commitProto} {
+		if err := reader.ReadMsg(msg); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, nil, nil, fmt.Errorf("failed to decode bootstrap state: %w", err)
+		}
+	}
+
+	state, err := sm.FromProto(&stateProto)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	block, err := tmtypes.BlockFromProto(&blockProto)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	commit, err := tmtypes.CommitFromProto(&commitProto)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return state, block, commit, nil
+}
+
+// openTendermintStores opens the Tendermint block and state stores of the node. They are
+// created if they don't exist and create is true.
+func openTendermintStores(cfg *tmcfg.Config, create bool) (*store.BlockStore, sm.Store, error) {
+	blockStoreDB, stateDB, err := openTendermintDBs(cfg, create)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return store.NewBlockStore(blockStoreDB), stateStore, nil
+}
+
+// openTendermintDBs opens the databases of the Tendermint block and state stores of the node.
+func openTendermintDBs(cfg *tmcfg.Config, create bool) (blockStoreDB, stateDB dbm.DB, err error) {
+	dbType := dbm.BackendType(cfg.DBBackend)
+	for _, name := range []string{"blockstore", "state"} {
+		if !create && !tmos.FileExists(filepath.Join(cfg.DBDir(), name+".db")) {
+			return nil, nil, fmt.Errorf("no %s found in %v", name, cfg.DBDir())
+		}
+	}
+
+	blockStoreDB, err = dbm.NewDB("blockstore", dbType, cfg.DBDir())
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err = dbm.NewDB("state", dbType, cfg.DBDir())
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+	return blockStoreDB, stateDB, nil
+}
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagSnapshotOutput = "output"
+
+// NewSnapshotsCmd returns the command managing the local state sync snapshots of a node. The node
+// must be stopped while they run.
+func NewSnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `Manage the local state sync snapshots of a stopped node.
+
+Snapshots can be dumped to an archive and loaded into the snapshot store of another
+node, which can serve them to its peers or restore its state from them. The archive
+of a snapshot carries the Tendermint state at the snapshot height when the node which
+dumped it has the following block, so a node restoring a snapshot loaded from such an
+archive can start from it without state syncing.`,
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(appCreator),
+		RestoreSnapshotCmd(appCreator),
+		DumpSnapshotCmd(),
+		LoadSnapshotCmd(),
+		DeleteSnapshotCmd(),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// ListSnapshotsCmd returns the command listing the local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			snapshots, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, snapshot := range snapshots {
+				line := fmt.Sprintf("height: %d format: %d chunks: %d", snapshot.Height, snapshot.Format, snapshot.Chunks)
+				if snapshot.Metadata.BaseHeight > 0 {
+					line += fmt.Sprintf(" base height: %d", snapshot.Metadata.BaseHeight)
+				}
+				cmd.Println(line)
+			}
+			return nil
+		},
+	}
+}
+
+// ExportSnapshotCmd returns the command taking a snapshot of the application state.
+func ExportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the application state at the latest height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			manager := app.SnapshotManager()
+			if manager == nil {
+				return fmt.Errorf("the app has no snapshot store")
+			}
+
+			height := app.Info(abci.RequestInfo{}).LastBlockHeight
+			if height <= 0 {
+				return fmt.Errorf("the app has no state to snapshot")
+			}
+			snapshot, err := manager.Create(uint64(height))
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Exported snapshot at height %d, format %d, with %d chunks\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+	return cmd
+}
+
+// RestoreSnapshotCmd returns the command restoring the application state from a local snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state of an empty node from a local snapshot.
+
+If the snapshot was loaded from an archive carrying the Tendermint state at its height,
+the empty Tendermint stores of the node are bootstrapped from it as well, and the node
+can be started without state syncing.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			manager := app.SnapshotManager()
+			if manager == nil {
+				return fmt.Errorf("the app has no snapshot store")
+			}
+
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+			info := app.Info(abci.RequestInfo{})
+			cmd.Printf("Restored snapshot at height %d with app hash %X\n", info.LastBlockHeight, info.LastBlockAppHash)
+
+			bootstrap, err := manager.GetBootstrapState(height)
+			if err != nil {
+				return err
+			}
+			if bootstrap == nil {
+				cmd.Println("No Tendermint state is available for the snapshot height, the Tendermint stores were not bootstrapped")
+				return nil
+			}
+			bootstrapped, err := bootstrapTendermint(ctx.Config, bootstrap, info.LastBlockAppHash)
+			if err != nil {
+				return fmt.Errorf("failed to bootstrap tendermint state: %w", err)
+			}
+			cmd.Printf("Bootstrapped Tendermint state at height %d\n", bootstrapped)
+			return nil
+		},
+	}
+}
+
+// DumpSnapshotCmd returns the command dumping a local snapshot to an archive.
+func DumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to a tar archive",
+		Long: `Dump a local snapshot to a tar archive, along with the snapshots it is based on.
+
+The Tendermint state at the snapshot height is added to the archive if the node has
+the block following the snapshot height.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			ctx := GetServerContextFromCmd(cmd)
+			store, err := GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			snapshot, err := store.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("no snapshot for height %d format %d", height, format)
+			}
+
+			bootstrap, err := store.GetBootstrapState(height)
+			if err != nil {
+				return err
+			}
+			if bootstrap == nil {
+				bootstrap, err = exportBootstrapState(ctx.Config, int64(height))
+				if err != nil {
+					cmd.PrintErrf("WARNING: the archive won't carry the Tendermint state: %v\n", err)
+				}
+			}
+
+			output, _ := cmd.Flags().GetString(flagSnapshotOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			if err := writeSnapshotArchive(store, height, format, bootstrap, file); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			cmd.Printf("Dumped snapshot at height %d, format %d, to %s\n", height, format, output)
+			return nil
+		},
+	}
+	cmd.Flags().StringP(flagSnapshotOutput, "o", "", "Output file, <height>-<format>.tar by default")
+	return cmd
+}
+
+// LoadSnapshotCmd returns the command loading a snapshot archive into the local snapshot store.
+func LoadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot archive into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			snapshot, err := loadSnapshotArchive(store, file)
+			if err != nil {
+				return fmt.Errorf("failed to load snapshot archive: %w", err)
+			}
+			cmd.Printf("Loaded snapshot at height %d, format %d\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// DeleteSnapshotCmd returns the command deleting a local snapshot.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			return store.Delete(height, format)
+		},
+	}
+}
+
+// parseSnapshotArgs parses the height and format arguments of the snapshot commands.
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -56,10 +57,23 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagStateHistory      = "state-history"
+	FlagBlockWitnesses    = "block-witnesses"
+	FlagStoreV2           = "store-v2"
+	FlagStorePruning      = "store-pruning"
+	FlagStoreMetrics      = "telemetry.store-metrics"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval       = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent     = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotMaxDeltas      = "state-sync.snapshot-max-deltas"
+	FlagStateSyncSnapshotCompression    = "state-sync.snapshot-compression"
+	FlagStateSyncSnapshotExportWorkers  = "state-sync.snapshot-export-workers"
+	FlagStateSyncSnapshotSigningKeyFile = "state-sync.snapshot-signing-key-file"
+	FlagStateSyncSnapshotTrustedSigners = "state-sync.snapshot-trusted-signers"
+
+	// mempool flags
+	FlagMempoolMaxTxs = "mempool.max-txs"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -166,6 +180,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Bool(FlagStateHistory, false, "Save the state changes of each height to a history DB serving queries at pruned heights")
+	cmd.Flags().Bool(FlagBlockWitnesses, false, "Save the witness of each block, with the proofs of its reads and writes, to a witness DB")
+	cmd.Flags().Bool(FlagStoreV2, false, "Use the SMT-based v2alpha1 multistore as the application state")
 
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
@@ -185,6 +202,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotMaxDeltas, 0, "State sync delta snapshots to take between full snapshots")
+	cmd.Flags().String(FlagStateSyncSnapshotCompression, serverconfig.DefaultSnapshotCompression, "State sync snapshot compression (zlib|zstd)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotExportWorkers, serverconfig.DefaultSnapshotExportWorkers, "State sync snapshot stores to export concurrently")
+	cmd.Flags().String(FlagStateSyncSnapshotSigningKeyFile, "", "Node key file signing zstd state sync snapshots, relative to the node home")
+	cmd.Flags().StringSlice(FlagStateSyncSnapshotTrustedSigners, []string{}, "Node IDs whose signed state sync snapshots are restored")
+
+	cmd.Flags().Int(FlagMempoolMaxTxs, serverconfig.DefaultMempoolMaxTxs, "Maximum number of txs in the app-side mempool (-1 disables it, 0 means unbounded)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -219,6 +243,12 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		return err
 	}
 
+	tracing, err := telemetry.NewTracing(config.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer stopTracing(ctx, tracing)
+
 	svr, err := server.NewServer(addr, transport, app)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
@@ -286,6 +316,14 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	// the tracing is set up before the app replays or executes any block, and
+	// stopped once the node is stopped, exporting the spans of the last blocks
+	tracing, err := telemetry.NewTracing(config.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer stopTracing(ctx, tracing)
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -517,3 +555,16 @@ func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	}
 	return telemetry.New(cfg.Telemetry)
 }
+
+// stopTracing exports the pending spans of the tracing, if any, and stops it.
+func stopTracing(ctx *Context, tracing *telemetry.Tracing) {
+	if tracing == nil {
+		return
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracing.Shutdown(shutdownCtx); err != nil {
+		ctx.Logger.Error("failed to export the pending spans", "err", err)
+	}
+}
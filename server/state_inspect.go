@@ -0,0 +1,420 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHomeA       = "home-a"
+	flagHomeB       = "home-b"
+	flagStateHeight = "height"
+	flagStateStores = "stores"
+	flagStateOutput = "output"
+)
+
+// NewStateDiffCmd creates a command comparing the application states of two node homes at a height.
+func NewStateDiffCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Compare the application states of two nodes at a height",
+		Long: fmt.Sprintf(`Compare the application states of the nodes of two homes at a height, e.g. to
+investigate an app hash mismatch. The commit hashes of the stores are compared, and
+the keys of the IAVL stores whose hashes differ are walked to print the keys whose
+values differ, decoded with the store decoders of the modules of the application if
+any. The height defaults to the latest height of both states. The nodes must be
+stopped.
+
+Example:
+$ %s debug state-diff --home-a ./node0 --home-b ./node1 --height 1200 --stores bank,staking
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			homeA, _ := cmd.Flags().GetString(flagHomeA)
+			homeB, _ := cmd.Flags().GetString(flagHomeB)
+			if homeA == "" || homeB == "" {
+				return errors.New("both --home-a and --home-b must be set")
+			}
+			height, _ := cmd.Flags().GetInt64(flagStateHeight)
+			names, _ := cmd.Flags().GetStringSlice(flagStateStores)
+
+			stateA, err := openAppState(ctx, appCreator, homeA)
+			if err != nil {
+				return err
+			}
+			defer stateA.Close()
+			stateB, err := openAppState(ctx, appCreator, homeB)
+			if err != nil {
+				return err
+			}
+			defer stateB.Close()
+
+			if height == 0 {
+				height = stateA.cms.LastCommitID().Version
+				if latest := stateB.cms.LastCommitID().Version; latest < height {
+					height = latest
+				}
+				if height == 0 {
+					return errors.New("the application states have no common committed height")
+				}
+			}
+
+			_, err = diffStates(cmd.OutOrStdout(), stateA.cms, stateB.cms, height, names, stateA.decoders)
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagHomeA, "", "The home directory of the first node")
+	cmd.Flags().String(flagHomeB, "", "The home directory of the second node")
+	cmd.Flags().Int64(flagStateHeight, 0, "The height to compare, defaults to the latest height of both states")
+	cmd.Flags().StringSlice(flagStateStores, nil, "The names of the stores to compare, defaults to all the stores")
+	return cmd
+}
+
+// NewStateDumpCmd creates a command writing the key-value pairs of a store of the application state to JSON.
+func NewStateDumpCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-dump [store]",
+		Short: "Write a store of the application state to JSON",
+		Long: `Write the key-value pairs of an IAVL store of the application state at a height to
+JSON, with the commit hash of the store. The keys and values are hex encoded. The
+height defaults to the latest height. The node must be stopped.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			height, _ := cmd.Flags().GetInt64(flagStateHeight)
+			output, _ := cmd.Flags().GetString(flagStateOutput)
+
+			state, err := openAppState(ctx, appCreator, ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer state.Close()
+			if height == 0 {
+				height = state.cms.LastCommitID().Version
+				if height == 0 {
+					return errors.New("the application state has no committed height")
+				}
+			}
+
+			w := cmd.OutOrStdout()
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			return dumpStore(w, state.cms, args[0], height)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagStateHeight, 0, "The height to dump, defaults to the latest height")
+	cmd.Flags().String(flagStateOutput, "", "The file to write the JSON to, defaults to stdout")
+	return cmd
+}
+
+// homeAppOptions are the app options of the server with the home directory of another node.
+type homeAppOptions struct {
+	types.AppOptions
+	home string
+}
+
+func (o homeAppOptions) Get(key string) interface{} {
+	if key == flags.FlagHome {
+		return o.home
+	}
+	return o.AppOptions.Get(key)
+}
+
+// simulationApp is an app with a simulation manager, providing the store decoders of its modules.
+type simulationApp interface {
+	SimulationManager() *module.SimulationManager
+}
+
+// appState is the application state of a node home, opened by the app of the server.
+type appState struct {
+	db       dbm.DB
+	cms      *rootmulti.Store
+	decoders sdk.StoreDecoderRegistry
+}
+
+// openAppState opens the application state of a node home with the stores mounted by the app, and the
+// store decoders of its modules if it has a simulation manager.
+func openAppState(ctx *Context, appCreator types.AppCreator, home string) (*appState, error) {
+	if ctx.Viper.GetBool(FlagStoreV2) {
+		return nil, errors.New("the state of the v2alpha1 multistore cannot be inspected")
+	}
+
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, err
+	}
+	app := appCreator(ctx.Logger, db, nil, homeAppOptions{AppOptions: ctx.Viper, home: home})
+	cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		db.Close()
+		return nil, fmt.Errorf("cannot inspect the state of a %T multistore", app.CommitMultiStore())
+	}
+
+	state := &appState{db: db, cms: cms}
+	if app, ok := app.(simulationApp); ok && app.SimulationManager() != nil {
+		state.decoders = app.SimulationManager().StoreDecoders
+	}
+	return state, nil
+}
+
+func (s *appState) Close() error {
+	return s.db.Close()
+}
+
+// storeAtHeight returns the IAVL store of the given name of a multistore at a height, for reading only.
+func storeAtHeight(cms *rootmulti.Store, name string, height int64) (*iavl.Store, error) {
+	key, ok := cms.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("no store %s is mounted", name)
+	}
+	store, ok := cms.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("store %s is not an IAVL store", name)
+	}
+	if !store.VersionExists(height) {
+		return nil, fmt.Errorf("height %d doesn't exist in store %s", height, name)
+	}
+	return store.GetImmutable(height)
+}
+
+// diffStates writes the differences between the states of two multistores at a height to w: the stores
+// whose commit hashes differ, and the keys whose values differ in the IAVL stores existing in both. The
+// names restrict the stores compared if set. It returns the number of stores which differ.
+func diffStates(
+	w io.Writer, a, b *rootmulti.Store, height int64, names []string, decoders sdk.StoreDecoderRegistry,
+) (int, error) {
+	infoA, err := a.GetCommitInfo(height)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the commit info of height %d of state A: %w", height, err)
+	}
+	infoB, err := b.GetCommitInfo(height)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the commit info of height %d of state B: %w", height, err)
+	}
+
+	hashesA := make(map[string][]byte, len(infoA.StoreInfos))
+	for _, info := range infoA.StoreInfos {
+		hashesA[info.Name] = info.CommitId.Hash
+	}
+	hashesB := make(map[string][]byte, len(infoB.StoreInfos))
+	for _, info := range infoB.StoreInfos {
+		hashesB[info.Name] = info.CommitId.Hash
+	}
+	if len(names) == 0 {
+		for name := range hashesA {
+			names = append(names, name)
+		}
+		for name := range hashesB {
+			if _, ok := hashesA[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+
+	fmt.Fprintf(w, "Height %d: app hash A %X, app hash B %X\n", height, infoA.Hash(), infoB.Hash())
+	differing := 0
+	for _, name := range names {
+		hashA, okA := hashesA[name]
+		hashB, okB := hashesB[name]
+		if !okA && !okB {
+			return differing, fmt.Errorf("no store %s at height %d", name, height)
+		}
+		if okA && okB && bytes.Equal(hashA, hashB) {
+			continue
+		}
+
+		differing++
+		fmt.Fprintf(w, "Store %s: hash A %s, hash B %s\n", name, formatHash(hashA, okA), formatHash(hashB, okB))
+		if !okA || !okB {
+			continue
+		}
+
+		storeA, err := storeAtHeight(a, name, height)
+		if err != nil {
+			return differing, err
+		}
+		storeB, err := storeAtHeight(b, name, height)
+		if err != nil {
+			return differing, err
+		}
+		keys, err := diffStore(w, storeA, storeB, decoders[name])
+		if err != nil {
+			return differing, err
+		}
+		fmt.Fprintf(w, "  %d keys differ\n", keys)
+	}
+
+	fmt.Fprintf(w, "%d of %d stores differ\n", differing, len(names))
+	return differing, nil
+}
+
+// diffStore walks two stores in ascending key order, and writes the keys whose values differ to w. It
+// returns the number of keys which differ.
+func diffStore(w io.Writer, a, b sdk.KVStore, decoder func(kvA, kvB kv.Pair) string) (int, error) {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	differing := 0
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		// a key missing from a store is reported with an empty value
+		var pairA, pairB kv.Pair
+		if cmp <= 0 {
+			pairA = kv.Pair{Key: iterA.Key(), Value: iterA.Value()}
+			pairB.Key = pairA.Key
+			iterA.Next()
+		}
+		if cmp >= 0 {
+			pairB = kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
+			pairA.Key = pairB.Key
+			iterB.Next()
+		}
+		if cmp == 0 && bytes.Equal(pairA.Value, pairB.Value) {
+			continue
+		}
+
+		differing++
+		fmt.Fprintf(w, "  Key %X\n", pairA.Key)
+		fmt.Fprintf(w, "    A: %s\n", formatValue(pairA.Value, cmp <= 0))
+		fmt.Fprintf(w, "    B: %s\n", formatValue(pairB.Value, cmp >= 0))
+		if decoded, ok := decodePairs(decoder, pairA, pairB); ok {
+			fmt.Fprintf(w, "    Decoded:\n      %s\n", strings.ReplaceAll(decoded, "\n", "\n      "))
+		}
+	}
+
+	if err := iterA.Error(); err != nil {
+		return differing, err
+	}
+	return differing, iterB.Error()
+}
+
+// decodePairs decodes two pairs of a key with the store decoder of a module, which panics on the keys
+// it doesn't know.
+func decodePairs(decoder func(kvA, kvB kv.Pair) string, a, b kv.Pair) (decoded string, ok bool) {
+	if decoder == nil {
+		return "", false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			decoded, ok = "", false
+		}
+	}()
+	return decoder(a, b), true
+}
+
+func formatHash(hash []byte, ok bool) string {
+	if !ok {
+		return "<none>"
+	}
+	return fmt.Sprintf("%X", hash)
+}
+
+func formatValue(value []byte, ok bool) string {
+	if !ok {
+		return "<none>"
+	}
+	return fmt.Sprintf("%X", value)
+}
+
+// dumpedPair is a key-value pair of a store dump.
+type dumpedPair struct {
+	Key   tmbytes.HexBytes `json:"key"`
+	Value tmbytes.HexBytes `json:"value"`
+}
+
+// dumpStore writes the key-value pairs of an IAVL store of a multistore at a height to w as a JSON
+// object, along with the commit hash of the store. The pairs are streamed in ascending key order.
+func dumpStore(w io.Writer, cms *rootmulti.Store, name string, height int64) error {
+	info, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("failed to get the commit info of height %d: %w", height, err)
+	}
+	var hash tmbytes.HexBytes
+	for _, storeInfo := range info.StoreInfos {
+		if storeInfo.Name == name {
+			hash = storeInfo.CommitId.Hash
+		}
+	}
+	store, err := storeAtHeight(cms, name, height)
+	if err != nil {
+		return err
+	}
+
+	header, err := json.Marshal(struct {
+		Store  string           `json:"store"`
+		Height int64            `json:"height"`
+		Hash   tmbytes.HexBytes `json:"hash"`
+	}{name, height, hash})
+	if err != nil {
+		return err
+	}
+	// the pairs are appended to the header object
+	if _, err := fmt.Fprintf(w, "%s,\"pairs\":[", header[:len(header)-1]); err != nil {
+		return err
+	}
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for first := true; iter.Valid(); iter.Next() {
+		bz, err := json.Marshal(dumpedPair{Key: iter.Key(), Value: iter.Value()})
+		if err != nil {
+			return err
+		}
+		if !first {
+			bz = append([]byte{','}, bz...)
+		}
+		first = false
+		if _, err := w.Write(bz); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
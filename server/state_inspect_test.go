@@ -0,0 +1,131 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// newInspectedStore returns a multistore with the stores acc and bank, committing the pairs of each
+// store at height 1.
+func newInspectedStore(t *testing.T, pairs map[string][]kv.Pair) *rootmulti.Store {
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	keys := map[string]storetypes.StoreKey{}
+	for _, name := range []string{"acc", "bank"} {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		cms.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for name, storePairs := range pairs {
+		store := cms.GetKVStore(keys[name])
+		for _, pair := range storePairs {
+			store.Set(pair.Key, pair.Value)
+		}
+	}
+	cms.Commit()
+	return cms
+}
+
+func TestDiffStates(t *testing.T) {
+	a := newInspectedStore(t, map[string][]kv.Pair{
+		"acc":  {{Key: []byte{1}, Value: []byte{1}}},
+		"bank": {{Key: []byte{1}, Value: []byte{1}}, {Key: []byte{2}, Value: []byte{2}}, {Key: []byte{4}, Value: []byte{4}}},
+	})
+	b := newInspectedStore(t, map[string][]kv.Pair{
+		"acc":  {{Key: []byte{1}, Value: []byte{1}}},
+		"bank": {{Key: []byte{1}, Value: []byte{1}}, {Key: []byte{2}, Value: []byte{3}}, {Key: []byte{3}, Value: []byte{3}}},
+	})
+	decoders := sdk.StoreDecoderRegistry{
+		"bank": func(kvA, kvB kv.Pair) string {
+			if kvA.Key[0] == 4 {
+				panic("unknown key")
+			}
+			return fmt.Sprintf("%d\n%d", kvA.Value, kvB.Value)
+		},
+	}
+
+	var out bytes.Buffer
+	differing, err := diffStates(&out, a, b, 1, nil, decoders)
+	require.NoError(t, err)
+	require.Equal(t, 1, differing)
+
+	hashes := func(s *rootmulti.Store) map[string][]byte {
+		info, err := s.GetCommitInfo(1)
+		require.NoError(t, err)
+		hashes := map[string][]byte{}
+		for _, storeInfo := range info.StoreInfos {
+			hashes[storeInfo.Name] = storeInfo.CommitId.Hash
+		}
+		return hashes
+	}
+	require.Equal(t, fmt.Sprintf(`Height 1: app hash A %X, app hash B %X
+Store bank: hash A %X, hash B %X
+  Key 02
+    A: 02
+    B: 03
+    Decoded:
+      [2]
+      [3]
+  Key 03
+    A: <none>
+    B: 03
+    Decoded:
+      []
+      [3]
+  Key 04
+    A: 04
+    B: <none>
+  3 keys differ
+1 of 2 stores differ
+`, a.LastCommitID().Hash, b.LastCommitID().Hash, hashes(a)["bank"], hashes(b)["bank"]), out.String())
+
+	// the stores compared can be restricted
+	out.Reset()
+	differing, err = diffStates(&out, a, b, 1, []string{"acc"}, decoders)
+	require.NoError(t, err)
+	require.Equal(t, 0, differing)
+
+	_, err = diffStates(&out, a, b, 1, []string{"gov"}, decoders)
+	require.EqualError(t, err, "no store gov at height 1")
+	_, err = diffStates(&out, a, b, 2, nil, decoders)
+	require.ErrorContains(t, err, "failed to get the commit info of height 2 of state A")
+}
+
+func TestDumpStore(t *testing.T) {
+	cms := newInspectedStore(t, map[string][]kv.Pair{
+		"bank": {{Key: []byte{1}, Value: []byte{0xa}}, {Key: []byte{2}, Value: []byte{0xb}}},
+	})
+
+	var out bytes.Buffer
+	require.NoError(t, dumpStore(&out, cms, "bank", 1))
+
+	var dump struct {
+		Store  string
+		Height int64
+		Hash   string
+		Pairs  []map[string]string
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &dump))
+	require.Equal(t, "bank", dump.Store)
+	require.Equal(t, int64(1), dump.Height)
+	require.Len(t, dump.Hash, 64)
+	require.Equal(t, []map[string]string{{"key": "01", "value": "0A"}, {"key": "02", "value": "0B"}}, dump.Pairs)
+
+	// an empty store is dumped without pairs
+	out.Reset()
+	require.NoError(t, dumpStore(&out, cms, "acc", 1))
+	require.Contains(t, out.String(), `"pairs":[]}`)
+
+	require.EqualError(t, dumpStore(&out, cms, "gov", 1), "no store gov is mounted")
+}
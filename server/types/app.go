@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -51,6 +53,14 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for tendermint queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// SnapshotManager returns the snapshot manager, or nil if the app has no
+		// snapshot store.
+		SnapshotManager() *snapshots.Manager
+
+		// CommitMultiStore returns the multistore of the application state. It
+		// must not be used while the app is running.
+		CommitMultiStore() storetypes.CommitMultiStore
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
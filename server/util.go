@@ -26,8 +26,13 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/history"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	"github.com/cosmos/cosmos-sdk/store/witness"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -290,7 +295,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
-		NewRollbackCmd(defaultNodeHome),
+		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewSnapshotsCmd(appCreator, defaultNodeHome),
+		NewMigrateStoreCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -371,6 +378,46 @@ func GetAppDBBackend(opts types.AppOptions) dbm.BackendType {
 	return dbm.GoLevelDBBackend
 }
 
+// GetSnapshotStore opens the state sync snapshot store of the node home.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// GetHistoryStore opens the state history DB of the node home.
+func GetHistoryStore(appOpts types.AppOptions) (*history.Store, error) {
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	historyDB, err := dbm.NewDB("history", GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return history.NewStore(historyDB)
+}
+
+// GetWitnessStore opens the block witness DB of the node home.
+func GetWitnessStore(appOpts types.AppOptions) (*witness.Store, error) {
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	witnessDB, err := dbm.NewDB("witness", GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return witness.NewStore(witnessDB), nil
+}
+
+// GetStoreV2 returns the v2alpha1 multistore of the node home, to be set as the
+// CommitMultiStore of the app.
+func GetStoreV2(appOpts types.AppOptions) (*multi.Adapter, error) {
+	db, err := openStoreV2DB(cast.ToString(appOpts.Get(flags.FlagHome)))
+	if err != nil {
+		return nil, err
+	}
+	return multi.NewAdapter(db, multi.DefaultStoreConfig()), nil
+}
+
 func skipInterface(iface net.Interface) bool {
 	if iface.Flags&net.FlagUp == 0 {
 		return true // interface down
@@ -400,6 +447,10 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	return dbm.NewDB("application", backendType, dataDir)
 }
 
+func openStoreV2DB(rootDir string) (*badgerdb.BadgerDB, error) {
+	return badgerdb.NewDB(filepath.Join(rootDir, "data", "application_v2"))
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -14,6 +14,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -35,6 +38,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -64,6 +68,27 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppStoreV2(t *testing.T) {
+	db := memdb.NewDB()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:         logger,
+		DB:             dbm.NewMemDB(),
+		AppOpts:        simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome),
+		BaseAppOptions: []func(*baseapp.BaseApp){baseapp.SetCMS(multi.NewAdapter(db, multi.DefaultStoreConfig()))},
+	})
+	cid := app.Commit()
+	require.NoError(t, app.CommitMultiStore().(*multi.Adapter).Close())
+
+	// the state is loaded from the v2alpha1 multistore
+	cms := multi.NewAdapter(db, multi.DefaultStoreConfig())
+	app2 := NewSimApp(logger, dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome), baseapp.SetCMS(cms))
+	require.Equal(t, int64(1), app2.LastBlockHeight())
+	require.Equal(t, cid.Data, app2.LastCommitID().Hash)
+	_, err := app2.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
+}
+
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
@@ -183,6 +208,8 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"gasschedule":  gasschedule.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -0,0 +1,157 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestDeliverTxsDeterminism executes blocks of conflicting bank and staking
+// transactions sequentially and in parallel, and checks that both executions
+// give the same results and AppHashes.
+func TestDeliverTxsDeterminism(t *testing.T) {
+	const chainID = "parallel-chain"
+	genesisTime := time.Unix(1_000_000, 0).UTC()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	valAddr := sdk.ValAddress(valSet.Validators[0].Address)
+
+	privs := make([]cryptotypes.PrivKey, 5)
+	addrs := make([]sdk.AccAddress, len(privs))
+	genAccs := make([]authtypes.GenesisAccount, len(privs))
+	balances := make([]banktypes.Balance, len(privs))
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addrs[i], privs[i].PubKey(), uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addrs[i].String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000)),
+		}
+	}
+
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount) }
+	send := func(from, to int, amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addrs[from], addrs[to], sdk.NewCoins(stake(amount)))
+	}
+	delegate := func(from int, amount int64) sdk.Msg {
+		return stakingtypes.NewMsgDelegate(addrs[from], valAddr, stake(amount))
+	}
+
+	// Most transactions of a block touch the same balances, sequences or
+	// validator, so that their speculative executions conflict.
+	type txSpec struct {
+		signer int
+		msg    sdk.Msg
+	}
+	blockSpecs := [][]txSpec{
+		{
+			{0, send(0, 1, 1_000)},
+			{0, send(0, 2, 1_000)},
+			{1, send(1, 2, 10_500)},
+			{2, delegate(2, 5_000)},
+			{3, delegate(3, 2_000)},
+			{4, send(4, 3, 20_000)}, // fails, the balance is too low
+			{3, send(3, 4, 100)},
+		},
+		{
+			{4, delegate(4, 3_000)},
+			{2, send(2, 0, 6_000)},
+			{0, delegate(0, 8_000)},
+			{1, delegate(1, 1_000)}, // fails, the balance is too low
+			{3, stakingtypes.NewMsgUndelegate(addrs[3], valAddr, stake(1_000))},
+			{2, delegate(2, 1_000)},
+		},
+	}
+
+	// genBlocks signs the transactions of the blocks, which give the same bytes
+	// on every call.
+	genBlocks := func(txConfig client.TxConfig) [][]abci.RequestDeliverTx {
+		r := rand.New(rand.NewSource(1))
+		seqs := make([]uint64, len(privs))
+		blocks := make([][]abci.RequestDeliverTx, len(blockSpecs))
+		for i, specs := range blockSpecs {
+			for _, spec := range specs {
+				tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{spec.msg},
+					sdk.Coins{stake(0)}, simtestutil.DefaultGenTxGas, chainID,
+					[]uint64{uint64(spec.signer)}, []uint64{seqs[spec.signer]}, privs[spec.signer])
+				require.NoError(t, err)
+				seqs[spec.signer]++
+
+				txBytes, err := txConfig.TxEncoder()(tx)
+				require.NoError(t, err)
+				blocks[i] = append(blocks[i], abci.RequestDeliverTx{Tx: txBytes})
+			}
+		}
+
+		return blocks
+	}
+
+	run := func(workers int) ([][]abci.ResponseDeliverTx, [][]byte) {
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+			simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome), baseapp.SetParallelDeliverTx(workers))
+
+		genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), NewDefaultGenesisState(app.AppCodec()), valSet, genAccs, balances...)
+		require.NoError(t, err)
+		stateBytes, err := json.Marshal(genesisState)
+		require.NoError(t, err)
+
+		app.InitChain(abci.RequestInitChain{
+			Time:            genesisTime,
+			ChainId:         chainID,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		app.Commit()
+
+		var (
+			responses [][]abci.ResponseDeliverTx
+			appHashes [][]byte
+		)
+		for i, block := range genBlocks(app.TxConfig()) {
+			header := tmproto.Header{
+				ChainID: chainID,
+				Height:  app.LastBlockHeight() + 1,
+				Time:    genesisTime.Add(time.Duration(i+1) * time.Minute),
+			}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			responses = append(responses, app.DeliverTxs(block))
+			app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+			appHashes = append(appHashes, app.Commit().Data)
+		}
+
+		return responses, appHashes
+	}
+
+	seqResponses, seqHashes := run(1)
+	parResponses, parHashes := run(4)
+
+	// the blocks contain both successful and failed transactions
+	require.Zero(t, seqResponses[0][0].Code)
+	require.NotZero(t, seqResponses[0][5].Code)
+
+	require.Equal(t, seqResponses, parResponses)
+	require.Equal(t, seqHashes, parHashes)
+}
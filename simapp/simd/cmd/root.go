@@ -12,6 +12,7 @@ import (
 	tmcfg "github.com/tendermint/tendermint/config"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -26,11 +27,13 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
-	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/history"
+	"github.com/cosmos/cosmos-sdk/store/witness"
 	simutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -180,7 +183,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd(),
 		config.Cmd(),
 	)
 
@@ -202,6 +205,17 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
 
+// debugCmd returns the debug commands, along with the commands inspecting the application state.
+func debugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(
+		server.NewStateDiffCmd(newApp),
+		server.NewStateDumpCmd(newApp, simapp.DefaultNodeHome),
+	)
+
+	return cmd
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -273,13 +287,12 @@ func newApp(
 	if err != nil {
 		panic(err)
 	}
-
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
+	storePruningOpts, err := server.GetStorePruningOptionsFromFlags(appOpts)
 	if err != nil {
 		panic(err)
 	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+
+	snapshotStore, err := server.GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -288,19 +301,83 @@ func newApp(
 		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.MaxDeltas = cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotMaxDeltas))
+	snapshotOptions.Format, err = snapshottypes.FormatFromCompression(cast.ToString(appOpts.Get(server.FlagStateSyncSnapshotCompression)))
+	if err != nil {
+		panic(err)
+	}
+	snapshotOptions.ExportWorkers = cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotExportWorkers))
+	snapshotOptions.TrustedSigners = cast.ToStringSlice(appOpts.Get(server.FlagStateSyncSnapshotTrustedSigners))
+	if keyFile := cast.ToString(appOpts.Get(server.FlagStateSyncSnapshotSigningKeyFile)); keyFile != "" {
+		nodeKey, err := p2p.LoadNodeKey(filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), keyFile))
+		if err != nil {
+			panic(err)
+		}
+		snapshotOptions.Signer = nodeKey.PrivKey
+	}
+
+	var historyStore *history.Store
+	if cast.ToBool(appOpts.Get(server.FlagStateHistory)) {
+		historyStore, err = server.GetHistoryStore(appOpts)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var witnessStore *witness.Store
+	if cast.ToBool(appOpts.Get(server.FlagBlockWitnesses)) {
+		witnessStore, err = server.GetWitnessStore(appOpts)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagStoreV2)) {
+		if historyStore != nil {
+			panic("the state history is not supported by the v2alpha1 multistore")
+		}
+		if witnessStore != nil {
+			panic("block witnesses are not supported by the v2alpha1 multistore")
+		}
+		if snapshotOptions.MaxDeltas > 0 {
+			panic("delta snapshots are not supported by the v2alpha1 multistore")
+		}
+		if len(storePruningOpts) > 0 {
+			panic("store pruning is not supported by the v2alpha1 multistore")
+		}
+		if len(cast.ToStringSlice(appOpts.Get(server.FlagStoreMetrics))) > 0 {
+			panic("store metrics are not supported by the v2alpha1 multistore")
+		}
+	}
+	baseappOptions, err := storeOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	var mp mempool.Mempool = mempool.NoOpMempool{}
+	if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs >= 0 {
+		mp = mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(maxTxs))
+	}
 
 	return simapp.NewSimApp(
 		logger, db, traceStore, true,
 		appOpts,
-		baseapp.SetPruning(pruningOpts),
-		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
-		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
-		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
-		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
-		baseapp.SetInterBlockCache(cache),
-		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
-		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
-		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		append(baseappOptions,
+			baseapp.SetPruning(pruningOpts),
+			baseapp.SetStorePruning(storePruningOpts),
+			baseapp.SetStoreMetrics(cast.ToStringSlice(appOpts.Get(server.FlagStoreMetrics))),
+			baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
+			baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
+			baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
+			baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+			baseapp.SetInterBlockCache(cache),
+			baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+			baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+			baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+			baseapp.SetHistory(historyStore),
+			baseapp.SetWitnessStore(witnessStore),
+			baseapp.SetMempool(mp),
+		)...,
 	)
 }
 
@@ -332,15 +409,34 @@ func appExport(
 	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
 	appOpts = viperAppOpts
 
+	baseappOptions, err := storeOptions(appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
 	if height != -1 {
-		simApp = simapp.NewSimApp(logger, db, traceStore, false, appOpts)
+		simApp = simapp.NewSimApp(logger, db, traceStore, false, appOpts, baseappOptions...)
 
 		if err := simApp.LoadHeight(height); err != nil {
 			return servertypes.ExportedApp{}, err
 		}
 	} else {
-		simApp = simapp.NewSimApp(logger, db, traceStore, true, appOpts)
+		simApp = simapp.NewSimApp(logger, db, traceStore, true, appOpts, baseappOptions...)
 	}
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// storeOptions returns the option setting the v2alpha1 multistore as the
+// CommitMultiStore of the app, if the store-v2 option is enabled. It must
+// precede the other options.
+func storeOptions(appOpts servertypes.AppOptions) ([]func(*baseapp.BaseApp), error) {
+	if !cast.ToBool(appOpts.Get(server.FlagStoreV2)) {
+		return nil, nil
+	}
+	cms, err := server.GetStoreV2(appOpts)
+	if err != nil {
+		return nil, err
+	}
+	return []func(*baseapp.BaseApp){baseapp.SetCMS(cms)}, nil
+}
@@ -0,0 +1,256 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// deltaWriter turns the items of a full snapshot into the items of a delta snapshot, which only
+// contains the IAVL nodes created after the base height. The maximal subtrees which only contain
+// nodes of the base height or below are replaced by a SnapshotIAVLSubtreeItem, since a subtree
+// made of such nodes is part of the tree at the base height as well.
+//
+// IAVL nodes are exported in post-order, so the pending subtrees are kept on a stack until it is
+// known whether their parent is part of the base tree.
+type deltaWriter struct {
+	protoio.Writer
+	baseHeight int64
+	stack      []deltaSubtree
+}
+
+// deltaSubtree is a subtree of the IAVL tree being exported by a deltaWriter.
+type deltaSubtree struct {
+	shared  bool   // if true, the subtree is part of the base tree and has not been written yet
+	key     []byte // key of the leftmost leaf of the subtree
+	version int64  // version of the leftmost leaf of the subtree
+	nodes   uint64 // number of nodes of the subtree
+}
+
+// newDeltaWriter creates a deltaWriter writing the delta against the snapshot of baseHeight to writer.
+func newDeltaWriter(writer protoio.Writer, baseHeight uint64) *deltaWriter {
+	return &deltaWriter{
+		Writer:     writer,
+		baseHeight: int64(baseHeight),
+	}
+}
+
+// WriteMsg implements protoio.Writer interface
+func (w *deltaWriter) WriteMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+	switch item := item.Item.(type) {
+	case *types.SnapshotItem_IAVL:
+		return w.writeNode(item.IAVL)
+	case *types.SnapshotItem_KV, *types.SnapshotItem_Schema, *types.SnapshotItem_IAVLSubtree:
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "delta snapshots can't contain %T items", item)
+	default:
+		// any other item ends the IAVL tree being exported
+		if err := w.flush(); err != nil {
+			return err
+		}
+		w.stack = w.stack[:0]
+		return w.Writer.WriteMsg(msg)
+	}
+}
+
+// writeNode handles an exported IAVL node.
+func (w *deltaWriter) writeNode(node *types.SnapshotIAVLItem) error {
+	shared := node.Version <= w.baseHeight
+	if node.Height > 0 {
+		if len(w.stack) < 2 {
+			return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL inner node before its children")
+		}
+		left, right := w.stack[len(w.stack)-2], w.stack[len(w.stack)-1]
+		if shared && left.shared && right.shared {
+			w.stack = w.stack[:len(w.stack)-2]
+			w.stack = append(w.stack, deltaSubtree{
+				shared:  true,
+				key:     left.key,
+				version: left.version,
+				nodes:   left.nodes + right.nodes + 1,
+			})
+			return nil
+		}
+		// the node is changed, so none of the pending subtrees will be part of a larger unchanged subtree
+		if err := w.flush(); err != nil {
+			return err
+		}
+		w.stack = w.stack[:len(w.stack)-2]
+	} else {
+		if shared {
+			w.stack = append(w.stack, deltaSubtree{shared: true, key: node.Key, version: node.Version, nodes: 1})
+			return nil
+		}
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.stack = append(w.stack, deltaSubtree{})
+	return w.Writer.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_IAVL{IAVL: node},
+	})
+}
+
+// flush writes the pending unchanged subtrees, in export order.
+func (w *deltaWriter) flush() error {
+	for i := range w.stack {
+		if !w.stack[i].shared {
+			continue
+		}
+		err := w.Writer.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVLSubtree{
+				IAVLSubtree: &types.SnapshotIAVLSubtreeItem{
+					Key:     w.stack[i].key,
+					Version: w.stack[i].version,
+					Nodes:   w.stack[i].nodes,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		w.stack[i].shared = false
+	}
+	return nil
+}
+
+// deltaReader merges the items of a delta snapshot with the items of the snapshot it is based on,
+// yielding the items of a full snapshot at the height of the delta snapshot. The base reader may be
+// a deltaReader itself, to restore a chain of delta snapshots.
+//
+// Both streams list the stores in the same order, and the subtrees referenced by the delta are
+// listed in the same order as the base exports them, so the base is only read forward.
+type deltaReader struct {
+	delta protoio.Reader
+	base  protoio.Reader
+
+	next    *types.SnapshotItem // next base item, if already read
+	baseEOF bool                // if true, the base has been read entirely
+	inStore bool                // if true, the base is positioned in the store being read from the delta
+	copying uint64              // number of base nodes left to copy from the current subtree
+}
+
+// newDeltaReader creates a deltaReader merging delta with base.
+func newDeltaReader(delta, base protoio.Reader) *deltaReader {
+	return &deltaReader{
+		delta: delta,
+		base:  base,
+	}
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *deltaReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+	if r.copying > 0 {
+		node, err := r.nextBaseNode()
+		if err != nil {
+			return err
+		}
+		r.copying--
+		*item = types.SnapshotItem{Item: &types.SnapshotItem_IAVL{IAVL: node}}
+		return nil
+	}
+
+	if err := r.delta.ReadMsg(item); err != nil {
+		return err
+	}
+	switch i := item.Item.(type) {
+	case *types.SnapshotItem_Store:
+		return r.seekStore(i.Store.Name)
+	case *types.SnapshotItem_IAVLSubtree:
+		if !r.inStore {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "base snapshot has no store for subtree at key %X", i.IAVLSubtree.Key)
+		}
+		subtree := i.IAVLSubtree
+		if subtree.Nodes == 0 {
+			return sdkerrors.Wrap(types.ErrInvalidMetadata, "empty IAVL subtree")
+		}
+		for {
+			node, err := r.nextBaseNode()
+			if err != nil {
+				return sdkerrors.Wrapf(err, "IAVL subtree at key %X", subtree.Key)
+			}
+			if node.Height != 0 || !bytes.Equal(node.Key, subtree.Key) {
+				continue
+			}
+			if node.Version != subtree.Version {
+				return sdkerrors.Wrapf(types.ErrInvalidMetadata, "IAVL subtree at key %X has version %v in base snapshot, expected %v",
+					subtree.Key, node.Version, subtree.Version)
+			}
+			r.copying = subtree.Nodes - 1
+			*item = types.SnapshotItem{Item: &types.SnapshotItem_IAVL{IAVL: node}}
+			return nil
+		}
+	}
+	return nil
+}
+
+// peekBase returns the next base item without consuming it, or nil at the end of the base.
+func (r *deltaReader) peekBase() (*types.SnapshotItem, error) {
+	if r.next == nil && !r.baseEOF {
+		item := &types.SnapshotItem{}
+		err := r.base.ReadMsg(item)
+		switch {
+		case err == io.EOF:
+			r.baseEOF = true
+		case err != nil:
+			return nil, sdkerrors.Wrap(err, "base snapshot")
+		default:
+			r.next = item
+		}
+	}
+	return r.next, nil
+}
+
+// nextBaseNode consumes the next base item, which must be an IAVL node of the current store.
+func (r *deltaReader) nextBaseNode() (*types.SnapshotIAVLItem, error) {
+	item, err := r.peekBase()
+	if err != nil {
+		return nil, err
+	}
+	node := item.GetIAVL()
+	if node == nil {
+		r.inStore = false
+		return nil, sdkerrors.Wrap(types.ErrInvalidMetadata, "IAVL subtree not found in base snapshot")
+	}
+	r.next = nil
+	return node, nil
+}
+
+// seekStore positions the base at the nodes of the given store, if it has one.
+func (r *deltaReader) seekStore(name string) error {
+	r.inStore = false
+	for {
+		item, err := r.peekBase()
+		if err != nil || item == nil {
+			return err
+		}
+		switch i := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if i.Store.Name > name {
+				return nil
+			}
+			r.next = nil
+			if i.Store.Name == name {
+				r.inStore = true
+				return nil
+			}
+		case *types.SnapshotItem_IAVL:
+			r.next = nil
+		default:
+			// the stores of the base have all been read
+			return nil
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package snapshots
+
+import (
+	"errors"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// exportBlockBuffer is the number of blocks an export worker may produce ahead of the writer.
+const exportBlockBuffer = 2
+
+// errExportAborted is returned by the export workers once the export has failed.
+var errExportAborted = errors.New("snapshot export aborted")
+
+// exportMultistore writes the items of the multistore snapshot at height to streamWriter, as a delta
+// against the snapshot of baseHeight if it is not 0. If the multistore implements
+// types.StoreSnapshotter and workers is greater than 1, the stores are exported and compressed
+// concurrently by that many workers. The blocks of the stores are written in store order, so the
+// output is the same as the one of a sequential export.
+func exportMultistore(multistore types.Snapshotter, height, baseHeight uint64, workers int, streamWriter *StreamWriter) error {
+	storeSnapshotter, ok := multistore.(types.StoreSnapshotter)
+	if !ok || workers <= 1 {
+		var writer protoio.Writer = streamWriter
+		if baseHeight > 0 {
+			writer = newDeltaWriter(streamWriter, baseHeight)
+		}
+		if err := multistore.Snapshot(height, writer); err != nil {
+			return err
+		}
+		if deltaWriter, ok := writer.(*deltaWriter); ok {
+			return deltaWriter.flush()
+		}
+		return nil
+	}
+
+	stores, err := storeSnapshotter.SnapshotStores(height)
+	if err != nil {
+		return err
+	}
+	if workers > len(stores) {
+		workers = len(stores)
+	}
+
+	// done aborts the workers if the export fails, before waiting for them to return
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	done := make(chan struct{})
+	defer close(done)
+
+	results := make([]chan []byte, len(stores))
+	errs := make([]error, len(stores))
+	for i := range results {
+		results[i] = make(chan []byte, exportBlockBuffer)
+	}
+	jobs := make(chan int, len(stores))
+	for i := range stores {
+		jobs <- i
+	}
+	close(jobs)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				select {
+				case <-done:
+					errs[i] = errExportAborted
+					close(results[i])
+					continue
+				default:
+				}
+				errs[i] = exportStore(storeSnapshotter, height, baseHeight, stores[i], streamWriter, results[i], done)
+				close(results[i])
+			}
+		}()
+	}
+
+	for i := range stores {
+		for block := range results[i] {
+			if err := streamWriter.writeBlock(block); err != nil {
+				return err
+			}
+		}
+		if errs[i] != nil {
+			return errs[i]
+		}
+	}
+	return nil
+}
+
+// exportStore exports a single store of the multistore, sending its compressed blocks to results.
+// It gives up once done is closed.
+func exportStore(
+	storeSnapshotter types.StoreSnapshotter, height, baseHeight uint64, name string,
+	streamWriter *StreamWriter, results chan<- []byte, done <-chan struct{},
+) error {
+	blockWriter := newBlockWriter(func(block []byte) error {
+		select {
+		case results <- streamWriter.compressBlock(block):
+			return nil
+		case <-done:
+			return errExportAborted
+		}
+	})
+	var writer protoio.Writer = blockWriter
+	if baseHeight > 0 {
+		writer = newDeltaWriter(blockWriter, baseHeight)
+	}
+	if err := storeSnapshotter.SnapshotStore(height, name, writer); err != nil {
+		return err
+	}
+	if deltaWriter, ok := writer.(*deltaWriter); ok {
+		if err := deltaWriter.flush(); err != nil {
+			return err
+		}
+	}
+	return blockWriter.Flush()
+}
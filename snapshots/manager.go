@@ -10,6 +10,7 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -152,11 +153,15 @@ func (m *Manager) GetKeepRecent() uint32 {
 // for block retention. Blocks since the oldest available snapshot must be
 // available for state sync nodes to catch up (oldest because a node may be
 // restoring an old snapshot while a new snapshot was taken).
+//
+// Retained delta snapshots keep up to MaxDeltas older snapshots they are based on.
 func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
-	return int64(m.opts.Interval * uint64(m.opts.KeepRecent))
+	return int64(m.opts.Interval * uint64(m.opts.KeepRecent+m.opts.MaxDeltas))
 }
 
-// Create creates a snapshot and returns its metadata.
+// Create creates a snapshot and returns its metadata. If delta snapshots are enabled, a delta
+// snapshot based on the latest snapshot is created unless the latter already ends a chain of
+// MaxDeltas delta snapshots.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
@@ -179,18 +184,49 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	format := m.opts.FullFormat()
+	if types.IsDeltaFormat(format) || types.DeltaFormatOf(format) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "cannot take full snapshots of format %v", format)
+	}
+	baseHeight, err := m.deltaBaseHeight(latest, format)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to examine latest snapshot")
+	}
+	if baseHeight > 0 {
+		format = types.DeltaFormatOf(format)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, baseHeight, format, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	if baseHeight > 0 {
+		return m.store.SaveDelta(height, format, baseHeight, ch)
+	}
+	return m.store.Save(height, format, ch)
+}
+
+// deltaBaseHeight returns the height the next snapshot is based on, or 0 if a full snapshot of the
+// given format must be taken.
+func (m *Manager) deltaBaseHeight(latest *types.Snapshot, format uint32) (uint64, error) {
+	if m.opts.MaxDeltas == 0 || latest == nil {
+		return 0, nil
+	}
+	chain, err := m.store.Chain(latest.Height, latest.Format)
+	if err != nil {
+		return 0, err
+	}
+	if chain[len(chain)-1].Format != format || uint32(len(chain)-1) >= m.opts.MaxDeltas {
+		return 0, nil
+	}
+	return latest.Height, nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
+// the produced chunks are written to the channel. A delta snapshot is taken if baseHeight is not 0.
+func (m *Manager) createSnapshot(height uint64, baseHeight uint64, format uint32, ch chan<- io.ReadCloser) {
+	streamWriter, err := NewFormatStreamWriter(ch, format)
+	if err != nil {
 		return
 	}
 	defer func() {
@@ -199,7 +235,8 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	workers := int(m.opts.ExportWorkers)
+	if err := exportMultistore(m.multistore, height, baseHeight, workers, streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -229,13 +266,78 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are listed along with the chain of snapshots they are based on, see chainSnapshot.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	for i, snapshot := range snapshots {
+		if types.IsDeltaFormat(snapshot.Format) {
+			chain, err := m.store.Chain(snapshot.Height, snapshot.Format)
+			if err != nil {
+				return nil, err
+			}
+			snapshots[i] = chainSnapshot(chain)
+		}
+		if m.opts.Signer != nil && types.HasChunkManifest(snapshot.Format) {
+			if err := snapshots[i].Sign(m.opts.Signer); err != nil {
+				return nil, sdkerrors.Wrap(err, "failed to sign snapshot")
+			}
+		}
+	}
+	return snapshots, nil
+}
+
+// chainSnapshot returns the snapshot advertising a chain of snapshots as returned by Store.Chain. Its
+// chunks are the chunks of all the snapshots of the chain, in the order of the chain, and its hash is
+// the SHA-256 hash of the hashes of the snapshots of the chain, or the Merkle root of its chunk hashes
+// for the formats with a chunk manifest.
+func chainSnapshot(chain []*types.Snapshot) *types.Snapshot {
+	snapshot := &types.Snapshot{
+		Height: chain[0].Height,
+		Format: chain[0].Format,
+		Metadata: types.Metadata{
+			ChunkHashes: [][]byte{},
+			BaseHeight:  chain[0].Metadata.BaseHeight,
+		},
+	}
+	hasher := sha256.New()
+	for _, link := range chain {
+		snapshot.Chunks += link.Chunks
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, link.Metadata.ChunkHashes...)
+		snapshot.Metadata.Chain = append(snapshot.Metadata.Chain, types.SnapshotLink{
+			Height: link.Height,
+			Format: link.Format,
+			Chunks: link.Chunks,
+			Hash:   link.Hash,
+		})
+		hasher.Write(link.Hash)
+	}
+	snapshot.Hash = hasher.Sum(nil)
+	if types.HasChunkManifest(snapshot.Format) {
+		snapshot.Hash = types.ChunkManifestRoot(snapshot.Metadata.ChunkHashes)
+	}
+	return snapshot
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
 // concurrently with other operations. If the chunk does not exist, nil is returned.
+// The chunks of delta snapshots are indexed as listed by List.
 func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if types.IsDeltaFormat(format) {
+		chain, err := m.store.Chain(height, format)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range chain {
+			if chunk < link.Chunks {
+				height, format = link.Height, link.Format
+				break
+			}
+			chunk -= link.Chunks
+		}
+	}
 	reader, err := m.store.LoadChunk(height, format, chunk)
 	if err != nil {
 		return nil, err
@@ -248,6 +350,12 @@ func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte,
 	return io.ReadAll(reader)
 }
 
+// GetBootstrapState fetches the bootstrap state of a height from the snapshot store, see
+// Store.SaveBootstrapState.
+func (m *Manager) GetBootstrapState(height uint64) ([]byte, error) {
+	return m.store.GetBootstrapState(height)
+}
+
 // Prune prunes snapshots, if no other operations are in progress.
 func (m *Manager) Prune(retain uint32) (uint64, error) {
 	err := m.begin(opPrune)
@@ -258,9 +366,67 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 	return m.store.Prune(retain)
 }
 
+// DeleteAbove deletes the snapshots above a height, if no other operations are in progress.
+func (m *Manager) DeleteAbove(height uint64) ([]*types.Snapshot, error) {
+	err := m.begin(opPrune)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+	return m.store.DeleteAbove(height)
+}
+
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
+	// the snapshots offered by peers must be signed by a trusted signer if required
+	if len(m.opts.TrustedSigners) > 0 {
+		if err := snapshot.VerifySigner(m.opts.TrustedSigners); err != nil {
+			return err
+		}
+	}
+	return m.restore(snapshot)
+}
+
+// RestoreLocalSnapshot restores the state from a snapshot of the snapshot store, e.g. a snapshot loaded
+// from an archive. Delta snapshots are restored along with the chain of snapshots they are based on.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	if types.IsDeltaFormat(format) {
+		chain, err := m.store.Chain(height, format)
+		if err != nil {
+			return err
+		}
+		snapshot = chainSnapshot(chain)
+	}
+
+	if err := m.restore(*snapshot); err != nil {
+		return err
+	}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := m.LoadChunk(height, format, i)
+		if err == nil && chunk == nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot chunk %v", i)
+		}
+		if err != nil {
+			m.end()
+			return err
+		}
+		if _, err := m.RestoreChunk(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restore begins an async snapshot restoration, once the signer of the snapshot has been verified.
+func (m *Manager) restore(snapshot types.Snapshot) error {
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
@@ -273,9 +439,20 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat, types.ZstdFormat:
+	case types.DeltaFormat, types.ZstdDeltaFormat:
+		if err := validateChain(snapshot); err != nil {
+			return err
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	// the chunk hashes of formats with a chunk manifest are verified against the snapshot hash
+	// before any chunk is applied
+	if err := snapshot.ValidateManifest(); err != nil {
+		return err
+	}
 	if snapshot.Height == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -309,16 +486,121 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// validateChain checks the chain of snapshots advertised by a delta snapshot.
+func validateChain(snapshot types.Snapshot) error {
+	chain := snapshot.Metadata.Chain
+	if len(chain) < 2 {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "delta snapshot chain has %v snapshots", len(chain))
+	}
+	if chain[0].Height != snapshot.Height {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "delta snapshot chain starts at height %v, expected %v",
+			chain[0].Height, snapshot.Height)
+	}
+	chunks := uint32(0)
+	for i, link := range chain {
+		expected := snapshot.Format
+		if i == len(chain)-1 {
+			expected = types.FullFormat(snapshot.Format)
+		}
+		if link.Format != expected {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v at height %v of delta snapshot chain",
+				link.Format, link.Height)
+		}
+		if i > 0 && link.Height >= chain[i-1].Height {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "delta snapshot at height %v is based on height %v",
+				chain[i-1].Height, link.Height)
+		}
+		if link.Chunks == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "no chunks for snapshot at height %v", link.Height)
+		}
+		chunks += link.Chunks
+	}
+	if chunks != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "delta snapshot chain has %v chunks, but snapshot has %v",
+			chunks, snapshot.Chunks)
+	}
+	return nil
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
-	var nextItem types.SnapshotItem
+	if types.IsDeltaFormat(snapshot.Format) {
+		return m.restoreDeltaSnapshot(snapshot, chChunks)
+	}
 
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := NewFormatStreamReader(chChunks, snapshot.Format)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	return m.restoreStream(snapshot.Height, streamReader)
+}
+
+// restoreDeltaSnapshot restores a delta snapshot by merging the chain of snapshots it is based on.
+// The chunks of the delta snapshots come first and are buffered in memory, while the chunks of the
+// full snapshot they are based on are streamed as they arrive.
+func (m *Manager) restoreDeltaSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	chain := snapshot.Metadata.Chain
+	deltas := make([][][]byte, len(chain)-1)
+	for i, link := range chain[:len(chain)-1] {
+		for j := uint32(0); j < link.Chunks; j++ {
+			chunk, ok := <-chChunks
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+			}
+			bz, err := io.ReadAll(chunk)
+			chunk.Close()
+			if err != nil {
+				return err
+			}
+			deltas[i] = append(deltas[i], bz)
+		}
+	}
+
+	baseReader, err := NewFormatStreamReader(chChunks, snapshot.Format)
+	if err != nil {
+		return err
+	}
+	defer baseReader.Close()
+
+	var reader protoio.Reader = baseReader
+	for i := len(deltas) - 1; i >= 0; i-- {
+		chDelta := make(chan io.ReadCloser, len(deltas[i]))
+		for _, chunk := range deltas[i] {
+			chDelta <- io.NopCloser(bytes.NewReader(chunk))
+		}
+		close(chDelta)
+		deltaStream, err := NewFormatStreamReader(chDelta, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		defer deltaStream.Close()
+		reader = newDeltaReader(deltaStream, reader)
+	}
+
+	if err := m.restoreStream(snapshot.Height, reader); err != nil {
+		return err
+	}
+
+	// consume the rest of the base snapshot, e.g. its extension items
+	var item types.SnapshotItem
+	for {
+		item.Reset()
+		err := baseReader.ReadMsg(&item)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "base snapshot")
+		}
+	}
+}
+
+// restoreStream restores the multistore and the extensions from the items of a snapshot. The items
+// are the same for all the formats once decompressed and merged, so they are restored as CurrentFormat.
+func (m *Manager) restoreStream(height uint64, streamReader protoio.Reader) error {
+	var nextItem types.SnapshotItem
+
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
@@ -332,7 +614,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err := m.multistore.Restore(height, types.CurrentFormat, streamReader)
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
@@ -354,7 +636,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
@@ -1,15 +1,21 @@
 package snapshots_test
 
 import (
+	"encoding/hex"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -236,3 +242,178 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_Delta(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+	source := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	deltaOpts := types.NewSnapshotOptions(1, 2)
+	deltaOpts.MaxDeltas = 2
+	manager := snapshots.NewManager(store, deltaOpts, source, nil, log.NewNopLogger())
+	err = manager.RegisterExtensions(newExtSnapshotter(10))
+	require.NoError(t, err)
+
+	// a full snapshot is taken first, followed by up to two delta snapshots
+	formats := []uint32{types.CurrentFormat, types.DeltaFormat, types.DeltaFormat, types.CurrentFormat}
+	for i, format := range formats {
+		height := uint64(i + 1)
+		source.items = [][]byte{{byte(height), 1}, {byte(height), 2}}
+		snapshot, err := manager.Create(height)
+		require.NoError(t, err)
+		assert.Equal(t, format, snapshot.Format)
+		if format == types.DeltaFormat {
+			assert.Equal(t, height-1, snapshot.Metadata.BaseHeight)
+		}
+	}
+
+	// delta snapshots are advertised along with the snapshots they are based on
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 4)
+	snapshot := list[1]
+	require.EqualValues(t, 3, snapshot.Height)
+	require.Equal(t, types.DeltaFormat, snapshot.Format)
+	require.Len(t, snapshot.Metadata.Chain, 3)
+	chunks := uint32(0)
+	for i, link := range snapshot.Metadata.Chain {
+		assert.EqualValues(t, 3-i, link.Height)
+		stored, err := store.Get(link.Height, link.Format)
+		require.NoError(t, err)
+		assert.Equal(t, stored.Hash, link.Hash)
+		chunks += link.Chunks
+	}
+	assert.Equal(t, chunks, snapshot.Chunks)
+	assert.Len(t, snapshot.Metadata.ChunkHashes, int(chunks))
+
+	// the delta snapshot can't be restored without the snapshots it is based on
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	extSnapshotter := newExtSnapshotter(0)
+	targetManager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+	err = targetManager.RegisterExtensions(extSnapshotter)
+	require.NoError(t, err)
+	invalid := *snapshot
+	invalid.Metadata.Chain = invalid.Metadata.Chain[:2]
+	err = targetManager.Restore(invalid)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// restoring the chain yields the state at the height of the delta snapshot
+	err = targetManager.Restore(*snapshot)
+	require.NoError(t, err)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		require.NotNil(t, chunk)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, [][]byte{{3, 1}, {3, 2}}, target.items)
+	assert.Equal(t, 10, len(extSnapshotter.state))
+
+	// pruning keeps the snapshots the retained delta snapshots are based on
+	pruned, err := manager.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+	pruned, err = manager.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+}
+
+func TestManager_SignedZstd(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+	source := &mockSnapshotter{
+		items:         [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		prunedHeights: make(map[int64]struct{}),
+	}
+	signer := ed25519.GenPrivKey()
+	zstdOpts := types.NewSnapshotOptions(1, 2)
+	zstdOpts.Format = types.ZstdFormat
+	zstdOpts.Signer = signer
+	manager := snapshots.NewManager(store, zstdOpts, source, nil, log.NewNopLogger())
+	err = manager.RegisterExtensions(newExtSnapshotter(10))
+	require.NoError(t, err)
+
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+
+	// the snapshot hash is the Merkle root of the chunk hashes, and it is signed when listed
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	snapshot := list[0]
+	require.Equal(t, types.ZstdFormat, snapshot.Format)
+	require.Equal(t, types.ChunkManifestRoot(snapshot.Metadata.ChunkHashes), snapshot.Hash)
+	require.NoError(t, snapshot.ValidateManifest())
+	signerID := hex.EncodeToString(signer.PubKey().Address())
+	require.NoError(t, snapshot.VerifySigner([]string{signerID}))
+
+	// snapshots signed by untrusted signers or with a forged manifest are rejected
+	newTarget := func(trustedSigners ...string) (*snapshots.Manager, *mockSnapshotter) {
+		target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+		targetOpts := opts
+		targetOpts.TrustedSigners = trustedSigners
+		targetManager := snapshots.NewManager(setupStore(t), targetOpts, target, nil, log.NewNopLogger())
+		require.NoError(t, targetManager.RegisterExtensions(newExtSnapshotter(0)))
+		return targetManager, target
+	}
+	targetManager, _ := newTarget(hex.EncodeToString(ed25519.GenPrivKey().PubKey().Address()))
+	err = targetManager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	forged := *snapshot
+	forged.Metadata.ChunkHashes = append([][]byte{}, snapshot.Metadata.ChunkHashes...)
+	forged.Metadata.ChunkHashes[0] = make([]byte, 32)
+	targetManager, _ = newTarget()
+	err = targetManager.Restore(forged)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+	targetManager, _ = newTarget(signerID)
+	err = targetManager.Restore(forged)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	// the snapshot of a trusted signer is restored
+	targetManager, target := newTarget(strings.ToUpper(signerID))
+	err = targetManager.Restore(*snapshot)
+	require.NoError(t, err)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, source.items, target.items)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+	source := &mockSnapshotter{
+		items:         [][]byte{{1, 2, 3}, {4, 5, 6}},
+		prunedHeights: make(map[int64]struct{}),
+	}
+	deltaOpts := types.NewSnapshotOptions(1, 2)
+	deltaOpts.MaxDeltas = 1
+	manager := snapshots.NewManager(store, deltaOpts, source, nil, log.NewNopLogger())
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+	source.items = [][]byte{{7, 8, 9}}
+	snapshot, err := manager.Create(2)
+	require.NoError(t, err)
+	require.Equal(t, types.DeltaFormat, snapshot.Format)
+
+	// local snapshots are restored without being offered, even if trusted signers are required
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	targetOpts := opts
+	targetOpts.TrustedSigners = []string{"00"}
+	targetManager := snapshots.NewManager(store, targetOpts, target, nil, log.NewNopLogger())
+	err = targetManager.RestoreLocalSnapshot(3, types.CurrentFormat)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	err = targetManager.RestoreLocalSnapshot(2, types.DeltaFormat)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{7, 8, 9}}, target.items)
+
+	// the manager is ready for another operation
+	_, err = targetManager.Prune(2)
+	require.NoError(t, err)
+}
@@ -20,6 +20,8 @@ import (
 const (
 	// keyPrefixSnapshot is the prefix for snapshot database keys
 	keyPrefixSnapshot byte = 0x01
+	// keyPrefixBootstrapState is the prefix for bootstrap state database keys
+	keyPrefixBootstrapState byte = 0x02
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
@@ -48,7 +50,8 @@ func NewStore(db db.DB, dir string) (*Store, error) {
 	}, nil
 }
 
-// Delete deletes a snapshot.
+// Delete deletes a snapshot. A snapshot which delta snapshots are based on can't be deleted,
+// unless another snapshot of its height can serve as their base.
 func (s *Store) Delete(height uint64, format uint32) error {
 	s.mtx.Lock()
 	saving := s.saving[height]
@@ -57,14 +60,72 @@ func (s *Store) Delete(height uint64, format uint32) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrConflict,
 			"snapshot for height %v format %v is currently being saved", height, format)
 	}
+	if deltaFormat := types.DeltaFormatOf(format); deltaFormat != 0 {
+		other := types.FullFormat(format)
+		if other == format {
+			other = deltaFormat
+		}
+		based, err := s.hasDeltasBasedOn(height, deltaFormat)
+		if err != nil {
+			return err
+		}
+		exists, err := s.db.Has(encodeKey(height, other))
+		if err != nil {
+			return err
+		}
+		if based && !exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict,
+				"delta snapshots are based on the snapshot for height %v format %v", height, format)
+		}
+	}
 	err := s.db.DeleteSync(encodeKey(height, format))
 	if err != nil {
 		return sdkerrors.Wrapf(err, "failed to delete snapshot for height %v format %v",
 			height, format)
 	}
 	err = os.RemoveAll(s.pathSnapshot(height, format))
-	return sdkerrors.Wrapf(err, "failed to delete snapshot chunks for height %v format %v",
-		height, format)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to delete snapshot chunks for height %v format %v",
+			height, format)
+	}
+	return s.deleteOrphanBootstrapState(height)
+}
+
+// SaveBootstrapState saves the bootstrap state of a height, which must have a snapshot. The bootstrap
+// state is opaque data letting a node start from a snapshot of the height without state syncing,
+// e.g. the consensus engine state at the height. It is deleted along with the last snapshot of the height.
+func (s *Store) SaveBootstrapState(height uint64, state []byte) error {
+	iter, err := s.db.Iterator(encodeKey(height, 0), encodeKey(height+1, 0))
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to find snapshots")
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot at height %v", height)
+	}
+	err = s.db.SetSync(encodeBootstrapStateKey(height), state)
+	return sdkerrors.Wrapf(err, "failed to store bootstrap state for height %v", height)
+}
+
+// GetBootstrapState fetches the bootstrap state of a height, or nil if there is none.
+func (s *Store) GetBootstrapState(height uint64) ([]byte, error) {
+	state, err := s.db.Get(encodeBootstrapStateKey(height))
+	return state, sdkerrors.Wrapf(err, "failed to fetch bootstrap state for height %v", height)
+}
+
+// deleteOrphanBootstrapState deletes the bootstrap state of a height once it has no snapshot left.
+func (s *Store) deleteOrphanBootstrapState(height uint64) error {
+	iter, err := s.db.Iterator(encodeKey(height, 0), encodeKey(height+1, 0))
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to find snapshots")
+	}
+	orphan := !iter.Valid()
+	iter.Close()
+	if !orphan {
+		return nil
+	}
+	err = s.db.DeleteSync(encodeBootstrapStateKey(height))
+	return sdkerrors.Wrapf(err, "failed to delete bootstrap state for height %v", height)
 }
 
 // Get fetches snapshot info from the database.
@@ -129,6 +190,54 @@ func (s *Store) List() ([]*types.Snapshot, error) {
 	return snapshots, iter.Error()
 }
 
+// GetBase fetches the snapshot the delta snapshots of the given format based on the given height
+// are applied to, preferring a full snapshot over a delta one. Returns nil if there is none.
+func (s *Store) GetBase(height uint64, format uint32) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, types.FullFormat(format))
+	if snapshot != nil || err != nil {
+		return snapshot, err
+	}
+	return s.Get(height, format)
+}
+
+// Chain returns the chain of snapshots needed to restore the given snapshot: the snapshot itself,
+// followed by the snapshots it is transitively based on down to a full snapshot. Returns nil if
+// the snapshot does not exist.
+func (s *Store) Chain(height uint64, format uint32) ([]*types.Snapshot, error) {
+	snapshot, err := s.Get(height, format)
+	if snapshot == nil || err != nil {
+		return nil, err
+	}
+	chain := []*types.Snapshot{snapshot}
+	for types.IsDeltaFormat(snapshot.Format) {
+		base, err := s.GetBase(snapshot.Metadata.BaseHeight, snapshot.Format)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil || base.Height >= snapshot.Height {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata,
+				"no base snapshot at height %v for delta snapshot at height %v", snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+		chain = append(chain, base)
+		snapshot = base
+	}
+	return chain, nil
+}
+
+// hasDeltasBasedOn checks whether any delta snapshot of the given format is based on the given height.
+func (s *Store) hasDeltasBasedOn(height uint64, format uint32) (bool, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return false, err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Format == format && snapshot.Metadata.BaseHeight == height {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Load loads a snapshot (both metadata and binary chunks). The chunks must be consumed and closed.
 // Returns nil if the snapshot does not exist.
 func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.ReadCloser, error) {
@@ -179,27 +288,32 @@ func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.Re
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the heights the retained delta snapshots are transitively based on.
 func (s *Store) Prune(retain uint32) (uint64, error) {
-	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
+	// snapshots are listed newest first, so delta snapshots are seen before the snapshots they are based on
+	snapshots, err := s.List()
 	if err != nil {
 		return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
 	}
-	defer iter.Close()
 
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
+	seen := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
-	for ; iter.Valid(); iter.Next() {
-		height, format, err := decodeKey(iter.Key())
-		if err != nil {
-			return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
-		}
-		if skip[height] || uint32(len(skip)) < retain {
+	for _, snapshot := range snapshots {
+		height := snapshot.Height
+		seen[height] = true
+		if uint32(len(seen)) <= retain {
 			skip[height] = true
+		}
+		if skip[height] {
+			if types.IsDeltaFormat(snapshot.Format) {
+				skip[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
-		err = s.Delete(height, format)
+		err = s.Delete(height, snapshot.Format)
 		if err != nil {
 			return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
 		}
@@ -216,12 +330,70 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 			}
 		}
 	}
-	return pruned, iter.Error()
+	return pruned, nil
+}
+
+// DeleteAbove deletes the snapshots above a height, e.g. once the state has been rolled back to the
+// height, and returns them. The snapshots of the height and below are retained.
+func (s *Store) DeleteAbove(height uint64) ([]*types.Snapshot, error) {
+	// snapshots are listed newest first, so delta snapshots are deleted before the snapshots they are based on
+	snapshots, err := s.List()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to delete snapshots")
+	}
+
+	deleted := make([]*types.Snapshot, 0)
+	for _, snapshot := range snapshots {
+		if snapshot.Height <= height {
+			break
+		}
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return deleted, sdkerrors.Wrap(err, "failed to delete snapshots")
+		}
+		if err := os.RemoveAll(s.pathHeight(snapshot.Height)); err != nil {
+			return deleted, sdkerrors.Wrapf(err, "failed to remove snapshot directory for height %v", snapshot.Height)
+		}
+		deleted = append(deleted, snapshot)
+	}
+	return deleted, nil
 }
 
-// Save saves a snapshot to disk, returning it.
+// Save saves a snapshot to disk, returning it. The hash of the snapshots of the formats with a chunk
+// manifest is the Merkle root of their chunk hashes, see types.ChunkManifestRoot.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, 0, chunks)
+}
+
+// SaveDelta saves a delta snapshot based on the snapshot of baseHeight to disk, returning it.
+func (s *Store) SaveDelta(
+	height uint64, format uint32, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if !types.IsDeltaFormat(format) {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v is not a delta snapshot format", format)
+	}
+	if baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"delta snapshot height %v must be above its base height %v", height, baseHeight)
+	}
+	base, err := s.GetBase(baseHeight, format)
+	if err != nil {
+		DrainChunks(chunks)
+		return nil, err
+	}
+	if base == nil {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no base snapshot at height %v", baseHeight)
+	}
+	return s.save(height, format, baseHeight, chunks)
+}
+
+// save saves a snapshot to disk, returning it.
+func (s *Store) save(
+	height uint64, format uint32, baseHeight uint64, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -254,6 +426,9 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			BaseHeight: baseHeight,
+		},
 	}
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -290,6 +465,9 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+	if types.HasChunkManifest(format) {
+		snapshot.Hash = types.ChunkManifestRoot(snapshot.Metadata.ChunkHashes)
+	}
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
@@ -331,6 +509,14 @@ func decodeKey(k []byte) (uint64, uint32, error) {
 	return height, format, nil
 }
 
+// encodeBootstrapStateKey encodes a bootstrap state key.
+func encodeBootstrapStateKey(height uint64) []byte {
+	k := make([]byte, 9)
+	k[0] = keyPrefixBootstrapState
+	binary.BigEndian.PutUint64(k[1:], height)
+	return k
+}
+
 // encodeKey encodes a snapshot key.
 func encodeKey(height uint64, format uint32) []byte {
 	k := make([]byte, 13)
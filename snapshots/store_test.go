@@ -339,3 +339,154 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveDelta(t *testing.T) {
+	store := setupStore(t)
+
+	// Saving a delta snapshot on a missing base, or at or below its base, should error
+	_, err := store.SaveDelta(5, types.DeltaFormat, 4, makeChunks([][]byte{{5}}))
+	require.Error(t, err)
+	_, err = store.SaveDelta(3, types.DeltaFormat, 3, makeChunks([][]byte{{3}}))
+	require.Error(t, err)
+
+	// Saving delta snapshots should record their base height
+	snapshot, err := store.SaveDelta(4, types.DeltaFormat, 3, makeChunks([][]byte{{4, 3, 0}}))
+	require.NoError(t, err)
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.DeltaFormat,
+		Chunks: 1,
+		Hash:   hash([][]byte{{4, 3, 0}}),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums([][]byte{{4, 3, 0}}),
+			BaseHeight:  3,
+		},
+	}, snapshot)
+	delta, err := store.SaveDelta(5, types.DeltaFormat, 4, makeChunks([][]byte{{5, 3, 0}, {5, 3, 1}}))
+	require.NoError(t, err)
+
+	// The chain should go down to the full snapshot
+	base, err := store.Get(3, types.CurrentFormat)
+	require.NoError(t, err)
+	chain, err := store.Chain(5, types.DeltaFormat)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Snapshot{delta, snapshot, base}, chain)
+
+	chain, err = store.Chain(3, types.CurrentFormat)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Snapshot{base}, chain)
+
+	chain, err = store.Chain(9, types.DeltaFormat)
+	require.NoError(t, err)
+	assert.Nil(t, chain)
+
+	// Snapshots which delta snapshots are based on can't be deleted
+	err = store.Delete(3, types.CurrentFormat)
+	require.Error(t, err)
+	err = store.Delete(4, types.DeltaFormat)
+	require.Error(t, err)
+	err = store.Delete(5, types.DeltaFormat)
+	require.NoError(t, err)
+	err = store.Delete(4, types.DeltaFormat)
+	require.NoError(t, err)
+}
+
+func TestStore_PruneDelta(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDelta(4, types.DeltaFormat, 3, makeChunks([][]byte{{4, 3, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, types.DeltaFormat, 4, makeChunks([][]byte{{5, 3, 0}}))
+	require.NoError(t, err)
+	_, err = store.Save(6, types.CurrentFormat, makeChunks([][]byte{{6, 2, 0}}))
+	require.NoError(t, err)
+
+	// Retaining the delta snapshot at height 5 retains the heights it is based on
+	pruned, err := store.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, snapshot := range snapshots {
+		heights = append(heights, snapshot.Height)
+	}
+	assert.Equal(t, []uint64{6, 5, 4, 3}, heights)
+
+	// Once the delta snapshots are pruned, their bases can be pruned as well
+	pruned, err = store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.EqualValues(t, 6, snapshots[0].Height)
+}
+
+func TestStore_DeleteAbove(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDelta(4, types.DeltaFormat, 2, makeChunks([][]byte{{4, 3, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, types.DeltaFormat, 4, makeChunks([][]byte{{5, 3, 0}}))
+	require.NoError(t, err)
+
+	// The delta snapshots are deleted along with the snapshots above the height
+	deleted, err := store.DeleteAbove(2)
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, snapshot := range deleted {
+		heights = append(heights, snapshot.Height)
+	}
+	assert.Equal(t, []uint64{5, 4, 3}, heights)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assert.EqualValues(t, 2, snapshots[0].Height)
+	_, chunks, err := store.Load(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, readChunks(chunks))
+
+	// Deleting above the latest height is a no-op
+	deleted, err = store.DeleteAbove(2)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+}
+
+func TestStore_BootstrapState(t *testing.T) {
+	store := setupStore(t)
+	err := store.SaveBootstrapState(4, []byte{4})
+	require.Error(t, err)
+	err = store.SaveBootstrapState(2, []byte{2})
+	require.NoError(t, err)
+
+	state, err := store.GetBootstrapState(2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, state)
+	state, err = store.GetBootstrapState(1)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	// the bootstrap state is deleted along with the last snapshot of its height
+	err = store.Delete(2, 1)
+	require.NoError(t, err)
+	state, err = store.GetBootstrapState(2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, state)
+	err = store.Delete(2, 2)
+	require.NoError(t, err)
+	state, err = store.GetBootstrapState(2)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	// listing snapshots skips the bootstrap states
+	err = store.SaveBootstrapState(3, []byte{3})
+	require.NoError(t, err)
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	latest, err := store.GetLatest()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, latest.Height)
+}
@@ -3,11 +3,14 @@ package snapshots
 import (
 	"bufio"
 	"compress/zlib"
+	"encoding/binary"
 	"io"
 
 	protoio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
 
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -17,15 +20,26 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+	// Do not change the block size nor the zstd level without new snapshot format (must be uniform across nodes)
+	snapshotBlockSize = int(4e6)
+	snapshotZstdLevel = zstd.SpeedDefault
+	// snapshotZstdMaxMemory limits the memory used to decode a zstd frame
+	snapshotZstdMaxMemory = uint64(256e6)
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
 // Exported Items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+//
+// For zstd formats, the delimited Protobuf items are split into blocks which are compressed as
+// independent zstd frames, see blockWriter.
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
 	zWriter     *zlib.Writer
 	protoWriter protoio.WriteCloser
+
+	zstdEncoder *zstd.Encoder
+	blockWriter *blockWriter
 }
 
 // NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
@@ -46,21 +60,91 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	}
 }
 
+// NewFormatStreamWriter set up a stream pipeline to serialize snapshot DB records in the given format.
+func NewFormatStreamWriter(ch chan<- io.ReadCloser, format uint32) (*StreamWriter, error) {
+	if !types.IsZstdFormat(format) {
+		if types.FullFormat(format) != types.CurrentFormat {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+		}
+		if sw := NewStreamWriter(ch); sw != nil {
+			return sw, nil
+		}
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "zlib failure")
+	}
+
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	encoder, err := newZstdEncoder()
+	if err != nil {
+		chunkWriter.CloseWithError(err)
+		return nil, err
+	}
+	sw := &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufio.NewWriterSize(chunkWriter, snapshotBufferSize),
+		zstdEncoder: encoder,
+	}
+	sw.blockWriter = newBlockWriter(func(block []byte) error {
+		_, err := sw.bufWriter.Write(sw.compressBlock(block))
+		return err
+	})
+	return sw, nil
+}
+
+// newZstdEncoder creates the encoder compressing the blocks of zstd snapshots. Each block is
+// compressed by a single goroutine, but several blocks can be compressed concurrently.
+func newZstdEncoder() (*zstd.Encoder, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(snapshotZstdLevel))
+	return encoder, sdkerrors.Wrap(err, "zstd failure")
+}
+
 // WriteMsg implements protoio.Write interface
 func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
+	if sw.blockWriter != nil {
+		return sw.blockWriter.WriteMsg(msg)
+	}
 	return sw.protoWriter.WriteMsg(msg)
 }
 
-// Close implements io.Closer interface
-func (sw *StreamWriter) Close() error {
-	if err := sw.protoWriter.Close(); err != nil {
-		sw.chunkWriter.CloseWithError(err)
-		return err
+// compressBlock compresses a block of items produced by a blockWriter as a zstd frame if the format
+// uses zstd, and returns it unchanged otherwise. It can be called concurrently.
+func (sw *StreamWriter) compressBlock(block []byte) []byte {
+	if sw.zstdEncoder != nil {
+		return sw.zstdEncoder.EncodeAll(block, nil)
 	}
-	if err := sw.zWriter.Close(); err != nil {
-		sw.chunkWriter.CloseWithError(err)
+	return block
+}
+
+// writeBlock writes a block of items returned by compressBlock.
+func (sw *StreamWriter) writeBlock(block []byte) error {
+	if sw.blockWriter != nil {
+		if err := sw.blockWriter.Flush(); err != nil {
+			return err
+		}
+		_, err := sw.bufWriter.Write(block)
 		return err
 	}
+	_, err := sw.zWriter.Write(block)
+	return err
+}
+
+// Close implements io.Closer interface
+func (sw *StreamWriter) Close() error {
+	if sw.blockWriter != nil {
+		if err := sw.blockWriter.Flush(); err != nil {
+			sw.chunkWriter.CloseWithError(err)
+			return err
+		}
+		sw.zstdEncoder.Close()
+	} else {
+		if err := sw.protoWriter.Close(); err != nil {
+			sw.chunkWriter.CloseWithError(err)
+			return err
+		}
+		if err := sw.zWriter.Close(); err != nil {
+			sw.chunkWriter.CloseWithError(err)
+			return err
+		}
+	}
 	if err := sw.bufWriter.Flush(); err != nil {
 		sw.chunkWriter.CloseWithError(err)
 		return err
@@ -73,6 +157,50 @@ func (sw *StreamWriter) CloseWithError(err error) {
 	sw.chunkWriter.CloseWithError(err)
 }
 
+// blockWriter serializes snapshot items as delimited Protobuf messages into blocks, which are
+// passed to emit. A block is ended before each store and extension item, and once it reaches
+// snapshotBlockSize, so the blocks only depend on the items. In particular, the blocks of the
+// stores of a multistore are the same whether the stores are written in sequence or separately.
+type blockWriter struct {
+	block []byte
+	emit  func([]byte) error
+}
+
+// newBlockWriter creates a blockWriter passing the blocks to emit.
+func newBlockWriter(emit func([]byte) error) *blockWriter {
+	return &blockWriter{emit: emit}
+}
+
+// WriteMsg implements protoio.Write interface
+func (w *blockWriter) WriteMsg(msg proto.Message) error {
+	if item, ok := msg.(*types.SnapshotItem); ok && (item.GetStore() != nil || item.GetExtension() != nil) {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var prefix [binary.MaxVarintLen64]byte
+	w.block = append(w.block, prefix[:binary.PutUvarint(prefix[:], uint64(len(bz)))]...)
+	w.block = append(w.block, bz...)
+	if len(w.block) >= snapshotBlockSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush emits the pending block, if any.
+func (w *blockWriter) Flush() error {
+	if len(w.block) == 0 {
+		return nil
+	}
+	block := w.block
+	w.block = nil
+	return w.emit(block)
+}
+
 // StreamReader set up a restore stream pipeline
 // chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 type StreamReader struct {
@@ -96,6 +224,29 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	}, nil
 }
 
+// NewFormatStreamReader set up a restore stream pipeline for the given format.
+func NewFormatStreamReader(chunks <-chan io.ReadCloser, format uint32) (*StreamReader, error) {
+	if !types.IsZstdFormat(format) {
+		if types.FullFormat(format) != types.CurrentFormat {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+		}
+		return NewStreamReader(chunks)
+	}
+
+	chunkReader := NewChunkReader(chunks)
+	decoder, err := zstd.NewReader(chunkReader,
+		zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(snapshotZstdMaxMemory))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "zstd failure")
+	}
+	zReader := decoder.IOReadCloser()
+	return &StreamReader{
+		chunkReader: chunkReader,
+		zReader:     zReader,
+		protoReader: protoio.NewDelimitedReader(zReader, snapshotMaxItemSize),
+	}, nil
+}
+
 // ReadMsg implements protoio.Reader interface
 func (sr *StreamReader) ReadMsg(msg proto.Message) error {
 	return sr.protoReader.ReadMsg(msg)
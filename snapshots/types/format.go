@@ -1,6 +1,84 @@
 package types
 
+import (
+	"fmt"
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 2
+
+// DeltaFormat is the format of delta snapshots, which only contain the IAVL nodes changed since the
+// snapshot they are based on and reference the unchanged subtrees. Delta snapshots are advertised and
+// restored along with the chain of snapshots they are based on, down to a full snapshot of CurrentFormat.
+const DeltaFormat uint32 = 3
+
+// ZstdFormat is the format of full snapshots compressed as a sequence of zstd frames, which are
+// compressed concurrently. The hash of these snapshots is the Merkle root of their chunk hashes.
+const ZstdFormat uint32 = 4
+
+// ZstdDeltaFormat is the format of delta snapshots compressed like ZstdFormat snapshots, and
+// chained to a full snapshot of ZstdFormat.
+const ZstdDeltaFormat uint32 = 5
+
+const (
+	// CompressionZlib is the compression of CurrentFormat and DeltaFormat snapshots
+	CompressionZlib = "zlib"
+	// CompressionZstd is the compression of ZstdFormat and ZstdDeltaFormat snapshots
+	CompressionZstd = "zstd"
+)
+
+// FormatFromCompression returns the format of the full snapshots using the given compression.
+// An empty compression is zlib.
+func FormatFromCompression(compression string) (uint32, error) {
+	switch compression {
+	case "", CompressionZlib:
+		return CurrentFormat, nil
+	case CompressionZstd:
+		return ZstdFormat, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown snapshot compression %q", ErrUnknownFormat, compression)
+	}
+}
+
+// IsDeltaFormat returns true if the format is a format of delta snapshots.
+func IsDeltaFormat(format uint32) bool {
+	return format == DeltaFormat || format == ZstdDeltaFormat
+}
+
+// IsZstdFormat returns true if the format is compressed with zstd.
+func IsZstdFormat(format uint32) bool {
+	return format == ZstdFormat || format == ZstdDeltaFormat
+}
+
+// HasChunkManifest returns true if the hash of the snapshots of the format is the Merkle root
+// of their chunk hashes, which allows signing them.
+func HasChunkManifest(format uint32) bool {
+	return IsZstdFormat(format)
+}
+
+// FullFormat returns the format of the full snapshots the snapshots of the given format are chained to.
+func FullFormat(format uint32) uint32 {
+	switch format {
+	case DeltaFormat:
+		return CurrentFormat
+	case ZstdDeltaFormat:
+		return ZstdFormat
+	default:
+		return format
+	}
+}
+
+// DeltaFormatOf returns the format of the delta snapshots chained to the full snapshots of the given
+// format, or 0 if there is none.
+func DeltaFormatOf(format uint32) uint32 {
+	switch format {
+	case CurrentFormat, DeltaFormat:
+		return DeltaFormat
+	case ZstdFormat, ZstdDeltaFormat:
+		return ZstdDeltaFormat
+	default:
+		return 0
+	}
+}
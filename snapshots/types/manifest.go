@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// manifestSignPrefix prefixes the bytes signed by the signer of a snapshot
+const manifestSignPrefix = "cosmos-sdk/snapshot-manifest"
+
+// ChunkManifestRoot returns the Merkle root of the chunk hashes, which is the hash of the snapshots
+// of the formats with a chunk manifest.
+func ChunkManifestRoot(chunkHashes [][]byte) []byte {
+	return merkle.HashFromByteSlices(chunkHashes)
+}
+
+// ValidateManifest checks that the hash of a snapshot of a format with a chunk manifest is the
+// Merkle root of its chunk hashes. It is a no-op for the other formats.
+func (s Snapshot) ValidateManifest() error {
+	if !HasChunkManifest(s.Format) {
+		return nil
+	}
+	if !bytes.Equal(s.Hash, ChunkManifestRoot(s.Metadata.ChunkHashes)) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshot hash %X is not the Merkle root of its chunk hashes", s.Hash)
+	}
+	return nil
+}
+
+// ManifestSignBytes returns the bytes signed by the signer of the snapshot.
+func (s Snapshot) ManifestSignBytes() []byte {
+	bz := make([]byte, len(manifestSignPrefix)+16, len(manifestSignPrefix)+16+len(s.Hash))
+	n := copy(bz, manifestSignPrefix)
+	binary.BigEndian.PutUint64(bz[n:], s.Height)
+	binary.BigEndian.PutUint32(bz[n+8:], s.Format)
+	binary.BigEndian.PutUint32(bz[n+12:], s.Chunks)
+	return append(bz, s.Hash...)
+}
+
+// Sign signs the snapshot with an ed25519 key, e.g. the node key.
+func (s *Snapshot) Sign(key crypto.PrivKey) error {
+	if key.Type() != ed25519.KeyType {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "snapshots must be signed with an %s key, got %s", ed25519.KeyType, key.Type())
+	}
+	signature, err := key.Sign(s.ManifestSignBytes())
+	if err != nil {
+		return err
+	}
+	s.Metadata.Signer = key.PubKey().Bytes()
+	s.Metadata.Signature = signature
+	return nil
+}
+
+// VerifySigner checks that the snapshot is signed by one of the trusted signers, given as hex
+// encoded node IDs, and that its hash is the Merkle root of its chunk hashes.
+func (s Snapshot) VerifySigner(trustedSigners []string) error {
+	if !HasChunkManifest(s.Format) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshots of format %v can't be signed", s.Format)
+	}
+	if err := s.ValidateManifest(); err != nil {
+		return err
+	}
+	if len(s.Metadata.Signer) != ed25519.PubKeySize {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot is not signed")
+	}
+	signer := ed25519.PubKey(s.Metadata.Signer)
+	id := hex.EncodeToString(signer.Address())
+	trusted := false
+	for _, trustedSigner := range trustedSigners {
+		if strings.EqualFold(trustedSigner, id) {
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshot signer %s is not trusted", id)
+	}
+	if !signer.VerifySignature(s.ManifestSignBytes(), s.Metadata.Signature) {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "invalid snapshot signature")
+	}
+	return nil
+}
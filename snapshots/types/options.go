@@ -1,5 +1,9 @@
 package types
 
+import (
+	"github.com/tendermint/tendermint/crypto"
+)
+
 // SnapshotOptions defines the snapshot strategy used when determining which
 // heights are snapshotted for state sync.
 type SnapshotOptions struct {
@@ -8,6 +12,26 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxDeltas defines how many delta snapshots are taken after a full snapshot
+	// before taking the next full snapshot. 0 disables delta snapshots.
+	MaxDeltas uint32
+
+	// Format defines the format of the full snapshots taken, CurrentFormat or ZstdFormat.
+	// 0 defaults to CurrentFormat.
+	Format uint32
+
+	// ExportWorkers defines how many stores are exported concurrently. 0 or 1
+	// exports the stores sequentially.
+	ExportWorkers uint32
+
+	// Signer is the ed25519 key signing the snapshots of the formats with a chunk
+	// manifest, e.g. the node key. Snapshots are not signed if it is nil.
+	Signer crypto.PrivKey
+
+	// TrustedSigners are the hex encoded node IDs of the signers whose snapshots
+	// are restored. If not empty, only signed snapshots are restored.
+	TrustedSigners []string
 }
 
 // SnapshotIntervalOff represents the snapshot interval, at which
@@ -20,3 +44,11 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// FullFormat returns the format of the full snapshots taken.
+func (o SnapshotOptions) FullFormat() uint32 {
+	if o.Format == 0 {
+		return CurrentFormat
+	}
+	return o.Format
+}
@@ -103,6 +103,24 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot a delta snapshot is based on, it is 0 for full snapshots.
+	//
+	// Since: cosmos-sdk 0.47
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// chain lists the snapshots making up an advertised delta snapshot, in the order of their chunks:
+	// the delta snapshots from the newest to the oldest, followed by the full snapshot they are based on.
+	//
+	// Since: cosmos-sdk 0.47
+	Chain []SnapshotLink `protobuf:"bytes,3,rep,name=chain,proto3" json:"chain"`
+	// signer is the ed25519 public key of the node which signed a snapshot whose hash is the Merkle
+	// root of its chunk hashes.
+	//
+	// Since: cosmos-sdk 0.47
+	Signer []byte `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signature is the signature of the height, format, chunks and hash of the snapshot by the signer.
+	//
+	// Since: cosmos-sdk 0.47
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +163,105 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetChain() []SnapshotLink {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+func (m *Metadata) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *Metadata) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// SnapshotLink describes a snapshot of a delta snapshot chain.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotLink struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format uint32 `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunks uint32 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Hash   []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotLink) Reset()         { *m = SnapshotLink{} }
+func (m *SnapshotLink) String() string { return proto.CompactTextString(m) }
+func (*SnapshotLink) ProtoMessage()    {}
+func (*SnapshotLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *SnapshotLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotLink.Merge(m, src)
+}
+func (m *SnapshotLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotLink proto.InternalMessageInfo
+
+func (m *SnapshotLink) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotLink) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *SnapshotLink) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *SnapshotLink) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -158,6 +275,7 @@ type SnapshotItem struct {
 	//	*SnapshotItem_ExtensionPayload
 	//	*SnapshotItem_KV
 	//	*SnapshotItem_Schema
+	//	*SnapshotItem_IAVLSubtree
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -165,7 +283,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +336,9 @@ type SnapshotItem_KV struct {
 type SnapshotItem_Schema struct {
 	Schema *SnapshotSchema `protobuf:"bytes,6,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
 }
+type SnapshotItem_IAVLSubtree struct {
+	IAVLSubtree *SnapshotIAVLSubtreeItem `protobuf:"bytes,7,opt,name=iavl_subtree,json=iavlSubtree,proto3,oneof" json:"iavl_subtree,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
@@ -225,6 +346,7 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 func (*SnapshotItem_KV) isSnapshotItem_Item()               {}
 func (*SnapshotItem_Schema) isSnapshotItem_Item()           {}
+func (*SnapshotItem_IAVLSubtree) isSnapshotItem_Item()      {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -275,6 +397,13 @@ func (m *SnapshotItem) GetSchema() *SnapshotSchema {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLSubtree() *SnapshotIAVLSubtreeItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLSubtree); ok {
+		return x.IAVLSubtree
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -284,6 +413,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_ExtensionPayload)(nil),
 		(*SnapshotItem_KV)(nil),
 		(*SnapshotItem_Schema)(nil),
+		(*SnapshotItem_IAVLSubtree)(nil),
 	}
 }
 
@@ -298,7 +428,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +480,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,6 +537,71 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLSubtreeItem references a subtree of an IAVL tree which is unchanged since the
+// base snapshot of a delta snapshot. The subtree is made of the given number of nodes, exported in
+// post-order by the base snapshot starting with the leaf of the given key and version.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotIAVLSubtreeItem struct {
+	Key     []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Nodes   uint64 `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (m *SnapshotIAVLSubtreeItem) Reset()         { *m = SnapshotIAVLSubtreeItem{} }
+func (m *SnapshotIAVLSubtreeItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLSubtreeItem) ProtoMessage()    {}
+func (*SnapshotIAVLSubtreeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLSubtreeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLSubtreeItem.Merge(m, src)
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLSubtreeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLSubtreeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLSubtreeItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLSubtreeItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLSubtreeItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLSubtreeItem) GetNodes() uint64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -419,7 +614,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +668,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +716,7 @@ func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
 func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotKVItem) ProtoMessage()    {}
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
 }
 func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +770,7 @@ func (m *SnapshotSchema) Reset()         { *m = SnapshotSchema{} }
 func (m *SnapshotSchema) String() string { return proto.CompactTextString(m) }
 func (*SnapshotSchema) ProtoMessage()    {}
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{10}
 }
 func (m *SnapshotSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,9 +809,11 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*SnapshotLink)(nil), "cosmos.base.snapshots.v1beta1.SnapshotLink")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLSubtreeItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLSubtreeItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload")
 	proto.RegisterType((*SnapshotKVItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotKVItem")
@@ -628,44 +825,52 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xb6, 0x13, 0x27, 0x4d, 0x8f, 0x7d, 0x7b, 0xdb, 0x51, 0xef, 0xbd, 0xd6, 0x15, 0x24, 0xc1,
+	0x42, 0x6a, 0x24, 0xda, 0x84, 0x86, 0x0a, 0xd8, 0x12, 0x04, 0x75, 0xd5, 0x22, 0xd0, 0x04, 0x75,
+	0x01, 0x8b, 0x68, 0x92, 0x4c, 0x63, 0x93, 0xd8, 0x8e, 0x3c, 0x93, 0x88, 0xbc, 0x05, 0xaf, 0xc2,
+	0x5b, 0x74, 0x83, 0xd4, 0x25, 0xab, 0x08, 0xa5, 0x0f, 0xc0, 0x2b, 0xa0, 0x99, 0xb1, 0xd3, 0xb4,
+	0xb4, 0x90, 0x2e, 0x58, 0xe5, 0x7c, 0x27, 0xe7, 0x7c, 0xe7, 0x67, 0xbe, 0xf1, 0xc0, 0x76, 0x27,
+	0x62, 0x41, 0xc4, 0x6a, 0x6d, 0xc2, 0x68, 0x8d, 0x85, 0x64, 0xc8, 0xbc, 0x88, 0xb3, 0xda, 0x78,
+	0xb7, 0x4d, 0x39, 0xd9, 0x9d, 0x7b, 0xaa, 0xc3, 0x38, 0xe2, 0x11, 0xba, 0xab, 0xa2, 0xab, 0x22,
+	0xba, 0x3a, 0x8f, 0xae, 0x26, 0xd1, 0xff, 0x6f, 0xf6, 0xa2, 0x5e, 0x24, 0x23, 0x6b, 0xc2, 0x52,
+	0x49, 0xce, 0x67, 0x1d, 0x0a, 0xcd, 0x24, 0x16, 0xfd, 0x0b, 0x79, 0x8f, 0xfa, 0x3d, 0x8f, 0xdb,
+	0x7a, 0x59, 0xaf, 0x18, 0x38, 0x41, 0xc2, 0x7f, 0x12, 0xc5, 0x01, 0xe1, 0x76, 0xa6, 0xac, 0x57,
+	0xfe, 0xc2, 0x09, 0x12, 0xfe, 0x8e, 0x37, 0x0a, 0xfb, 0xcc, 0xce, 0x2a, 0xbf, 0x42, 0x08, 0x81,
+	0xe1, 0x11, 0xe6, 0xd9, 0x46, 0x59, 0xaf, 0x58, 0x58, 0xda, 0xe8, 0x00, 0x0a, 0x01, 0xe5, 0xa4,
+	0x4b, 0x38, 0xb1, 0x73, 0x65, 0xbd, 0x62, 0xd6, 0xb7, 0xaa, 0xbf, 0x6c, 0xb8, 0xfa, 0x2a, 0x09,
+	0x6f, 0x18, 0xa7, 0xd3, 0x92, 0x86, 0xe7, 0xe9, 0xce, 0x17, 0x1d, 0x0a, 0xe9, 0x9f, 0xe8, 0x1e,
+	0x58, 0xb2, 0x6a, 0x4b, 0x54, 0xa1, 0xcc, 0xd6, 0xcb, 0xd9, 0x8a, 0x85, 0x4d, 0xe9, 0x73, 0xa5,
+	0x0b, 0x95, 0xc0, 0x14, 0x25, 0x5a, 0xc9, 0x6c, 0x19, 0x39, 0x1b, 0x08, 0x97, 0xab, 0xe6, 0xdb,
+	0x87, 0x5c, 0xc7, 0x23, 0x7e, 0x68, 0x67, 0xcb, 0xd9, 0x8a, 0x59, 0x7f, 0xf0, 0x9b, 0xc6, 0xd2,
+	0x7d, 0x1d, 0xf9, 0x61, 0x3f, 0x69, 0x4e, 0xe5, 0x8b, 0x85, 0x30, 0xbf, 0x17, 0xd2, 0x38, 0x19,
+	0x3d, 0x41, 0xe8, 0x0e, 0xac, 0x0a, 0x8b, 0xf0, 0x51, 0x4c, 0xe5, 0xf4, 0x16, 0xbe, 0x70, 0x38,
+	0x1f, 0xc0, 0x5a, 0xa4, 0xfc, 0x93, 0xc7, 0xe0, 0x7c, 0x37, 0x2e, 0x8a, 0x1d, 0x70, 0x1a, 0x20,
+	0x17, 0x72, 0x8c, 0x47, 0x31, 0x95, 0xb5, 0xcc, 0xfa, 0xc3, 0x25, 0x67, 0x6f, 0x8a, 0x1c, 0x41,
+	0xe0, 0x6a, 0x58, 0x11, 0xa0, 0xd7, 0x60, 0xf8, 0x64, 0x3c, 0x90, 0xcd, 0x99, 0xf5, 0xda, 0x92,
+	0x44, 0x07, 0xcf, 0x8e, 0x8f, 0x04, 0x4f, 0xa3, 0x30, 0x9b, 0x96, 0x0c, 0x81, 0x5c, 0x0d, 0x4b,
+	0x22, 0xf4, 0x16, 0x56, 0xe9, 0x47, 0x4e, 0x43, 0xe6, 0x47, 0xa1, 0x1c, 0xcd, 0xac, 0xef, 0x2d,
+	0xc9, 0xfa, 0x22, 0xcd, 0x13, 0x3a, 0x71, 0x35, 0x7c, 0x41, 0x84, 0x4e, 0x60, 0x63, 0x0e, 0x5a,
+	0x43, 0x32, 0x19, 0x44, 0xa4, 0x2b, 0x57, 0x64, 0xd6, 0x9f, 0xdc, 0x96, 0xfd, 0x8d, 0x4a, 0x77,
+	0x35, 0xbc, 0x4e, 0xaf, 0xf8, 0xd0, 0x3e, 0x64, 0xfa, 0xe3, 0x44, 0xea, 0x3b, 0x4b, 0x12, 0x1f,
+	0x1e, 0xcb, 0x55, 0xe4, 0x67, 0xd3, 0x52, 0xe6, 0xf0, 0xd8, 0xd5, 0x70, 0xa6, 0x3f, 0x46, 0xfb,
+	0x90, 0x67, 0x1d, 0x8f, 0x06, 0xc4, 0xce, 0xdf, 0x8a, 0xac, 0x29, 0x93, 0x5c, 0x0d, 0x27, 0xe9,
+	0x68, 0x00, 0x96, 0xd8, 0x6b, 0x8b, 0x8d, 0xda, 0x3c, 0xa6, 0xd4, 0x5e, 0x91, 0x74, 0x8f, 0x6f,
+	0x71, 0x50, 0x4d, 0x95, 0x29, 0x9b, 0xfc, 0x7b, 0x36, 0x2d, 0x99, 0x0b, 0x4e, 0x57, 0xc3, 0xa6,
+	0xa0, 0x4f, 0x60, 0x23, 0x0f, 0x86, 0xcf, 0x69, 0xe0, 0x6c, 0xc1, 0xc6, 0x4f, 0xa2, 0x11, 0xd2,
+	0x0c, 0x49, 0xa0, 0x44, 0xb7, 0x8a, 0xa5, 0xed, 0x0c, 0x60, 0xfd, 0xaa, 0x28, 0xd0, 0x3a, 0x64,
+	0xfb, 0x74, 0x22, 0xc3, 0x2c, 0x2c, 0x4c, 0xb4, 0x09, 0xb9, 0x31, 0x19, 0x8c, 0xa8, 0x94, 0x99,
+	0x85, 0x15, 0x40, 0x36, 0xac, 0x8c, 0x69, 0x3c, 0x17, 0x4a, 0x16, 0xa7, 0x70, 0xe1, 0x32, 0x89,
+	0x33, 0xce, 0xa5, 0x97, 0xc9, 0x79, 0x0f, 0xff, 0xdd, 0x30, 0xd9, 0x35, 0x45, 0x17, 0xe8, 0x33,
+	0x97, 0xe9, 0x37, 0x21, 0x17, 0x46, 0x5d, 0xaa, 0xae, 0x9e, 0x81, 0x15, 0x70, 0x9e, 0xc3, 0x3f,
+	0xd7, 0x2a, 0xf1, 0xba, 0xb9, 0x6f, 0xba, 0xd6, 0xce, 0x1e, 0xd8, 0x37, 0x09, 0x4e, 0x34, 0x94,
+	0x4a, 0x57, 0xb5, 0x99, 0x42, 0xe7, 0x29, 0xac, 0x5d, 0x56, 0xd3, 0xb2, 0x3b, 0x74, 0xee, 0xc3,
+	0xda, 0x65, 0xe9, 0x88, 0x6e, 0xfb, 0x74, 0x92, 0x7e, 0x53, 0xa5, 0xdd, 0x78, 0x79, 0x3a, 0x2b,
+	0xea, 0x67, 0xb3, 0xa2, 0xfe, 0x6d, 0x56, 0xd4, 0x3f, 0x9d, 0x17, 0xb5, 0xb3, 0xf3, 0xa2, 0xf6,
+	0xf5, 0xbc, 0xa8, 0xbd, 0xdb, 0xee, 0xf9, 0xdc, 0x1b, 0xb5, 0xab, 0x9d, 0x28, 0xa8, 0x25, 0x0f,
+	0x97, 0xfa, 0xd9, 0x61, 0xdd, 0xfe, 0xc2, 0xf3, 0xc5, 0x27, 0x43, 0xca, 0xda, 0x79, 0xf9, 0xfe,
+	0x3c, 0xfa, 0x31, 0x00, 0x76, 0xb9, 0x0f, 0x32, 0xe4, 0x06, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -743,6 +948,39 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chain) > 0 {
+		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -755,6 +993,51 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Format != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -913,6 +1196,27 @@ func (m *SnapshotItem_Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLSubtree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLSubtree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLSubtree != nil {
+		{
+			size, err := m.IAVLSubtree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -990,6 +1294,46 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLSubtreeItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLSubtreeItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLSubtreeItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nodes != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Nodes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1171,28 +1515,67 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *SnapshotItem) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
 	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		n += m.Item.Size()
+	if len(m.Chain) > 0 {
+		for _, e := range m.Chain {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
 	}
 	return n
 }
 
-func (m *SnapshotItem_Store) Size() (n int) {
+func (m *SnapshotLink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Store != nil {
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		n += m.Item.Size()
+	}
+	return n
+}
+
+func (m *SnapshotItem_Store) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Store != nil {
 		l = m.Store.Size()
 		n += 1 + l + sovSnapshot(uint64(l))
 	}
@@ -1258,6 +1641,18 @@ func (m *SnapshotItem_Schema) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLSubtree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLSubtree != nil {
+		l = m.IAVLSubtree.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1294,6 +1689,25 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLSubtreeItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovSnapshot(uint64(m.Nodes))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1596,6 +2010,268 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = append(m.Chain, SnapshotLink{})
+			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1856,6 +2532,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_Schema{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLSubtree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLSubtreeItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLSubtree{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -2115,6 +2826,128 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLSubtreeItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtreeItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtreeItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
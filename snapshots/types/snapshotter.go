@@ -54,3 +54,13 @@ type ExtensionSnapshotter interface {
 	// the payload reader returns `io.EOF` when reached the extension boundaries.
 	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
+
+// StoreSnapshotter is implemented by the multistores whose stores can be snapshotted concurrently.
+// The snapshot items of the multistore are the items of its stores, in the order of SnapshotStores.
+type StoreSnapshotter interface {
+	// SnapshotStores returns the names of the stores to snapshot at the given height, in snapshot order.
+	SnapshotStores(height uint64) ([]string, error)
+
+	// SnapshotStore writes the snapshot items of a single store into the protobuf writer.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"bytes"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// bTreeDegree is the degree of the btree nodes, which bounds the number of items
+// copied when writing to a node shared with a snapshot.
+const bTreeDegree = 32
+
+// item is an entry of the BTree. A nil value of a dirty item means the key was
+// deleted, while a nil value of a clean item means the parent doesn't have the key.
+// The items are never modified once in the BTree, since the snapshots share them.
+type item struct {
+	key   []byte
+	value []byte
+}
+
+func byKeys(a, b *item) bool {
+	return bytes.Compare(a.key, b.key) < 0
+}
+
+// BTree is the in-memory cache of a cachekv store, holding both the values read from
+// the parent and the writes not yet flushed to it.
+//
+// The cached values are held in a map for the lookups, while the dirty ones are also
+// indexed by a copy-on-write btree for the iterators and the writes, so that
+// iterating doesn't step through the clean values. Copying the btree is O(1), so that
+// the iterators read from a snapshot of the dirty values instead of a sorted copy of
+// them, and the writes made while iterating don't invalidate them.
+//
+// The BTree is not safe for concurrent writes, which the cachekv store guards with its
+// mutex. The snapshots of the iterators are safe to read while the BTree is written.
+type BTree struct {
+	cache map[string]*item
+	dirty *btree.BTreeG[*item]
+}
+
+// NewBTree creates an empty BTree.
+func NewBTree() BTree {
+	return BTree{
+		cache: make(map[string]*item),
+		dirty: btree.NewBTreeGOptions(byKeys, btree.Options{
+			Degree: bTreeDegree,
+			// the BTree is guarded by the mutex of the cachekv store, and the
+			// snapshots are only read
+			NoLocks: true,
+		}),
+	}
+}
+
+// Get returns the value cached for the key, and whether the key is cached.
+func (bt BTree) Get(key []byte) ([]byte, bool) {
+	i, found := bt.cache[string(key)]
+	if !found {
+		return nil, false
+	}
+	return i.value, true
+}
+
+// Set caches the value of the key, dirty meaning it differs from the parent value.
+// A dirty key stays dirty until the BTree is cleared, so a clean value must only be
+// set for a key which is not cached. The key and value are retained, so the caller
+// must not modify them.
+func (bt BTree) Set(key, value []byte, dirty bool) {
+	i := &item{key: key, value: value}
+	bt.cache[string(key)] = i
+	if dirty {
+		bt.dirty.Set(i)
+	}
+}
+
+// Len returns the number of cached keys.
+func (bt BTree) Len() int {
+	return len(bt.cache)
+}
+
+// Clear removes all the cached keys. The snapshots of the iterators are not modified.
+func (bt BTree) Clear() {
+	for key := range bt.cache {
+		delete(bt.cache, key)
+	}
+	bt.dirty.Clear()
+}
+
+// ScanDirty calls fn on the dirty keys in ascending order, until it returns false.
+// The value of a deleted key is nil.
+func (bt BTree) ScanDirty(fn func(key, value []byte) bool) {
+	bt.dirty.Scan(func(i *item) bool {
+		return fn(i.key, i.value)
+	})
+}
+
+// Iterator returns an iterator over the dirty keys within [start, end), in ascending
+// order. It reads from a snapshot of the dirty keys taken in O(1) when it is created,
+// the btree nodes being copied lazily on the next writes.
+func (bt BTree) Iterator(start, end []byte) types.Iterator {
+	return newMemIterator(start, end, bt.dirty.Copy(), true)
+}
+
+// ReverseIterator returns an iterator over the dirty keys within [start, end), in
+// descending order. It reads from a snapshot of the dirty keys taken in O(1) when it
+// is created, the btree nodes being copied lazily on the next writes.
+func (bt BTree) ReverseIterator(start, end []byte) types.Iterator {
+	return newMemIterator(start, end, bt.dirty.Copy(), false)
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func collect(t *testing.T, itr types.Iterator) (keys []string, values []string) {
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+		values = append(values, string(itr.Value()))
+	}
+	require.Error(t, itr.Error())
+	require.Panics(t, func() { itr.Key() })
+	require.NoError(t, itr.Close())
+	return keys, values
+}
+
+func TestBTree(t *testing.T) {
+	bt := NewBTree()
+	bt.Set([]byte("b"), []byte("1"), true)
+	bt.Set([]byte("d"), []byte("2"), false)
+	bt.Set([]byte("c"), nil, true)
+	bt.Set([]byte("e"), nil, false)
+	bt.Set([]byte("a"), []byte("3"), true)
+	bt.Set([]byte("f"), []byte("4"), true)
+	require.Equal(t, 6, bt.Len())
+
+	value, found := bt.Get([]byte("d"))
+	require.True(t, found)
+	require.Equal(t, []byte("2"), value)
+	value, found = bt.Get([]byte("e"))
+	require.True(t, found)
+	require.Nil(t, value)
+	_, found = bt.Get([]byte("g"))
+	require.False(t, found)
+
+	var dirty []string
+	bt.ScanDirty(func(key, value []byte) bool {
+		dirty = append(dirty, string(key))
+		return true
+	})
+	require.Equal(t, []string{"a", "b", "c", "f"}, dirty)
+
+	// the iterators skip the clean items and return a nil value for the deleted ones
+	testCases := []struct {
+		start, end []byte
+		ascending  []string
+		descending []string
+	}{
+		{nil, nil, []string{"a", "b", "c", "f"}, []string{"f", "c", "b", "a"}},
+		{[]byte("b"), []byte("f"), []string{"b", "c"}, []string{"c", "b"}},
+		{[]byte("bb"), []byte("e"), []string{"c"}, []string{"c"}},
+		{[]byte("d"), []byte("f"), nil, nil},
+		{[]byte("d"), nil, []string{"f"}, []string{"f"}},
+		{nil, []byte("b"), []string{"a"}, []string{"a"}},
+		{[]byte("c"), []byte("a"), nil, nil},
+	}
+	for _, tc := range testCases {
+		keys, _ := collect(t, bt.Iterator(tc.start, tc.end))
+		require.Equal(t, tc.ascending, keys, "[%q, %q)", tc.start, tc.end)
+		keys, _ = collect(t, bt.ReverseIterator(tc.start, tc.end))
+		require.Equal(t, tc.descending, keys, "[%q, %q) reversed", tc.start, tc.end)
+	}
+	_, values := collect(t, bt.Iterator(nil, nil))
+	require.Equal(t, []string{"3", "1", "", "4"}, values)
+
+	// the iterators read from a snapshot
+	itr := bt.Iterator(nil, nil)
+	bt.Set([]byte("b"), []byte("5"), true)
+	bt.Set([]byte("bb"), []byte("6"), true)
+	keys, values := collect(t, bt.Iterator([]byte("b"), []byte("c")))
+	require.Equal(t, []string{"b", "bb"}, keys)
+	require.Equal(t, []string{"5", "6"}, values)
+	bt.Clear()
+	require.Equal(t, 0, bt.Len())
+	_, found = bt.Get([]byte("b"))
+	require.False(t, found)
+	keys, _ = collect(t, bt.Iterator(nil, nil))
+	require.Empty(t, keys)
+	keys, values = collect(t, itr)
+	require.Equal(t, []string{"a", "b", "c", "f"}, keys)
+	require.Equal(t, []string{"3", "1", "", "4"}, values)
+}
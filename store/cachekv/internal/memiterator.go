@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.Iterator = (*memIterator)(nil)
+
+// memIterator iterates over a snapshot of the dirty items of a BTree, stepping through
+// the btree nodes without copying them. The value of a deleted key is nil.
+type memIterator struct {
+	iter btree.GenericIter[*item]
+
+	start     []byte
+	end       []byte
+	ascending bool
+	valid     bool
+}
+
+func newMemIterator(start, end []byte, items *btree.BTreeG[*item], ascending bool) *memIterator {
+	iter := items.Iter()
+	var valid bool
+	if ascending {
+		if start != nil {
+			valid = iter.Seek(&item{key: start})
+		} else {
+			valid = iter.First()
+		}
+	} else {
+		if end != nil {
+			// the first item >= end is excluded
+			valid = iter.Seek(&item{key: end})
+			if valid {
+				valid = iter.Prev()
+			} else {
+				valid = iter.Last()
+			}
+		} else {
+			valid = iter.Last()
+		}
+	}
+
+	mi := &memIterator{
+		iter:      iter,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		valid:     valid,
+	}
+	mi.checkDomain()
+	return mi
+}
+
+// Domain implements Iterator.
+func (mi *memIterator) Domain() (start []byte, end []byte) {
+	return mi.start, mi.end
+}
+
+// Valid implements Iterator.
+func (mi *memIterator) Valid() bool {
+	return mi.valid
+}
+
+// Next implements Iterator.
+func (mi *memIterator) Next() {
+	mi.assertValid()
+	mi.step()
+	mi.checkDomain()
+}
+
+// Key implements Iterator.
+func (mi *memIterator) Key() []byte {
+	mi.assertValid()
+	return mi.iter.Item().key
+}
+
+// Value implements Iterator.
+func (mi *memIterator) Value() []byte {
+	mi.assertValid()
+	return mi.iter.Item().value
+}
+
+// Error implements Iterator.
+func (mi *memIterator) Error() error {
+	if !mi.Valid() {
+		return errors.New("invalid memIterator")
+	}
+	return nil
+}
+
+// Close implements Iterator.
+func (mi *memIterator) Close() error {
+	mi.iter.Release()
+	mi.valid = false
+	return nil
+}
+
+func (mi *memIterator) step() {
+	if mi.ascending {
+		mi.valid = mi.iter.Next()
+	} else {
+		mi.valid = mi.iter.Prev()
+	}
+}
+
+// checkDomain invalidates the iterator once it steps out of the domain.
+func (mi *memIterator) checkDomain() {
+	if !mi.valid {
+		return
+	}
+	key := mi.iter.Item().key
+	if mi.ascending && mi.end != nil && bytes.Compare(key, mi.end) >= 0 {
+		mi.valid = false
+	}
+	if !mi.ascending && mi.start != nil && bytes.Compare(key, mi.start) < 0 {
+		mi.valid = false
+	}
+}
+
+func (mi *memIterator) assertValid() {
+	if err := mi.Error(); err != nil {
+		panic(err)
+	}
+}
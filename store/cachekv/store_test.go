@@ -350,6 +350,52 @@ func TestNilEndIterator(t *testing.T) {
 	}
 }
 
+func TestCacheKVIteratorWritesWhileIterating(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(0), valFmt(0))
+	mem.Set(keyFmt(2), valFmt(2))
+	st := cachekv.NewStore(mem)
+	st.Set(keyFmt(1), valFmt(1))
+	st.Delete(keyFmt(2))
+	require.Equal(t, valFmt(0), st.Get(keyFmt(0)))
+
+	// the iterators read the state of the cache when they are created
+	for _, ascending := range []bool{true, false} {
+		var itr types.Iterator
+		if ascending {
+			itr = st.Iterator(nil, nil)
+		} else {
+			itr = st.ReverseIterator(nil, nil)
+		}
+		var keys [][]byte
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, itr.Key())
+			st.Set(keyFmt(3), valFmt(3))
+			st.Delete(keyFmt(1))
+		}
+		require.NoError(t, itr.Close())
+		if ascending {
+			require.Equal(t, [][]byte{keyFmt(0), keyFmt(1)}, keys)
+		} else {
+			require.Equal(t, [][]byte{keyFmt(3), keyFmt(1), keyFmt(0)}, keys)
+		}
+		st.Set(keyFmt(1), valFmt(1))
+	}
+
+	// writing the store doesn't affect the open iterators
+	itr := st.Iterator(nil, nil)
+	st.Write()
+	st.Delete(keyFmt(0))
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, [][]byte{keyFmt(0), keyFmt(1), keyFmt(3)}, keys)
+	require.Nil(t, mem.Get(keyFmt(2)))
+	require.Equal(t, valFmt(0), mem.Get(keyFmt(0)))
+}
+
 //-------------------------------------------------------------------------------------------
 // do some random ops
 
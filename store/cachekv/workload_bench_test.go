@@ -0,0 +1,143 @@
+package cachekv_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The benchmarks run workloads modeled after the access patterns of simapp, over a parent
+// holding a populated state. To compare two revisions of the store, run them on both,
+// copying this file to the older one if needed, and compare the results with benchstat:
+//
+//	go test -run '^$' -bench CacheKV -count 10 ./store/cachekv > new.txt
+//	benchstat old.txt new.txt
+
+// The prefixes of the state, after the bank, staking and distribution ones.
+var (
+	benchBalancesPrefix    = []byte{0x02}
+	benchPowerIndexPrefix  = []byte{0x23}
+	benchDelegationsPrefix = []byte{0x31}
+	benchRewardsPrefix     = []byte{0x41}
+)
+
+const (
+	benchAccounts    = 10000
+	benchValidators  = 200
+	benchDelegations = 20
+)
+
+func benchStateKey(prefix []byte, ids ...uint64) []byte {
+	key := make([]byte, len(prefix)+8*len(ids))
+	copy(key, prefix)
+	for i, id := range ids {
+		binary.BigEndian.PutUint64(key[len(prefix)+8*i:], id)
+	}
+	return key
+}
+
+// newBenchParent returns a parent store holding the balances of the accounts, the power
+// index of the validators and their delegations and rewards.
+func newBenchParent() types.KVStore {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := make([]byte, 64)
+	for acc := uint64(0); acc < benchAccounts; acc++ {
+		parent.Set(benchStateKey(benchBalancesPrefix, acc), value)
+	}
+	for val := uint64(0); val < benchValidators; val++ {
+		parent.Set(benchStateKey(benchPowerIndexPrefix, val), value)
+		for del := uint64(0); del < benchDelegations; del++ {
+			parent.Set(benchStateKey(benchDelegationsPrefix, val, del), value)
+			parent.Set(benchStateKey(benchRewardsPrefix, val, del), value)
+		}
+	}
+	return parent
+}
+
+func iterateN(itr types.Iterator, n int) {
+	for i := 0; i < n && itr.Valid(); i++ {
+		_ = itr.Value()
+		itr.Next()
+	}
+	itr.Close()
+}
+
+func runCacheKVBenchmarks(b *testing.B, sizes []int, workload func(store types.CacheKVStore, r *rand.Rand, size int)) {
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("ops-%d", size), func(b *testing.B) {
+			parent := newBenchParent()
+			r := rand.New(rand.NewSource(49872768940))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				workload(cachekv.NewStore(parent), r, size)
+			}
+		})
+	}
+}
+
+// The txs of a block send tokens between random accounts, without iterating.
+func BenchmarkCacheKVDeliverTxs(b *testing.B) {
+	value := make([]byte, 64)
+	runCacheKVBenchmarks(b, []int{1000, 10000}, func(store types.CacheKVStore, r *rand.Rand, size int) {
+		for i := 0; i < size; i++ {
+			from := benchStateKey(benchBalancesPrefix, uint64(r.Intn(benchAccounts)))
+			to := benchStateKey(benchBalancesPrefix, uint64(r.Intn(benchAccounts*2)))
+			store.Get(from)
+			store.Get(to)
+			store.Set(from, value)
+			store.Set(to, value)
+		}
+		store.Write()
+	})
+}
+
+// The staking EndBlocker updates the power index of the validators, and iterates over it
+// from the highest power to find the active set after each update.
+func BenchmarkCacheKVEndBlocker(b *testing.B) {
+	value := make([]byte, 64)
+	runCacheKVBenchmarks(b, []int{1000, 10000}, func(store types.CacheKVStore, r *rand.Rand, size int) {
+		for i := 0; i < size; i++ {
+			val := uint64(r.Intn(benchValidators))
+			store.Delete(benchStateKey(benchPowerIndexPrefix, val))
+			store.Set(benchStateKey(benchPowerIndexPrefix, val+uint64(r.Intn(benchValidators))), value)
+			iterateN(store.ReverseIterator(benchPowerIndexPrefix, types.PrefixEndBytes(benchPowerIndexPrefix)), 10)
+		}
+		store.Write()
+	})
+}
+
+// The distribution hooks withdraw the rewards of a delegation on each delegation change,
+// iterating over the delegations of its validator.
+func BenchmarkCacheKVDistributionHooks(b *testing.B) {
+	value := make([]byte, 64)
+	runCacheKVBenchmarks(b, []int{1000, 10000}, func(store types.CacheKVStore, r *rand.Rand, size int) {
+		for i := 0; i < size; i++ {
+			val, del := uint64(r.Intn(benchValidators)), uint64(r.Intn(benchDelegations*2))
+			store.Get(benchStateKey(benchDelegationsPrefix, val, del))
+			store.Set(benchStateKey(benchDelegationsPrefix, val, del), value)
+			store.Delete(benchStateKey(benchRewardsPrefix, val, del))
+			prefix := benchStateKey(benchDelegationsPrefix, val)
+			iterateN(store.Iterator(prefix, types.PrefixEndBytes(prefix)), benchDelegations)
+		}
+		store.Write()
+	})
+}
+
+// An export iterates once over a state modified by a block.
+func BenchmarkCacheKVIterateAfterWrites(b *testing.B) {
+	value := make([]byte, 64)
+	runCacheKVBenchmarks(b, []int{1000, 10000}, func(store types.CacheKVStore, r *rand.Rand, size int) {
+		for i := 0; i < size; i++ {
+			store.Set(benchStateKey(benchBalancesPrefix, uint64(r.Intn(benchAccounts*2))), value)
+		}
+		iterateN(store.Iterator(nil, nil), benchAccounts*2)
+	})
+}
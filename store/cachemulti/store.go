@@ -33,9 +33,13 @@ type Store struct {
 	traceContext types.TraceContext
 
 	listeners map[types.StoreKey][]types.WriteListener
+	metrics   map[string]bool
 }
 
-var _ types.CacheMultiStore = Store{}
+var (
+	_ types.CacheMultiStore   = Store{}
+	_ types.MetricsMultiStore = Store{}
+)
 
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
@@ -86,7 +90,7 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 		stores[k] = v
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, cms.listeners)
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, cms.listeners).SetMetrics(cms.metrics)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -134,6 +138,19 @@ func (cms Store) ListeningEnabled(key types.StoreKey) bool {
 	return false
 }
 
+// SetMetrics sets the names of the KVStores whose operations emit metrics. The
+// modified Store is returned.
+func (cms Store) SetMetrics(metrics map[string]bool) Store {
+	cms.metrics = metrics
+	return cms
+}
+
+// MetricsEnabled returns if the metrics of the operations are emitted for a
+// specific KVStore.
+func (cms Store) MetricsEnabled(key types.StoreKey) bool {
+	return cms.metrics[key.Name()]
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -0,0 +1,103 @@
+package history
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The history DB holds the following records, the store names and keys being encoded as key segments:
+//
+//	versionPrefix | version                   => empty: the saved versions
+//	changePrefix | store | key | version      => valueSet | value, or valueDeleted: the changes of a key
+//	changesetPrefix | version | store | key   => empty: the keys changed at a version
+//
+// The changes of a key are sorted by version, so its value at a version is the one of the latest change at or
+// before the version. The changesets index the changes by version, so that the versions can be truncated.
+var (
+	versionPrefix   = []byte{0x00}
+	changePrefix    = []byte{0x01}
+	changesetPrefix = []byte{0x02}
+)
+
+const (
+	valueDeleted byte = iota
+	valueSet
+)
+
+// appendSegment appends the order-preserving encoding of bz to dst. Zero bytes are escaped as 0x00 0xff and
+// the segment is terminated by 0x00 0x01, so encoded segments sort like the raw ones and no encoded segment
+// is a prefix of another.
+func appendSegment(dst, bz []byte) []byte {
+	for _, b := range bz {
+		if b == 0 {
+			dst = append(dst, 0, 0xff)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+	return append(dst, 0, 1)
+}
+
+// readSegment decodes the segment at the start of bz, and returns it along with the rest of bz.
+func readSegment(bz []byte) ([]byte, []byte, error) {
+	segment := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0 {
+			segment = append(segment, bz[i])
+			continue
+		}
+		if i+1 == len(bz) {
+			break
+		}
+		switch bz[i+1] {
+		case 0xff:
+			segment = append(segment, 0)
+			i++
+		case 0x01:
+			return segment, bz[i+2:], nil
+		default:
+			return nil, nil, fmt.Errorf("invalid history key segment %X", bz)
+		}
+	}
+	return nil, nil, fmt.Errorf("unterminated history key segment %X", bz)
+}
+
+func versionKey(version uint64) []byte {
+	return appendUint64(append([]byte{}, versionPrefix...), version)
+}
+
+// storePrefix returns the prefix of the changes of a store.
+func storePrefix(name string) []byte {
+	return appendSegment(append([]byte{}, changePrefix...), []byte(name))
+}
+
+// keyPrefix returns the prefix of the changes of a key of a store.
+func keyPrefix(name string, key []byte) []byte {
+	return appendSegment(storePrefix(name), key)
+}
+
+func changeKey(name string, key []byte, version uint64) []byte {
+	return appendUint64(keyPrefix(name, key), version)
+}
+
+func changesetKey(version uint64, name string, key []byte) []byte {
+	bz := appendUint64(append([]byte{}, changesetPrefix...), version)
+	return appendSegment(appendSegment(bz, []byte(name)), key)
+}
+
+// parseChangesetKey returns the change key indexed by a changeset key, and its version.
+func parseChangesetKey(bz []byte) ([]byte, uint64, error) {
+	if len(bz) < len(changesetPrefix)+8 {
+		return nil, 0, fmt.Errorf("invalid history changeset key %X", bz)
+	}
+	bz = bz[len(changesetPrefix):]
+	version := binary.BigEndian.Uint64(bz)
+	change := append(append([]byte{}, changePrefix...), bz[8:]...)
+	return appendUint64(change, version), version, nil
+}
+
+func appendUint64(dst []byte, v uint64) []byte {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], v)
+	return append(dst, bz[:]...)
+}
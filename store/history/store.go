@@ -0,0 +1,295 @@
+package history
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	cosmosdb "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// syncBatchSize is the number of changes written per batch when syncing the history with the stores.
+const syncBatchSize = 10000
+
+// Store is a versioned history DB of the persistent stores of a multistore. It is fed with the changeset of
+// each version through the write listeners of the multistore, and serves read-only views of the stores at
+// any saved version, regardless of the versions retained by the stores themselves.
+type Store struct {
+	db dbm.DB
+
+	mtx       sync.RWMutex
+	versions  *cosmosdb.VersionManager
+	changeset map[string]map[string][]byte
+}
+
+var _ types.WriteListener = (*Store)(nil)
+
+// NewStore returns a history store backed by db.
+func NewStore(db dbm.DB) (*Store, error) {
+	itr, err := db.Iterator(versionPrefix, types.PrefixEndBytes(versionPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var versions []uint64
+	for ; itr.Valid(); itr.Next() {
+		versions = append(versions, binary.BigEndian.Uint64(itr.Key()[len(versionPrefix):]))
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+	return &Store{
+		db:        db,
+		versions:  cosmosdb.NewVersionManager(versions),
+		changeset: make(map[string]map[string][]byte),
+	}, nil
+}
+
+// Versions returns the saved versions.
+func (s *Store) Versions() cosmosdb.VersionSet {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.versions.Copy()
+}
+
+// LastVersion returns the last saved version, or 0 if none.
+func (s *Store) LastVersion() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return int64(s.versions.Last())
+}
+
+// VersionExists returns true if the version is saved.
+func (s *Store) VersionExists(version int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return version > 0 && s.versions.Exists(uint64(version))
+}
+
+// OnWrite implements types.WriteListener. It adds the write to the changeset of the next version.
+func (s *Store) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	changes, ok := s.changeset[storeKey.Name()]
+	if !ok {
+		changes = make(map[string][]byte)
+		s.changeset[storeKey.Name()] = changes
+	}
+	if delete {
+		changes[string(key)] = nil
+	} else {
+		changes[string(key)] = append([]byte{}, value...)
+	}
+	return nil
+}
+
+// Commit saves the changeset written since the last commit as the given version, which must be greater than
+// the last saved version.
+func (s *Store) Commit(version int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if version <= int64(s.versions.Last()) {
+		return fmt.Errorf("cannot commit history version %d, the last saved version is %d", version, s.versions.Last())
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for name, changes := range s.changeset {
+		for key, value := range changes {
+			if err := setChange(batch, uint64(version), name, []byte(key), value); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.saveVersion(batch, uint64(version)); err != nil {
+		return err
+	}
+	s.changeset = make(map[string]map[string][]byte)
+	return nil
+}
+
+// Sync saves the state of the stores as the given version, which must be greater than the last saved version,
+// as its difference with the state at the last saved version. It starts the history of stores which already
+// have a state, and catches up with stores which were changed without the history, e.g. restored from a
+// snapshot. The versions in between are not saved.
+func (s *Store) Sync(version int64, stores map[string]types.KVStore) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	last := s.versions.Last()
+	if version <= int64(last) {
+		return fmt.Errorf("cannot sync history version %d, the last saved version is %d", version, last)
+	}
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+	size := 0
+	for name, store := range stores {
+		err := diffStore(s.view(name, last), store, func(key, value []byte) error {
+			if err := setChange(batch, uint64(version), name, key, value); err != nil {
+				return err
+			}
+			if size++; size%syncBatchSize == 0 {
+				if err := batch.Write(); err != nil {
+					return err
+				}
+				batch.Close()
+				batch = s.db.NewBatch()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.saveVersion(batch, uint64(version)); err != nil {
+		return err
+	}
+	s.changeset = make(map[string]map[string][]byte)
+	return nil
+}
+
+// Truncate deletes the saved versions greater than the given version, along with their changesets, and
+// discards the changeset written since the last commit.
+func (s *Store) Truncate(version int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if version < 0 {
+		version = 0
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	start := uint64(version) + 1
+
+	itr, err := s.db.Iterator(appendUint64(append([]byte{}, changesetPrefix...), start), types.PrefixEndBytes(changesetPrefix))
+	if err != nil {
+		return err
+	}
+	for ; itr.Valid(); itr.Next() {
+		change, _, err := parseChangesetKey(itr.Key())
+		if err == nil {
+			err = batch.Delete(change)
+		}
+		if err == nil {
+			err = batch.Delete(itr.Key())
+		}
+		if err != nil {
+			itr.Close()
+			return err
+		}
+	}
+	if err := itr.Error(); err != nil {
+		itr.Close()
+		return err
+	}
+	itr.Close()
+
+	var truncated []uint64
+	for it := s.versions.Iterator(); it.Next(); {
+		if it.Value() >= start {
+			truncated = append(truncated, it.Value())
+		}
+	}
+	for _, v := range truncated {
+		if err := batch.Delete(versionKey(v)); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	for _, v := range truncated {
+		s.versions.Delete(v)
+	}
+	s.changeset = make(map[string]map[string][]byte)
+	return nil
+}
+
+// StoreAt returns a read-only view of the named store at a saved version.
+func (s *Store) StoreAt(name string, version int64) (*ViewStore, error) {
+	if !s.VersionExists(version) {
+		return nil, fmt.Errorf("%w: history version %d", cosmosdb.ErrVersionDoesNotExist, version)
+	}
+	return s.view(name, uint64(version)), nil
+}
+
+// Close closes the history DB.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) view(name string, version uint64) *ViewStore {
+	return &ViewStore{db: s.db, name: name, version: version}
+}
+
+// saveVersion writes the batch along with the record of the version, and adds the version to the saved ones.
+func (s *Store) saveVersion(batch dbm.Batch, version uint64) error {
+	if err := batch.Set(versionKey(version), []byte{}); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	_, err := s.versions.Save(version)
+	return err
+}
+
+// setChange adds the change of a key at a version to the batch. A nil value deletes the key.
+func setChange(batch dbm.Batch, version uint64, name string, key, value []byte) error {
+	entry := []byte{valueDeleted}
+	if value != nil {
+		entry = append([]byte{valueSet}, value...)
+	}
+	if err := batch.Set(changeKey(name, key, version), entry); err != nil {
+		return err
+	}
+	return batch.Set(changesetKey(version, name, key), []byte{})
+}
+
+// diffStore calls write with the changes turning the state of the history view into the one of the store,
+// a nil value deleting a key.
+func diffStore(view *ViewStore, store types.KVStore, write func(key, value []byte) error) error {
+	historyItr := view.Iterator(nil, nil)
+	defer historyItr.Close()
+	storeItr := store.Iterator(nil, nil)
+	defer storeItr.Close()
+
+	for historyItr.Valid() || storeItr.Valid() {
+		cmp := -1
+		switch {
+		case !historyItr.Valid():
+			cmp = 1
+		case storeItr.Valid():
+			cmp = bytes.Compare(historyItr.Key(), storeItr.Key())
+		}
+
+		var err error
+		switch {
+		case cmp < 0:
+			err = write(historyItr.Key(), nil)
+			historyItr.Next()
+		case cmp > 0:
+			err = write(storeItr.Key(), storeItr.Value())
+			storeItr.Next()
+		default:
+			if !bytes.Equal(historyItr.Value(), storeItr.Value()) {
+				err = write(storeItr.Key(), storeItr.Value())
+			}
+			historyItr.Next()
+			storeItr.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := historyItr.Error(); err != nil {
+		return err
+	}
+	return storeItr.Error()
+}
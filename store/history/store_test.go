@@ -0,0 +1,144 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	cosmosdb "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/history"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store1\x00")
+)
+
+func write(t *testing.T, store *history.Store, storeKey types.StoreKey, key, value string) {
+	require.NoError(t, store.OnWrite(storeKey, []byte(key), []byte(value), false))
+}
+
+func remove(t *testing.T, store *history.Store, storeKey types.StoreKey, key string) {
+	require.NoError(t, store.OnWrite(storeKey, []byte(key), nil, true))
+}
+
+func readAll(t *testing.T, view types.KVStore, reverse bool) map[string]string {
+	return readRange(t, view, nil, nil, reverse)
+}
+
+func readRange(t *testing.T, view types.KVStore, start, end []byte, reverse bool) map[string]string {
+	var itr types.Iterator
+	if reverse {
+		itr = view.ReverseIterator(start, end)
+	} else {
+		itr = view.Iterator(start, end)
+	}
+	defer itr.Close()
+
+	pairs := map[string]string{}
+	var last []byte
+	for ; itr.Valid(); itr.Next() {
+		if last != nil {
+			require.Equal(t, reverse, string(itr.Key()) < string(last), "keys out of order")
+		}
+		last = itr.Key()
+		pairs[string(itr.Key())] = string(itr.Value())
+	}
+	require.NoError(t, itr.Error())
+	return pairs
+}
+
+func requireState(t *testing.T, store *history.Store, name string, version int64, expected map[string]string) {
+	view, err := store.StoreAt(name, version)
+	require.NoError(t, err)
+	for key, value := range expected {
+		require.Equal(t, []byte(value), view.Get([]byte(key)), "key %q at version %d", key, version)
+	}
+	require.Equal(t, expected, readAll(t, view, false))
+	require.Equal(t, expected, readAll(t, view, true))
+}
+
+func TestStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := history.NewStore(db)
+	require.NoError(t, err)
+
+	write(t, store, storeKey1, "a", "1")
+	write(t, store, storeKey1, "a\x00", "2")
+	write(t, store, storeKey1, "b", "3")
+	write(t, store, storeKey2, "a", "4")
+	require.NoError(t, store.Commit(1))
+
+	require.NoError(t, store.Commit(2))
+
+	write(t, store, storeKey1, "a", "5")
+	remove(t, store, storeKey1, "a\x00")
+	write(t, store, storeKey1, "c", "6")
+	write(t, store, storeKey1, "c", "7")
+	require.NoError(t, store.Commit(3))
+	require.Error(t, store.Commit(3))
+
+	remove(t, store, storeKey1, "b")
+	require.NoError(t, store.Commit(5))
+
+	state1 := map[string]string{"a": "1", "a\x00": "2", "b": "3"}
+	state3 := map[string]string{"a": "5", "b": "3", "c": "7"}
+	state5 := map[string]string{"a": "5", "c": "7"}
+	requireState(t, store, storeKey1.Name(), 1, state1)
+	requireState(t, store, storeKey1.Name(), 2, state1)
+	requireState(t, store, storeKey1.Name(), 3, state3)
+	requireState(t, store, storeKey1.Name(), 5, state5)
+	requireState(t, store, storeKey2.Name(), 5, map[string]string{"a": "4"})
+
+	_, err = store.StoreAt(storeKey1.Name(), 4)
+	require.ErrorIs(t, err, cosmosdb.ErrVersionDoesNotExist)
+	_, err = store.StoreAt(storeKey1.Name(), 6)
+	require.ErrorIs(t, err, cosmosdb.ErrVersionDoesNotExist)
+
+	view, err := store.StoreAt(storeKey1.Name(), 3)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "5", "b": "3"}, readRange(t, view, nil, []byte("c"), false))
+	require.Equal(t, map[string]string{"b": "3", "c": "7"}, readRange(t, view, []byte("a\x00"), nil, true))
+	require.Panics(t, func() { view.Set([]byte("a"), []byte("1")) })
+
+	// the versions are loaded from the DB
+	store, err = history.NewStore(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, store.LastVersion())
+	require.Equal(t, 4, store.Versions().Count())
+	requireState(t, store, storeKey1.Name(), 3, state3)
+
+	// truncated versions are deleted along with their changes
+	write(t, store, storeKey1, "d", "8")
+	require.NoError(t, store.Truncate(2))
+	require.EqualValues(t, 2, store.LastVersion())
+	require.False(t, store.VersionExists(3))
+	require.NoError(t, store.Commit(3))
+	requireState(t, store, storeKey1.Name(), 3, state1)
+}
+
+func TestStoreSync(t *testing.T) {
+	store, err := history.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	kvStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	kvStore.Set([]byte("a"), []byte("1"))
+	kvStore.Set([]byte("b"), []byte("2"))
+	stores := map[string]types.KVStore{storeKey1.Name(): kvStore}
+	require.NoError(t, store.Sync(4, stores))
+	requireState(t, store, storeKey1.Name(), 4, map[string]string{"a": "1", "b": "2"})
+	require.False(t, store.VersionExists(3))
+
+	// the changes made without the history are saved as a single version
+	kvStore.Delete([]byte("a"))
+	kvStore.Set([]byte("b"), []byte("3"))
+	kvStore.Set([]byte("c"), []byte("4"))
+	require.Error(t, store.Sync(4, stores))
+	require.NoError(t, store.Sync(8, stores))
+	requireState(t, store, storeKey1.Name(), 4, map[string]string{"a": "1", "b": "2"})
+	requireState(t, store, storeKey1.Name(), 8, map[string]string{"b": "3", "c": "4"})
+	require.Equal(t, 2, store.Versions().Count())
+}
@@ -0,0 +1,280 @@
+package history
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var errKeyEmpty = errors.New("key cannot be empty")
+
+var (
+	_ types.KVStore   = (*ViewStore)(nil)
+	_ types.Queryable = (*ViewStore)(nil)
+)
+
+// ViewStore is a read-only view of a store of the history at a saved version.
+type ViewStore struct {
+	db      dbm.DB
+	name    string
+	version uint64
+}
+
+// Get implements KVStore. It returns the value of the latest change of the key at or before the version.
+func (s *ViewStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	itr, err := s.db.ReverseIterator(keyPrefix(s.name, key), changeKey(s.name, key, s.version+1))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	if !itr.Valid() {
+		return nil
+	}
+	entry := itr.Value()
+	if entry[0] == valueDeleted {
+		return nil
+	}
+	return append([]byte{}, entry[1:]...)
+}
+
+// Has implements KVStore.
+func (s *ViewStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore. It panics as the view is read-only.
+func (s *ViewStore) Set(key, value []byte) {
+	panic("cannot set on a history view store")
+}
+
+// Delete implements KVStore. It panics as the view is read-only.
+func (s *ViewStore) Delete(key []byte) {
+	panic("cannot delete on a history view store")
+}
+
+// Iterator implements KVStore.
+func (s *ViewStore) Iterator(start, end []byte) types.Iterator {
+	return newIterator(s, start, end, false)
+}
+
+// ReverseIterator implements KVStore.
+func (s *ViewStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newIterator(s, start, end, true)
+}
+
+// GetStoreType implements Store.
+func (s *ViewStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements KVStore.
+func (s *ViewStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s *ViewStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *ViewStore) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// Query implements Queryable. It serves the "/key" and "/subspace" queries of an IAVL store at the version
+// of the view, without proofs.
+func (s *ViewStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
+	}
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "history queries cannot be proven"), false)
+	}
+	res.Height = int64(s.version)
+
+	switch req.Path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = s.Get(req.Data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		res.Key = req.Data
+		iterator := types.KVStorePrefixIterator(s, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
+	}
+	return res
+}
+
+// iterator iterates over the keys of a history view store. The changes of each key are read in order, and the
+// key is yielded with the value of its latest change at or before the version of the view, if it was set.
+type iterator struct {
+	source  dbm.Iterator
+	prefix  int
+	version uint64
+	reverse bool
+
+	start, end []byte
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func newIterator(s *ViewStore, start, end []byte, reverse bool) *iterator {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		panic(errKeyEmpty)
+	}
+	prefix := storePrefix(s.name)
+	lower, upper := prefix, types.PrefixEndBytes(prefix)
+	if start != nil {
+		lower = appendSegment(append([]byte{}, prefix...), start)
+	}
+	if end != nil {
+		upper = appendSegment(append([]byte{}, prefix...), end)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = s.db.ReverseIterator(lower, upper)
+	} else {
+		source, err = s.db.Iterator(lower, upper)
+	}
+	if err != nil {
+		panic(err)
+	}
+	it := &iterator{
+		source:  source,
+		prefix:  len(prefix),
+		version: s.version,
+		reverse: reverse,
+		start:   start,
+		end:     end,
+	}
+	it.advance()
+	return it
+}
+
+// parse returns the key and version of a change key of the store.
+func (it *iterator) parse(bz []byte) ([]byte, uint64, error) {
+	key, rest, err := readSegment(bz[it.prefix:])
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rest) != 8 {
+		return nil, 0, fmt.Errorf("invalid history change key %X", bz)
+	}
+	return key, binary.BigEndian.Uint64(rest), nil
+}
+
+// advance moves the iterator to the next key which was set at the version of the view.
+func (it *iterator) advance() {
+	it.valid = false
+	for it.source.Valid() {
+		key, _, err := it.parse(it.source.Key())
+		if err != nil {
+			it.err = err
+			return
+		}
+
+		// the changes are sorted by ascending versions, or descending ones in reverse
+		var entry []byte
+		for ; it.source.Valid(); it.source.Next() {
+			k, version, err := it.parse(it.source.Key())
+			if err != nil {
+				it.err = err
+				return
+			}
+			if !bytes.Equal(k, key) {
+				break
+			}
+			if version <= it.version && (entry == nil || !it.reverse) {
+				entry = append([]byte{}, it.source.Value()...)
+			}
+		}
+		if entry != nil && entry[0] == valueSet {
+			it.key, it.value, it.valid = key, entry[1:], true
+			return
+		}
+	}
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() {
+	it.assertValid()
+	it.advance()
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	it.assertValid()
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	it.assertValid()
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.source.Error()
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
+
+func (it *iterator) assertValid() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+}
@@ -0,0 +1,208 @@
+package metrickv
+
+import (
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Labels of the metrics of the operations.
+const (
+	LabelStore  = "store"
+	LabelPrefix = "prefix"
+	LabelMsg    = "msg"
+)
+
+// labelNone is the value of the labels which do not apply to an operation,
+// e.g. the message type of an operation outside of any message. The labels of a
+// metric are always the same, as required by Prometheus.
+const labelNone = "none"
+
+var _ types.KVStore = &Store{}
+
+// Store emits telemetry metrics of the operations of an underlying KVStore:
+// the latency of each operation, and the number of bytes read and written.
+// The metrics are labelled by store name, message type and key prefix, the
+// first byte of the key. It implements the KVStore interface.
+type Store struct {
+	parent types.KVStore
+	store  metrics.Label
+	msg    metrics.Label
+}
+
+// NewStore returns a reference to a new MetricKVStore given a parent KVStore
+// implementation, the name of the store, and the type URL of the message
+// executed with the store, if any.
+func NewStore(parent types.KVStore, storeName, msgTypeURL string) *Store {
+	if msgTypeURL == "" {
+		msgTypeURL = labelNone
+	}
+
+	return &Store{
+		parent: parent,
+		store:  telemetry.NewLabel(LabelStore, storeName),
+		msg:    telemetry.NewLabel(LabelMsg, msgTypeURL),
+	}
+}
+
+// labels returns the labels of an operation on the given key.
+func (s *Store) labels(key []byte) []metrics.Label {
+	prefix := labelNone
+	if len(key) > 0 {
+		prefix = hex.EncodeToString(key[:1])
+	}
+	return []metrics.Label{s.store, s.msg, telemetry.NewLabel(LabelPrefix, prefix)}
+}
+
+// measure emits the latency of an operation and the number of bytes it read or
+// wrote, if any.
+func (s *Store) measure(op string, start time.Time, key []byte, size int) {
+	labels := s.labels(key)
+	telemetry.MeasureSinceWithLabels([]string{"store", "kv", op}, start, labels)
+	if size > 0 {
+		telemetry.IncrCounterWithLabels([]string{"store", "kv", op, "bytes"}, float32(size), labels)
+	}
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface. It measures a read operation.
+func (s *Store) Get(key []byte) []byte {
+	start := time.Now()
+	value := s.parent.Get(key)
+	s.measure("get", start, key, len(value))
+
+	return value
+}
+
+// Set implements the KVStore interface. It measures a write operation.
+func (s *Store) Set(key []byte, value []byte) {
+	start := time.Now()
+	s.parent.Set(key, value)
+	s.measure("set", start, key, len(key)+len(value))
+}
+
+// Delete implements the KVStore interface. It measures a delete operation.
+func (s *Store) Delete(key []byte) {
+	start := time.Now()
+	s.parent.Delete(key)
+	s.measure("delete", start, key, 0)
+}
+
+// Has implements the KVStore interface. It measures a has operation.
+func (s *Store) Has(key []byte) bool {
+	start := time.Now()
+	has := s.parent.Has(key)
+	s.measure("has", start, key, 0)
+
+	return has
+}
+
+// Iterator implements the KVStore interface. It measures the creation of the
+// iterator, and the entries read with it once it is closed.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface. It measures the creation
+// of the iterator, and the entries read with it once it is closed.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	var parent types.Iterator
+
+	now := time.Now()
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+	s.measure("iterator", now, start, 0)
+
+	return &metricIterator{parent: parent, labels: s.labels(start)}
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a MetricKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a MetricKVStore")
+}
+
+// CacheWrapWithListeners implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a MetricKVStore")
+}
+
+// metricIterator counts the entries read with an iterator, and the number of
+// bytes of their keys and values.
+type metricIterator struct {
+	parent types.Iterator
+	labels []metrics.Label
+
+	entries int
+	size    int
+}
+
+// Domain implements the Iterator interface.
+func (mi *metricIterator) Domain() (start []byte, end []byte) {
+	return mi.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (mi *metricIterator) Valid() bool {
+	return mi.parent.Valid()
+}
+
+// Next implements the Iterator interface.
+func (mi *metricIterator) Next() {
+	mi.entries++
+	mi.parent.Next()
+}
+
+// Key implements the Iterator interface.
+func (mi *metricIterator) Key() []byte {
+	key := mi.parent.Key()
+	mi.size += len(key)
+	return key
+}
+
+// Value implements the Iterator interface.
+func (mi *metricIterator) Value() []byte {
+	value := mi.parent.Value()
+	mi.size += len(value)
+	return value
+}
+
+// Close implements the Iterator interface. It emits the number of entries
+// iterated over and of bytes read.
+func (mi *metricIterator) Close() error {
+	telemetry.IncrCounterWithLabels([]string{"store", "kv", "iterator", "entries"}, float32(mi.entries), mi.labels)
+	if mi.size > 0 {
+		telemetry.IncrCounterWithLabels([]string{"store", "kv", "iterator", "bytes"}, float32(mi.size), mi.labels)
+	}
+
+	return mi.parent.Close()
+}
+
+// Error implements the Iterator interface.
+func (mi *metricIterator) Error() error {
+	return mi.parent.Error()
+}
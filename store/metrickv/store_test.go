@@ -0,0 +1,93 @@
+package metrickv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/metrickv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// newInmemSink sets an in-memory sink as the global metrics sink.
+func newInmemSink(t *testing.T) *metrics.InmemSink {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	return sink
+}
+
+func newMetricKVStore(msgTypeURL string) *metrickv.Store {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte{0x01, 'a'}, []byte("value"))
+	parent.Set([]byte{0x01, 'b'}, []byte("value"))
+	parent.Set([]byte{0x02, 'a'}, []byte("value"))
+
+	return metrickv.NewStore(parent, "bank", msgTypeURL)
+}
+
+func TestMetricKVStore(t *testing.T) {
+	sink := newInmemSink(t)
+	store := newMetricKVStore("/cosmos.bank.v1beta1.MsgSend")
+
+	require.Equal(t, []byte("value"), store.Get([]byte{0x01, 'a'}))
+	require.Nil(t, store.Get([]byte{0x02, 'b'}))
+	require.True(t, store.Has([]byte{0x02, 'a'}))
+	store.Set([]byte{0x03, 'a'}, []byte("value"))
+	store.Delete([]byte{0x03, 'a'})
+
+	iter := store.Iterator([]byte{0x01}, []byte{0x02})
+	for ; iter.Valid(); iter.Next() {
+		iter.Key()
+		iter.Value()
+	}
+	require.NoError(t, iter.Close())
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+	labels := ";store=bank;msg=/cosmos.bank.v1beta1.MsgSend;prefix="
+
+	samples := data[0].Samples
+	require.Equal(t, 2, samples["store.kv.get"+labels+"01"].Count+samples["store.kv.get"+labels+"02"].Count)
+	require.Equal(t, 1, samples["store.kv.has"+labels+"02"].Count)
+	require.Equal(t, 1, samples["store.kv.set"+labels+"03"].Count)
+	require.Equal(t, 1, samples["store.kv.delete"+labels+"03"].Count)
+	require.Equal(t, 1, samples["store.kv.iterator"+labels+"01"].Count)
+
+	counters := data[0].Counters
+	// only the bytes of the values found are read
+	require.Equal(t, float64(5), counters["store.kv.get.bytes"+labels+"01"].Sum)
+	require.NotContains(t, counters, "store.kv.get.bytes"+labels+"02")
+	require.Equal(t, float64(7), counters["store.kv.set.bytes"+labels+"03"].Sum)
+	require.Equal(t, float64(2), counters["store.kv.iterator.entries"+labels+"01"].Sum)
+	require.Equal(t, float64(14), counters["store.kv.iterator.bytes"+labels+"01"].Sum)
+}
+
+func TestMetricKVStoreNoneLabels(t *testing.T) {
+	sink := newInmemSink(t)
+	store := newMetricKVStore("")
+
+	iter := store.ReverseIterator(nil, nil)
+	require.NoError(t, iter.Close())
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+	require.Equal(t, 1, data[0].Samples["store.kv.iterator;store=bank;msg=none;prefix=none"].Count)
+	require.Equal(t, float64(0), data[0].Counters["store.kv.iterator.entries;store=bank;msg=none;prefix=none"].Sum)
+}
+
+func TestMetricKVStoreCacheWrap(t *testing.T) {
+	store := newMetricKVStore("")
+	require.Panics(t, func() { store.CacheWrap() })
+	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
+	require.Panics(t, func() { store.CacheWrapWithListeners(nil, nil) })
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
@@ -224,6 +224,115 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotParallelExport(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 1000)
+	version := uint64(source.LastCommitID().Version)
+
+	// the snapshots are the same whatever the number of export workers
+	for _, format := range []uint32{snapshottypes.CurrentFormat, snapshottypes.ZstdFormat} {
+		var hash []byte
+		for _, workers := range []uint32{0, 2, 8} {
+			snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+			require.NoError(t, err)
+			opts := snapshottypes.NewSnapshotOptions(1, 1)
+			opts.Format = format
+			opts.ExportWorkers = workers
+			manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+			snapshot, err := manager.Create(version)
+			require.NoError(t, err)
+			require.Equal(t, format, snapshot.Format)
+			if hash == nil {
+				hash = snapshot.Hash
+			}
+			assert.Equal(t, hash, snapshot.Hash, "format %v with %v workers", format, workers)
+		}
+	}
+}
+
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	for _, format := range []uint32{snapshottypes.CurrentFormat, snapshottypes.ZstdFormat} {
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			testMultistoreSnapshotRestoreDelta(t, format)
+		})
+	}
+}
+
+func testMultistoreSnapshotRestoreDelta(t *testing.T, format uint32) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 1000)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	opts := snapshottypes.NewSnapshotOptions(1, 3)
+	opts.MaxDeltas = 2
+	opts.Format = format
+	opts.ExportWorkers = 2
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	full, err := manager.Create(uint64(source.LastCommitID().Version))
+	require.NoError(t, err)
+	require.Equal(t, format, full.Format)
+
+	// change a few keys of some stores, and take delta snapshots
+	var snapshot *snapshottypes.Snapshot
+	for i := 0; i < 2; i++ {
+		store0 := source.GetStoreByName("store0").(types.CommitKVStore)
+		store0.Set([]byte{0, 0, 0, 0, 0, 0, 1, byte(i)}, []byte{byte(i)})
+		store0.Delete([]byte{0, 0, 0, 0, 0, 0, 2, byte(i)})
+		store2 := source.GetStoreByName("store2").(types.CommitKVStore)
+		store2.Set([]byte("new"), []byte{byte(i)})
+		source.Commit()
+
+		snapshot, err = manager.Create(uint64(source.LastCommitID().Version))
+		require.NoError(t, err)
+		require.Equal(t, snapshottypes.DeltaFormatOf(format), snapshot.Format)
+	}
+
+	// only the changed nodes are stored by delta snapshots
+	size := func(height uint64, format uint32) int {
+		_, chunks, err := snapshotStore.Load(height, format)
+		require.NoError(t, err)
+		total := 0
+		for chunk := range chunks {
+			bz, err := io.ReadAll(chunk)
+			require.NoError(t, err)
+			total += len(bz)
+		}
+		return total
+	}
+	assert.Less(t, size(snapshot.Height, snapshot.Format)*20, size(full.Height, full.Format))
+
+	// restore the advertised chain into an empty store
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Height, list[0].Height)
+	require.Len(t, list[0].Metadata.Chain, 3)
+
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, opts, target, nil, log.NewNopLogger())
+
+	require.NoError(t, targetManager.Restore(*list[0]))
+	for i := uint32(0); i < list[0].Chunks; i++ {
+		chunk, err := manager.LoadChunk(list[0].Height, list[0].Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == list[0].Chunks-1, done)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
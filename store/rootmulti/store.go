@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/armon/go-metrics"
 	iavltree "github.com/cosmos/iavl"
 	protoio "github.com/gogo/protobuf/io"
 	gogotypes "github.com/gogo/protobuf/types"
@@ -21,18 +22,22 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/history"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/metrickv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	latestVersionKey = "s/latest"
-	commitInfoKeyFmt = "s/%d" // s/<version>
+	latestVersionKey   = "s/latest"
+	commitInfoKeyFmt   = "s/%d"          // s/<version>
+	storePruningPrefix = "s/pruning/%s/" // s/pruning/<store name>/
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -43,13 +48,17 @@ type Store struct {
 	logger         log.Logger
 	lastCommitInfo *types.CommitInfo
 	pruningManager *pruning.Manager
-	iavlCacheSize  int
-	storesParams   map[types.StoreKey]storeParams
-	stores         map[types.StoreKey]types.CommitKVStore
-	keysByName     map[string]types.StoreKey
-	lazyLoading    bool
-	initialVersion int64
-	removalMap     map[types.StoreKey]bool
+	// storePruning holds the pruning managers of the IAVL stores with a pruning strategy of their own,
+	// by store name
+	storePruning     map[string]*pruning.Manager
+	snapshotInterval uint64
+	iavlCacheSize    int
+	storesParams     map[types.StoreKey]storeParams
+	stores           map[types.StoreKey]types.CommitKVStore
+	keysByName       map[string]types.StoreKey
+	lazyLoading      bool
+	initialVersion   int64
+	removalMap       map[types.StoreKey]bool
 
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
@@ -58,11 +67,20 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	// metrics holds the names of the stores whose operations emit metrics, and iavlStats the statistics
+	// of the node caches of those which are IAVL stores
+	metrics   map[string]bool
+	iavlStats map[types.StoreKey]*iavltree.Statistics
+
+	history *history.Store
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore         = (*Store)(nil)
+	_ types.Queryable                = (*Store)(nil)
+	_ snapshottypes.StoreSnapshotter = (*Store)(nil)
+	_ types.MetricsMultiStore        = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -80,6 +98,9 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		listeners:      make(map[types.StoreKey][]types.WriteListener),
 		removalMap:     make(map[types.StoreKey]bool),
 		pruningManager: pruning.NewManager(db, logger),
+		storePruning:   make(map[string]*pruning.Manager),
+		metrics:        make(map[string]bool),
+		iavlStats:      make(map[types.StoreKey]*iavltree.Statistics),
 	}
 }
 
@@ -95,16 +116,52 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// GetStorePruning fetches the pruning strategy of the store with the given key name, which is the one
+// of the root store unless the store has a pruning strategy of its own.
+func (rs *Store) GetStorePruning(name string) pruningtypes.PruningOptions {
+	if manager, ok := rs.storePruning[name]; ok {
+		return manager.GetOptions()
+	}
+	return rs.pruningManager.GetOptions()
+}
+
+// SetStorePruning sets the pruning strategy of the IAVL store mounted with the given key name, in place
+// of the one of the root store. The heights to prune of the store are persisted separately from the
+// ones of the root store. It must be called before loading a version.
+func (rs *Store) SetStorePruning(name string, pruningOpts pruningtypes.PruningOptions) {
+	manager, ok := rs.storePruning[name]
+	if !ok {
+		manager = pruning.NewManager(rs.storePruningDB(name), rs.logger)
+		manager.SetSnapshotInterval(rs.snapshotInterval)
+		rs.storePruning[name] = manager
+	}
+	manager.SetOptions(pruningOpts)
+}
+
+func (rs *Store) storePruningDB(name string) dbm.DB {
+	return dbm.NewPrefixDB(rs.db, []byte(fmt.Sprintf(storePruningPrefix, name)))
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
+	rs.snapshotInterval = snapshotInterval
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
+	for _, manager := range rs.storePruning {
+		manager.SetSnapshotInterval(snapshotInterval)
+	}
 }
 
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
 
+// SetHistory sets the history DB saving the changesets of the IAVL stores at each version, which serves
+// the queries at the versions pruned from the IAVL stores. It must be called before loading a version.
+func (rs *Store) SetHistory(history *history.Store) {
+	rs.history = history
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -201,6 +258,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	for name := range rs.metrics {
+		if _, ok := rs.keysByName[name]; !ok {
+			return fmt.Errorf("cannot enable the metrics of %s, which is not a mounted store", name)
+		}
+	}
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	newStores := make(map[types.StoreKey]types.CommitKVStore)
 
@@ -268,11 +331,59 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
+	if rs.history != nil {
+		if err := rs.loadHistory(ver); err != nil {
+			return errors.Wrap(err, "failed to load state history")
+		}
+	}
+
 	// load any pruned heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadPruningHeights(rs.db); err != nil {
 		return err
 	}
+	for name, manager := range rs.storePruning {
+		key, ok := rs.keysByName[name]
+		if !ok || rs.storesParams[key].typ != types.StoreTypeIAVL {
+			return fmt.Errorf("cannot set the pruning strategy of %s, which is not a mounted IAVL store", name)
+		}
+		if err := manager.LoadPruningHeights(rs.storePruningDB(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadHistory registers the history as a write listener of the IAVL stores, and brings it in line with the
+// loaded version: the versions saved after it, e.g. by a commit which didn't complete, are truncated, and the
+// state of the stores is saved as the loaded version if the history is behind it.
+func (rs *Store) loadHistory(ver int64) error {
+	stores := make(map[string]types.KVStore)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		stores[key.Name()] = store
+
+		registered := false
+		for _, listener := range rs.listeners[key] {
+			registered = registered || listener == types.WriteListener(rs.history)
+		}
+		if !registered {
+			rs.AddListeners(key, []types.WriteListener{rs.history})
+		}
+	}
 
+	if last := rs.history.LastVersion(); last > ver {
+		rs.logger.Info("truncating state history", "last", last, "version", ver)
+		if err := rs.history.Truncate(ver); err != nil {
+			return err
+		}
+	}
+	if last := rs.history.LastVersion(); last < ver {
+		rs.logger.Info("syncing state history", "last", last, "version", ver)
+		return rs.history.Sync(ver, stores)
+	}
 	return nil
 }
 
@@ -321,6 +432,9 @@ func moveKVStoreData(oldDB types.KVStore, newDB types.KVStore) error {
 // less than <current height> - KeepRecent and <current height> % Interval == 0
 func (rs *Store) PruneSnapshotHeight(height int64) {
 	rs.pruningManager.HandleHeightSnapshot(height)
+	for _, manager := range rs.storePruning {
+		manager.HandleHeightSnapshot(height)
+	}
 }
 
 // SetInterBlockCache sets the Store's internal inter-block (persistent) cache.
@@ -387,6 +501,18 @@ func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 	return false
 }
 
+// EnableMetrics enables the metrics of the store mounted with the given key name: the operations on
+// the KVStore emit metrics labelled by store name, and the hits and misses of the node cache of an IAVL
+// store are emitted on commit. It must be called before loading a version.
+func (rs *Store) EnableMetrics(name string) {
+	rs.metrics[name] = true
+}
+
+// MetricsEnabled returns if the metrics of the operations are emitted for a specific KVStore.
+func (rs *Store) MetricsEnabled(key types.StoreKey) bool {
+	return rs.metrics[key.Name()]
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -417,6 +543,14 @@ func (rs *Store) Commit() types.CommitID {
 
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+	rs.emitIAVLMetrics()
+
+	// the history is committed before the metadata, and truncated on load if the metadata wasn't flushed
+	if rs.history != nil {
+		if err := rs.history.Commit(version); err != nil {
+			panic(err)
+		}
+	}
 
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
@@ -461,7 +595,7 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	for k, v := range rs.stores {
 		stores[k] = v
 	}
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners).SetMetrics(rs.metrics)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -477,6 +611,12 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
+			// Serve the version from the history if it was pruned from the IAVL store.
+			if view, ok := rs.historyView(key, version); ok {
+				cachedStores[key] = view
+				continue
+			}
+
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
@@ -491,7 +631,24 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners), nil
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners).SetMetrics(rs.metrics), nil
+}
+
+// historyView returns the view of the history of an IAVL store at a version which the store doesn't have,
+// if the history has it.
+func (rs *Store) historyView(key types.StoreKey, version int64) (*history.ViewStore, bool) {
+	if rs.history == nil || version <= 0 || key == nil {
+		return nil, false
+	}
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok || store.VersionExists(version) {
+		return nil, false
+	}
+	view, err := rs.history.StoreAt(key.Name(), version)
+	if err != nil {
+		return nil, false
+	}
+	return view, true
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -532,18 +689,44 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// handlePruning prunes the stores following the pruning strategy of the root store, then each store
+// with a pruning strategy of its own following it.
 func (rs *Store) handlePruning(version int64) error {
-	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
-	if !rs.pruningManager.ShouldPruneAtHeight(version) {
+	if err := rs.handleStorePruning(rs.pruningManager, "", version); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(rs.storePruning))
+	for name := range rs.storePruning {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := rs.handleStorePruning(rs.storePruning[name], name, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleStorePruning prunes the stores of a pruning manager, which are the store with the given name, or
+// the stores without a pruning strategy of their own if the name is empty.
+func (rs *Store) handleStorePruning(manager *pruning.Manager, name string, version int64) error {
+	manager.HandleHeight(version - 1) // we should never prune the current version.
+	if !manager.ShouldPruneAtHeight(version) {
 		return nil
 	}
-	rs.logger.Info("prune start", "height", version)
-	defer rs.logger.Info("prune end", "height", version)
-	return rs.pruneStores()
+	keyvals := []interface{}{"height", version}
+	if name != "" {
+		keyvals = append(keyvals, "store", name)
+	}
+	rs.logger.Info("prune start", keyvals...)
+	defer rs.logger.Info("prune end", keyvals...)
+	return rs.pruneStores(manager, name)
 }
 
-func (rs *Store) pruneStores() error {
-	pruningHeights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+func (rs *Store) pruneStores(manager *pruning.Manager, name string) error {
+	pruningHeights, err := manager.GetFlushAndResetPruningHeights()
 	if err != nil {
 		return err
 	}
@@ -553,7 +736,7 @@ func (rs *Store) pruneStores() error {
 		return nil
 	}
 
-	rs.logger.Debug("pruning heights", "heights", pruningHeights)
+	rs.logger.Debug("pruning heights", "heights", pruningHeights, "store", name)
 
 	for key, store := range rs.stores {
 		// If the store is wrapped with an inter-block cache, we must first unwrap
@@ -561,6 +744,14 @@ func (rs *Store) pruneStores() error {
 		if store.GetStoreType() != types.StoreTypeIAVL {
 			continue
 		}
+		// the stores with a pruning strategy of their own are only pruned by their manager
+		prunedBy := ""
+		if _, ok := rs.storePruning[key.Name()]; ok {
+			prunedBy = key.Name()
+		}
+		if prunedBy != name {
+			continue
+		}
 
 		store = rs.GetCommitKVStore(key)
 
@@ -610,6 +801,13 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store), false)
 	}
 
+	// serve the unproven queries at a version pruned from an IAVL store from the history
+	if !req.Prove {
+		if view, ok := rs.historyView(rs.keysByName[storeName], req.Height); ok {
+			queryable = view
+		}
+	}
+
 	// trim the path and make the query
 	req.Path = subpath
 	res := queryable.Query(req)
@@ -686,81 +884,92 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	names, err := rs.SnapshotStores(height)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := rs.SnapshotStore(height, name, protoWriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SnapshotStores implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStores(height uint64) ([]string, error) {
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(getLatestVersion(rs.db)) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
+	names := []string{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			names = append(names, key.Name())
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
+	sort.Strings(names)
+	return names, nil
+}
+
+// SnapshotStore implements snapshottypes.StoreSnapshotter.
+//
+// IAVL stores are serialized as a stream of SnapshotItem Protobuf messages. The first item
+// contains a SnapshotStore with store metadata (i.e. name), and the following messages contain
+// a SnapshotNode (i.e. an ExportNode). Store changes are demarcated by new SnapshotStore items.
+func (rs *Store) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot non-IAVL store %q", name)
+	}
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: name,
+			},
+		},
 	})
+	if err != nil {
+		return err
+	}
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			return nil
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
-
-	return nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
@@ -864,9 +1073,14 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var store types.CommitKVStore
 		var err error
 
-		if params.initialVersion == 0 {
+		switch {
+		case rs.metrics[key.Name()]:
+			stat := &iavltree.Statistics{}
+			rs.iavlStats[key] = stat
+			store, err = iavl.LoadStoreWithStatistics(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, stat)
+		case params.initialVersion == 0:
 			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSize)
-		} else {
+		default:
 			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize)
 		}
 
@@ -932,30 +1146,80 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	}
 }
 
-// RollbackToVersion delete the versions after `target` and update the latest version.
-func (rs *Store) RollbackToVersion(target int64) int64 {
-	if target < 0 {
-		panic("Negative rollback target")
+// GetCommitInfo returns the commit info of a committed version, with the commit IDs of its stores.
+func (rs *Store) GetCommitInfo(version int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, version)
+}
+
+// CheckRollbackToVersion returns the commit info of the target version of a rollback. It fails if
+// the version hasn't been committed, or if it doesn't exist in an IAVL store, having been pruned or
+// the store having been added after it. The stores must have been loaded.
+func (rs *Store) CheckRollbackToVersion(target int64) (*types.CommitInfo, error) {
+	if target <= 0 {
+		return nil, fmt.Errorf("invalid rollback target version %d", target)
 	}
-	current := getLatestVersion(rs.db)
-	if target >= current {
-		return current
+	if latest := getLatestVersion(rs.db); target > latest {
+		return nil, fmt.Errorf("rollback target version %d is above the latest version %d", target, latest)
 	}
-	for ; current > target; current-- {
-		rs.pruningManager.HandleHeight(current)
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get commit info of version %d", target)
 	}
-	if err := rs.pruneStores(); err != nil {
-		panic(err)
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store = rs.GetCommitKVStore(key)
+		if !store.(*iavl.Store).VersionExists(target) {
+			return nil, fmt.Errorf("version %d doesn't exist in store %s", target, key.Name())
+		}
 	}
+	return cInfo, nil
+}
 
-	// update latest height
-	bz, err := gogotypes.StdInt64Marshal(current)
+// RollbackToVersion rolls the stores back to the target version, deleting the later versions of the
+// IAVL stores, and loads it as the latest version. The stores must have been loaded, and are left
+// untouched if the rollback is rejected by CheckRollbackToVersion.
+func (rs *Store) RollbackToVersion(target int64) error {
+	cInfo, err := rs.CheckRollbackToVersion(target)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		store = rs.GetCommitKVStore(key)
+		if _, err := store.(*iavl.Store).LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
 	}
 
-	rs.db.Set([]byte(latestVersionKey), bz)
-	return current
+	rs.flushMetadata(rs.db, target, cInfo)
+	return rs.LoadLatestVersion()
+}
+
+// emitIAVLMetrics emits the hits and misses of the node caches of the IAVL stores with metrics enabled
+// since the last commit, and the hit ratio of their node cache.
+func (rs *Store) emitIAVLMetrics() {
+	for key, stat := range rs.iavlStats {
+		labels := []metrics.Label{telemetry.NewLabel(metrickv.LabelStore, key.Name())}
+		hits, misses := stat.GetCacheHitCnt(), stat.GetCacheMissCnt()
+		fastHits, fastMisses := stat.GetFastCacheHitCnt(), stat.GetFastCacheMissCnt()
+		stat.Reset()
+
+		telemetry.IncrCounterWithLabels([]string{"store", "iavl", "node_cache", "hits"}, float32(hits), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "iavl", "node_cache", "misses"}, float32(misses), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "iavl", "fast_node_cache", "hits"}, float32(fastHits), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "iavl", "fast_node_cache", "misses"}, float32(fastMisses), labels)
+		if hits+misses > 0 {
+			telemetry.SetGaugeWithLabels([]string{"store", "iavl", "node_cache", "hit_ratio"}, float32(hits)/float32(hits+misses), labels)
+		}
+	}
 }
 
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -15,6 +16,7 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/history"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
@@ -507,6 +509,101 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+		ms.SetStorePruning(testStoreKey2.Name(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+		ms.SetStorePruning(testStoreKey3.Name(), pruningtypes.NewCustomPruningOptions(5, 3))
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+	requireVersions := func(ms *Store, key types.StoreKey, first, last int64) {
+		store := ms.GetCommitKVStore(key).(*iavl.Store)
+		for v := int64(1); v <= last; v++ {
+			require.Equal(t, v >= first, store.VersionExists(v), "version %d of %s", v, key.Name())
+		}
+	}
+
+	ms := newStore()
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 1), ms.GetStorePruning(testStoreKey1.Name()))
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), ms.GetStorePruning(testStoreKey2.Name()))
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	requireVersions(ms, testStoreKey1, 8, 10)
+	requireVersions(ms, testStoreKey2, 1, 10)
+	requireVersions(ms, testStoreKey3, 4, 10)
+
+	// the heights left to prune of a store are loaded on restart
+	ms = newStore()
+	ms.Commit()
+	ms.Commit()
+	requireVersions(ms, testStoreKey1, 10, 12)
+	requireVersions(ms, testStoreKey2, 1, 12)
+	requireVersions(ms, testStoreKey3, 7, 12)
+
+	// the pruning strategy can only be set on the mounted IAVL stores
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning("unknown", pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, ms.LoadLatestVersion())
+}
+
+func TestMultiStore_RollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := []byte("key")
+	commitIDs := make([]types.CommitID, 11)
+	for v := int64(1); v <= 10; v++ {
+		ms.GetKVStore(testStoreKey1).Set(key, []byte(fmt.Sprint(v)))
+		commitIDs[v] = ms.Commit()
+	}
+
+	// the target version must have been committed
+	_, err := ms.CheckRollbackToVersion(0)
+	require.Error(t, err)
+	_, err = ms.CheckRollbackToVersion(11)
+	require.Error(t, err)
+	cInfo, err := ms.CheckRollbackToVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[5], cInfo.CommitID())
+
+	// rolling back several versions deletes the later versions of the IAVL stores
+	require.NoError(t, ms.RollbackToVersion(5))
+	require.Equal(t, commitIDs[5], ms.LastCommitID())
+	require.Equal(t, []byte("5"), ms.GetKVStore(testStoreKey1).Get(key))
+	iavlStore := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	require.True(t, iavlStore.VersionExists(5))
+	require.False(t, iavlStore.VersionExists(6))
+
+	// the versions above the target can be committed again with a different state
+	ms.GetKVStore(testStoreKey1).Set(key, []byte("rolled back"))
+	commitID := ms.Commit()
+	require.Equal(t, int64(6), commitID.Version)
+	require.NotEqual(t, commitIDs[6].Hash, commitID.Hash)
+
+	restore := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, restore.LoadLatestVersion())
+	require.Equal(t, commitID, restore.LastCommitID())
+	require.Equal(t, []byte("rolled back"), restore.GetKVStore(testStoreKey1).Get(key))
+}
+
+func TestMultiStore_RollbackToPrunedVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.NoError(t, ms.LoadLatestVersion())
+	for v := int64(1); v <= 10; v++ {
+		ms.Commit()
+	}
+
+	// a version pruned from the IAVL stores can't be rolled back to, and the stores are left untouched
+	require.Error(t, ms.RollbackToVersion(5))
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+	require.Equal(t, int64(10), getLatestVersion(db))
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -607,6 +704,59 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStoreHistory(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the state committed before the history is enabled is synced when the store is loaded
+	ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value1"))
+	ms.GetKVStore(testStoreKey2).Set([]byte("other"), []byte("value"))
+	ms.Commit()
+
+	historyDB := dbm.NewMemDB()
+	hs, err := history.NewStore(historyDB)
+	require.NoError(t, err)
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ms.SetHistory(hs)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.EqualValues(t, 1, hs.LastVersion())
+
+	for i := 2; i <= 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		if i == 5 {
+			ms.GetKVStore(testStoreKey2).Delete([]byte("other"))
+		}
+		ms.Commit()
+	}
+
+	// the versions pruned from the IAVL stores are served by the history
+	for i := int64(1); i <= 10; i++ {
+		cms, err := ms.CacheMultiStoreWithVersion(i)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+		require.Equal(t, i < 5, cms.GetKVStore(testStoreKey2).Has([]byte("other")))
+
+		res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: i})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), res.Value)
+	}
+	res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 3, Prove: true})
+	require.NotEqualValues(t, 0, res.Code)
+
+	// a version saved to the history by a commit which didn't complete is truncated
+	ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value11"))
+	require.NoError(t, hs.Commit(11))
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ms.SetHistory(hs)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.EqualValues(t, 10, hs.LastVersion())
+	ms.Commit()
+	cms, err := ms.CacheMultiStoreWithVersion(10)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value10"), cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -646,6 +796,49 @@ func TestAddListenersAndListeningEnabled(t *testing.T) {
 	require.True(t, enabled)
 }
 
+func TestEnableMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.EnableMetrics("unknown")
+	require.Error(t, ms.LoadLatestVersion())
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.EnableMetrics(testStoreKey1.Name())
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.True(t, ms.MetricsEnabled(testStoreKey1))
+	require.False(t, ms.MetricsEnabled(testStoreKey2))
+	cms := ms.CacheMultiStore()
+	require.True(t, types.MetricsEnabled(cms, testStoreKey1))
+	require.False(t, types.MetricsEnabled(cms, testStoreKey2))
+	require.True(t, types.MetricsEnabled(cms.CacheMultiStore(), testStoreKey1))
+
+	// the node cache statistics of the store are emitted on commit
+	ms.GetKVStore(testStoreKey1).Set(testKey1, testValue1)
+	ms.GetKVStore(testStoreKey2).Set(testKey1, testValue1)
+	ms.Commit()
+	ms.GetKVStore(testStoreKey1).Get(testKey1)
+	ms.GetKVStore(testStoreKey1).Get(testKey2)
+	ms.Commit()
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+	counters := data[0].Counters
+	labels := ";store=" + testStoreKey1.Name()
+	require.Contains(t, counters, "store.iavl.node_cache.hits"+labels)
+	require.Contains(t, counters, "store.iavl.node_cache.misses"+labels)
+	require.Contains(t, counters, "store.iavl.fast_node_cache.hits"+labels)
+	require.Equal(t, 2, counters["store.iavl.fast_node_cache.misses"+labels].Count)
+	require.NotContains(t, counters, "store.iavl.node_cache.hits;store="+testStoreKey2.Name())
+}
+
 var (
 	interfaceRegistry = codecTypes.NewInterfaceRegistry()
 	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
@@ -0,0 +1,369 @@
+package rwset
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface and records the read and write sets
+// of its user. Reads are delegated to the parent KVStore and the observed
+// values are recorded, including every position visited by an iterator.
+// Writes are buffered and never reach the parent until WriteTo is called.
+//
+// A Store is meant to sit below a cachekv.Store, so that reads served from the
+// branch's own writes are not recorded, and it is not safe for concurrent use.
+// It allows optimistic executors to run a transaction against a snapshot and
+// later check, with Validate, whether the snapshot observed by the
+// transaction is still the one it would observe when executed in order.
+type Store struct {
+	parent    types.KVStore
+	reads     map[string][]byte
+	iterators []*iteratorRecord
+	writes    map[string][]byte
+	deletes   map[string]struct{}
+}
+
+// NewStore returns a reference to a new Store recording the reads and writes
+// issued against the given parent KVStore.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{
+		parent:  parent,
+		reads:   make(map[string][]byte),
+		writes:  make(map[string][]byte),
+		deletes: make(map[string]struct{}),
+	}
+}
+
+// Get implements the KVStore interface. It records the first value observed
+// for the given key and delegates the Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+	if _, ok := s.reads[string(key)]; !ok {
+		s.reads[string(key)] = copyBytes(value)
+	}
+
+	return value
+}
+
+// Has implements the KVStore interface. It is recorded as a read of the key.
+func (s *Store) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements the KVStore interface. The write is buffered.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	delete(s.deletes, string(key))
+	s.writes[string(key)] = value
+}
+
+// Delete implements the KVStore interface. The delete is buffered.
+func (s *Store) Delete(key []byte) {
+	types.AssertValidKey(key)
+
+	delete(s.writes, string(key))
+	s.deletes[string(key)] = struct{}{}
+}
+
+// Iterator implements the KVStore interface. Every position visited by the
+// returned iterator is recorded.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface. Every position visited by
+// the returned iterator is recorded.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	var parent types.Iterator
+
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	record := &iteratorRecord{
+		start:     copyBytes(start),
+		end:       copyBytes(end),
+		ascending: ascending,
+	}
+	s.iterators = append(s.iterators, record)
+
+	return &recordingIterator{parent: parent, record: record}
+}
+
+// Validate returns true if every read recorded by the Store, including the
+// positions visited by its iterators, observes the same values when issued
+// against the given KVStore.
+func (s *Store) Validate(store types.KVStore) bool {
+	for key, value := range s.reads {
+		if !bytes.Equal(store.Get([]byte(key)), value) {
+			return false
+		}
+	}
+
+	for _, record := range s.iterators {
+		if !record.validate(store) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReadKeys returns the keys read from the parent KVStore in ascending order,
+// including the positions visited by its iterators.
+func (s *Store) ReadKeys() [][]byte {
+	reads := s.Reads()
+	keys := make([][]byte, len(reads))
+	for i, pair := range reads {
+		keys[i] = pair.Key
+	}
+
+	return keys
+}
+
+// Reads returns the keys read from the parent KVStore in ascending order along
+// with the first value observed, nil if the key doesn't exist, including the
+// positions visited by its iterators.
+func (s *Store) Reads() []types.KVPair {
+	values := make(map[string][]byte, len(s.reads))
+	for key, value := range s.reads {
+		values[key] = value
+	}
+	for _, record := range s.iterators {
+		for i, key := range record.keys {
+			if _, ok := values[string(key)]; !ok {
+				values[string(key)] = record.values[i]
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	reads := make([]types.KVPair, len(keys))
+	for i, key := range keys {
+		reads[i] = types.KVPair{Key: []byte(key), Value: values[key]}
+	}
+
+	return reads
+}
+
+// ReadRanges returns the domains of keys read entirely from the parent KVStore
+// by the iterators of the Store, i.e. in which every key of the parent was
+// visited: the whole domain of an exhausted iterator, or the part of the domain
+// up to the last position visited otherwise.
+func (s *Store) ReadRanges() []*types.KeyRange {
+	var ranges []*types.KeyRange
+	for _, record := range s.iterators {
+		switch {
+		case record.exhausted:
+			ranges = append(ranges, &types.KeyRange{Start: record.start, End: record.end})
+
+		case len(record.keys) == 0:
+
+		case record.ascending:
+			last := record.keys[len(record.keys)-1]
+			ranges = append(ranges, &types.KeyRange{Start: record.start, End: append(copyBytes(last), 0)})
+
+		default:
+			ranges = append(ranges, &types.KeyRange{Start: record.keys[len(record.keys)-1], End: record.end})
+		}
+	}
+
+	return ranges
+}
+
+// HasWrites returns true if the Store buffered any write.
+func (s *Store) HasWrites() bool {
+	return len(s.writes) > 0 || len(s.deletes) > 0
+}
+
+// WriteTo applies the buffered writes to the given KVStore in ascending key
+// order.
+func (s *Store) WriteTo(store types.KVStore) {
+	for _, key := range s.writtenKeys() {
+		if value, ok := s.writes[key]; ok {
+			store.Set([]byte(key), value)
+			continue
+		}
+
+		store.Delete([]byte(key))
+	}
+}
+
+// Changeset returns the buffered writes in ascending key order, as the pairs
+// of the store with the given name.
+func (s *Store) Changeset(storeKey string) []*types.StoreKVPair {
+	keys := s.writtenKeys()
+	pairs := make([]*types.StoreKVPair, len(keys))
+	for i, key := range keys {
+		value, ok := s.writes[key]
+		pairs[i] = &types.StoreKVPair{
+			StoreKey: storeKey,
+			Delete:   !ok,
+			Key:      []byte(key),
+			Value:    value,
+		}
+	}
+
+	return pairs
+}
+
+func (s *Store) writtenKeys() []string {
+	keys := make([]string, 0, len(s.writes)+len(s.deletes))
+	for key := range s.writes {
+		keys = append(keys, key)
+	}
+	for key := range s.deletes {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a read/write set KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a read/write set KVStore")
+}
+
+// CacheWrapWithListeners implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a read/write set KVStore")
+}
+
+// iteratorRecord holds the domain of an iterator together with the key/value
+// pairs it visited, in order.
+type iteratorRecord struct {
+	start, end []byte
+	ascending  bool
+	keys       [][]byte
+	values     [][]byte
+	// exhausted is set once the iterator was observed to be invalid, i.e. the
+	// absence of further keys in the domain was read as well.
+	exhausted bool
+}
+
+func (r *iteratorRecord) validate(store types.KVStore) bool {
+	var it types.Iterator
+	if r.ascending {
+		it = store.Iterator(r.start, r.end)
+	} else {
+		it = store.ReverseIterator(r.start, r.end)
+	}
+	defer it.Close()
+
+	for i := range r.keys {
+		if !it.Valid() || !bytes.Equal(it.Key(), r.keys[i]) || !bytes.Equal(it.Value(), r.values[i]) {
+			return false
+		}
+
+		it.Next()
+	}
+
+	return !r.exhausted || !it.Valid()
+}
+
+// recordingIterator records every position its parent iterator is observed at.
+type recordingIterator struct {
+	parent types.Iterator
+	record *iteratorRecord
+	// recorded is set once the current position has been recorded
+	recorded bool
+}
+
+func (ri *recordingIterator) observe() bool {
+	valid := ri.parent.Valid()
+	switch {
+	case !valid:
+		ri.record.exhausted = true
+
+	case !ri.recorded:
+		ri.record.keys = append(ri.record.keys, copyBytes(ri.parent.Key()))
+		ri.record.values = append(ri.record.values, copyBytes(ri.parent.Value()))
+		ri.recorded = true
+	}
+
+	return valid
+}
+
+// Domain implements the Iterator interface.
+func (ri *recordingIterator) Domain() (start []byte, end []byte) {
+	return ri.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (ri *recordingIterator) Valid() bool {
+	return ri.observe()
+}
+
+// Next implements the Iterator interface.
+func (ri *recordingIterator) Next() {
+	ri.observe()
+	ri.parent.Next()
+	ri.recorded = false
+}
+
+// Key implements the Iterator interface.
+func (ri *recordingIterator) Key() []byte {
+	ri.observe()
+	return ri.parent.Key()
+}
+
+// Value implements the Iterator interface.
+func (ri *recordingIterator) Value() []byte {
+	ri.observe()
+	return ri.parent.Value()
+}
+
+// Close implements the Iterator interface.
+func (ri *recordingIterator) Close() error {
+	return ri.parent.Close()
+}
+
+// Error delegates the Error call to the parent iterator.
+func (ri *recordingIterator) Error() error {
+	return ri.parent.Error()
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+
+	cp := make([]byte, len(bz))
+	copy(cp, bz)
+
+	return cp
+}
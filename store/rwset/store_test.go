@@ -0,0 +1,176 @@
+package rwset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newParent() types.KVStore {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("a"), []byte("1"))
+	parent.Set([]byte("b"), []byte("2"))
+	parent.Set([]byte("c"), []byte("3"))
+
+	return parent
+}
+
+func TestWritesAreBuffered(t *testing.T) {
+	parent := newParent()
+	store := rwset.NewStore(parent)
+
+	branch := cachekv.NewStore(store)
+	branch.Set([]byte("a"), []byte("10"))
+	branch.Delete([]byte("b"))
+	branch.Set([]byte("d"), []byte("4"))
+	branch.Write()
+
+	// the parent is untouched until the writes are applied
+	require.Equal(t, []byte("1"), parent.Get([]byte("a")))
+	require.True(t, store.HasWrites())
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "test", Key: []byte("a"), Value: []byte("10")},
+		{StoreKey: "test", Delete: true, Key: []byte("b")},
+		{StoreKey: "test", Key: []byte("d"), Value: []byte("4")},
+	}, store.Changeset("test"))
+
+	store.WriteTo(parent)
+	require.Equal(t, []byte("10"), parent.Get([]byte("a")))
+	require.Nil(t, parent.Get([]byte("b")))
+	require.Equal(t, []byte("4"), parent.Get([]byte("d")))
+}
+
+func TestValidateReads(t *testing.T) {
+	parent := newParent()
+	store := rwset.NewStore(parent)
+
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.False(t, store.Has([]byte("z")))
+	require.True(t, store.Validate(parent))
+
+	// blind writes to keys that were never read do not invalidate the reads
+	parent.Set([]byte("b"), []byte("20"))
+	require.True(t, store.Validate(parent))
+
+	// creating a key that was observed as missing invalidates the reads
+	parent.Set([]byte("z"), []byte("26"))
+	require.False(t, store.Validate(parent))
+	parent.Delete([]byte("z"))
+	require.True(t, store.Validate(parent))
+
+	parent.Set([]byte("a"), []byte("10"))
+	require.False(t, store.Validate(parent))
+}
+
+func TestValidateIterators(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(types.KVStore)
+		valid  bool
+	}{
+		{"unchanged", func(types.KVStore) {}, true},
+		{"write outside the domain", func(s types.KVStore) { s.Set([]byte("x"), []byte("0")) }, true},
+		{"write past the visited positions", func(s types.KVStore) { s.Set([]byte("c"), []byte("30")) }, true},
+		{"update a visited value", func(s types.KVStore) { s.Set([]byte("b"), []byte("20")) }, false},
+		{"insert between visited keys", func(s types.KVStore) { s.Set([]byte("aa"), []byte("0")) }, false},
+		{"delete a visited key", func(s types.KVStore) { s.Delete([]byte("a")) }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := newParent()
+			store := rwset.NewStore(parent)
+
+			// visit the first two keys only
+			it := store.Iterator([]byte("a"), []byte("d"))
+			require.Equal(t, []byte("a"), it.Key())
+			it.Next()
+			require.Equal(t, []byte("2"), it.Value())
+			require.NoError(t, it.Close())
+
+			tc.mutate(parent)
+			require.Equal(t, tc.valid, store.Validate(parent))
+		})
+	}
+}
+
+func TestValidateExhaustedIterator(t *testing.T) {
+	parent := newParent()
+	store := rwset.NewStore(parent)
+
+	it := store.ReverseIterator([]byte("b"), nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+	require.True(t, store.Validate(parent))
+
+	// appending a key to an exhausted domain invalidates the iteration
+	parent.Set([]byte("d"), []byte("4"))
+	require.False(t, store.Validate(parent))
+}
+
+func TestReadKeys(t *testing.T) {
+	store := rwset.NewStore(newParent())
+	store.Get([]byte("c"))
+	store.Has([]byte("e"))
+
+	iter := store.Iterator([]byte("b"), nil)
+	require.True(t, iter.Valid())
+	iter.Next()
+	require.NoError(t, iter.Close())
+
+	// the keys read twice are returned once
+	store.Get([]byte("b"))
+	require.Equal(t, [][]byte{[]byte("b"), []byte("c"), []byte("e")}, store.ReadKeys())
+}
+
+func TestReads(t *testing.T) {
+	store := rwset.NewStore(newParent())
+	store.Get([]byte("e"))
+
+	iter := store.Iterator([]byte("b"), nil)
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, []types.KVPair{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("e")},
+	}, store.Reads())
+}
+
+func TestReadRanges(t *testing.T) {
+	store := rwset.NewStore(newParent())
+
+	// an exhausted iterator reads its whole domain
+	iter := store.Iterator([]byte("b"), []byte("z"))
+	for ; iter.Valid(); iter.Next() {
+	}
+	require.NoError(t, iter.Close())
+
+	// an ascending iterator reads its domain up to its last position
+	iter = store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	iter.Next()
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// a descending iterator reads its domain down to its last position
+	iter = store.ReverseIterator(nil, []byte("c"))
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// an iterator closed before any position reads nothing
+	require.NoError(t, store.Iterator(nil, nil).Close())
+
+	require.Equal(t, []*types.KeyRange{
+		{Start: []byte("b"), End: []byte("z")},
+		{End: []byte("b\x00")},
+		{Start: []byte("b"), End: []byte("c")},
+	}, store.ReadRanges())
+}
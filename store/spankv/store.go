@@ -0,0 +1,172 @@
+package spankv
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store records a tracing span for each operation of an underlying KVStore,
+// as a child of the span of a context. It implements the KVStore interface.
+type Store struct {
+	parent types.KVStore
+	ctx    context.Context
+	name   string
+}
+
+// NewStore returns a reference to a new SpanKVStore recording the spans of the
+// operations of the store of the given name within the span of the context.
+func NewStore(parent types.KVStore, ctx context.Context, name string) *Store {
+	return &Store{parent: parent, ctx: ctx, name: name}
+}
+
+// startSpan starts the span of an operation on a key of the store.
+func (ss *Store) startSpan(op string, key []byte) trace.Span {
+	_, span := telemetry.StartSpan(ss.ctx, "KVStore."+op,
+		attribute.String("store", ss.name),
+		attribute.String("key", hex.EncodeToString(key)),
+	)
+	return span
+}
+
+// Implements Store.
+func (ss *Store) GetStoreType() types.StoreType {
+	return ss.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (ss *Store) Get(key []byte) []byte {
+	span := ss.startSpan("Get", key)
+	defer span.End()
+
+	value := ss.parent.Get(key)
+	span.SetAttributes(attribute.Int("value_size", len(value)))
+	return value
+}
+
+// Implements KVStore.
+func (ss *Store) Set(key []byte, value []byte) {
+	span := ss.startSpan("Set", key)
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("value_size", len(value)))
+	ss.parent.Set(key, value)
+}
+
+// Implements KVStore.
+func (ss *Store) Has(key []byte) bool {
+	span := ss.startSpan("Has", key)
+	defer span.End()
+
+	return ss.parent.Has(key)
+}
+
+// Implements KVStore.
+func (ss *Store) Delete(key []byte) {
+	span := ss.startSpan("Delete", key)
+	defer span.End()
+
+	ss.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface. The span of the iterator lasts
+// until it is closed.
+func (ss *Store) Iterator(start, end []byte) types.Iterator {
+	return ss.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface. The span of the iterator
+// lasts until it is closed.
+func (ss *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return ss.iterator(start, end, false)
+}
+
+// Implements KVStore.
+func (ss *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a SpanKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (ss *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a SpanKVStore")
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (ss *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a SpanKVStore")
+}
+
+func (ss *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	op := "Iterator"
+	if !ascending {
+		op = "ReverseIterator"
+	}
+	_, span := telemetry.StartSpan(ss.ctx, "KVStore."+op,
+		attribute.String("store", ss.name),
+		attribute.String("start", hex.EncodeToString(start)),
+		attribute.String("end", hex.EncodeToString(end)),
+	)
+
+	var parent types.Iterator
+	if ascending {
+		parent = ss.parent.Iterator(start, end)
+	} else {
+		parent = ss.parent.ReverseIterator(start, end)
+	}
+
+	return &spanIterator{parent: parent, span: span}
+}
+
+type spanIterator struct {
+	parent types.Iterator
+	span   trace.Span
+	// next is the number of entries iterated over
+	next int
+}
+
+// Implements Iterator.
+func (si *spanIterator) Domain() (start []byte, end []byte) {
+	return si.parent.Domain()
+}
+
+// Implements Iterator.
+func (si *spanIterator) Valid() bool {
+	return si.parent.Valid()
+}
+
+// Implements Iterator.
+func (si *spanIterator) Next() {
+	si.next++
+	si.parent.Next()
+}
+
+// Implements Iterator.
+func (si *spanIterator) Key() []byte {
+	return si.parent.Key()
+}
+
+// Implements Iterator.
+func (si *spanIterator) Value() []byte {
+	return si.parent.Value()
+}
+
+// Close implements the Iterator interface, ending the span of the iterator.
+func (si *spanIterator) Close() error {
+	si.span.SetAttributes(attribute.Int("next", si.next))
+	si.span.End()
+
+	return si.parent.Close()
+}
+
+// Implements Iterator.
+func (si *spanIterator) Error() error {
+	return si.parent.Error()
+}
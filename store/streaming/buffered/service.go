@@ -0,0 +1,414 @@
+package buffered
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRetryInterval is the default interval between two attempts to replay
+// the buffered messages while ListenCommit waits for them to be delivered.
+const DefaultRetryInterval = time.Second
+
+// recordSuffix is the suffix of the files holding the buffered records.
+const recordSuffix = ".rec"
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ baseapp.CommitListener   = &StreamingService{}
+	_ types.WriteListener      = &StreamingService{}
+)
+
+// StreamingService wraps a StreamingService with a durable on-disk buffer.
+//
+// The wrapped service receives the state changes and ABCI messages through the
+// wrapper. When the wrapped service fails to process an ABCI message, the
+// message and the state changes it caused are written to the buffer directory,
+// together with every subsequent message, and the hook succeeds. Each listening
+// hook then replays the buffered messages in order, so that the wrapped
+// service catches up with the missed blocks as soon as it recovers, e.g. once
+// it reconnects to its destination. The buffer survives restarts.
+//
+// Messages are delivered at least once: a message whose delivery failed half
+// way is delivered again by the replay. Replayed messages are delivered with
+// the Context of the hook which replays them.
+type StreamingService struct {
+	inner         baseapp.StreamingService
+	innerKeys     map[string]types.StoreKey // the store keys of the wrapped service, by name
+	listeners     map[types.StoreKey][]types.WriteListener
+	dir           string
+	codec         codec.BinaryCodec
+	retryInterval time.Duration
+
+	stateCache     []*types.StoreKVPair // the state changes in the order they are received
+	stateCacheLock sync.Mutex           // mutex for the state cache
+
+	pending  []uint64 // the sequence numbers of the buffered records, in order
+	nextSeq  uint64   // the sequence number of the next buffered record
+	quitChan chan struct{}
+}
+
+// NewStreamingService wraps the provided StreamingService with a durable buffer
+// stored in dir. Records buffered by a previous run are replayed first.
+func NewStreamingService(inner baseapp.StreamingService, dir string, retryInterval time.Duration, c codec.BinaryCodec) (*StreamingService, error) {
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	bss := &StreamingService{
+		inner:         inner,
+		innerKeys:     make(map[string]types.StoreKey),
+		listeners:     make(map[types.StoreKey][]types.WriteListener),
+		dir:           dir,
+		codec:         c,
+		retryInterval: retryInterval,
+		quitChan:      make(chan struct{}),
+	}
+
+	for key := range inner.Listeners() {
+		bss.innerKeys[key.Name()] = key
+		bss.listeners[key] = []types.WriteListener{bss}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, recordSuffix) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, recordSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		bss.pending = append(bss.pending, seq)
+		if seq >= bss.nextSeq {
+			bss.nextSeq = seq + 1
+		}
+	}
+
+	sort.Slice(bss.pending, func(i, j int) bool { return bss.pending[i] < bss.pending[j] })
+
+	return bss, nil
+}
+
+// Pending returns the number of buffered messages awaiting delivery.
+func (bss *StreamingService) Pending() int {
+	return len(bss.pending)
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the WriteListeners recording the state changes for the wrapped service
+func (bss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return bss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface by caching the state
+// change until the next listening hook.
+func (bss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+	}
+
+	bss.stateCacheLock.Lock()
+	bss.stateCache = append(bss.stateCache, kvPair)
+	bss.stateCacheLock.Unlock()
+
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+func (bss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	return bss.listen(ctx, &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+func (bss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return bss.listen(ctx, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+func (bss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return bss.listen(ctx, &req, &res)
+}
+
+// ListenCommit satisfies the baseapp.CommitListener interface
+// It replays the buffered messages until all of them are delivered, retrying at
+// the configured interval, and returns an error only if the service is closed.
+func (bss *StreamingService) ListenCommit(ctx sdk.Context) error {
+	for {
+		if bss.replay(ctx) == nil {
+			return nil
+		}
+
+		select {
+		case <-bss.quitChan:
+			return errors.New("streaming service is closed")
+		case <-time.After(bss.retryInterval):
+		}
+	}
+}
+
+// listen delivers the message to the wrapped service, or buffers it if the
+// wrapped service fails or buffered messages are still awaiting delivery.
+func (bss *StreamingService) listen(ctx sdk.Context, req, res codec.ProtoMarshaler) error {
+	bss.stateCacheLock.Lock()
+	rec := record{req: req, kvPairs: bss.stateCache, res: res}
+	bss.stateCache = nil
+	bss.stateCacheLock.Unlock()
+
+	if len(bss.pending) == 0 && bss.deliver(ctx, rec) == nil {
+		return nil
+	}
+
+	if err := bss.persist(rec); err != nil {
+		return err
+	}
+
+	// failing to replay is not an error, the messages stay buffered
+	_ = bss.replay(ctx)
+
+	return nil
+}
+
+// replay delivers the buffered messages in order, and stops at the first failure.
+func (bss *StreamingService) replay(ctx sdk.Context) error {
+	for len(bss.pending) > 0 {
+		path := bss.recordPath(bss.pending[0])
+
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rec, err := decodeRecord(bss.codec, bz)
+		if err != nil {
+			return fmt.Errorf("failed to decode buffered record %s: %w", path, err)
+		}
+
+		if err := bss.deliver(ctx, rec); err != nil {
+			return err
+		}
+
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+
+		bss.pending = bss.pending[1:]
+	}
+
+	return nil
+}
+
+// deliver forwards the state changes of a record to the WriteListeners of the
+// wrapped service, and the ABCI messages to its matching listening hook.
+func (bss *StreamingService) deliver(ctx sdk.Context, rec record) error {
+	innerListeners := bss.inner.Listeners()
+	for _, kvPair := range rec.kvPairs {
+		key, ok := bss.innerKeys[kvPair.StoreKey]
+		if !ok {
+			continue
+		}
+
+		for _, listener := range innerListeners[key] {
+			if err := listener.OnWrite(key, kvPair.Key, kvPair.Value, kvPair.Delete); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch req := rec.req.(type) {
+	case *abci.RequestBeginBlock:
+		return bss.inner.ListenBeginBlock(ctx, *req, *rec.res.(*abci.ResponseBeginBlock))
+	case *abci.RequestDeliverTx:
+		return bss.inner.ListenDeliverTx(ctx, *req, *rec.res.(*abci.ResponseDeliverTx))
+	case *abci.RequestEndBlock:
+		return bss.inner.ListenEndBlock(ctx, *req, *rec.res.(*abci.ResponseEndBlock))
+	default:
+		return fmt.Errorf("unexpected ABCI request %T", req)
+	}
+}
+
+// persist writes a record to the buffer directory. The record is written to a
+// temporary file first, so that a crash never leaves a truncated record.
+func (bss *StreamingService) persist(rec record) error {
+	bz, err := encodeRecord(bss.codec, rec)
+	if err != nil {
+		return err
+	}
+
+	seq := bss.nextSeq
+	path := bss.recordPath(seq)
+	tmpPath := path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	bss.pending = append(bss.pending, seq)
+	bss.nextSeq++
+
+	return nil
+}
+
+func (bss *StreamingService) recordPath(seq uint64) string {
+	return filepath.Join(bss.dir, fmt.Sprintf("%020d%s", seq, recordSuffix))
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It starts the wrapped service
+func (bss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	return bss.inner.Stream(wg)
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It closes the wrapped service, buffered messages are replayed on the next run
+func (bss *StreamingService) Close() error {
+	close(bss.quitChan)
+	return bss.inner.Close()
+}
+
+// record holds an ABCI request and response together with the state changes
+// which occurred in between.
+type record struct {
+	req     codec.ProtoMarshaler
+	kvPairs []*types.StoreKVPair
+	res     codec.ProtoMarshaler
+}
+
+// encodeRecord encodes a record as the length-prefixed request and response,
+// packed in Anys, surrounding the length-prefixed StoreKVPairs.
+func encodeRecord(c codec.BinaryCodec, rec record) ([]byte, error) {
+	reqAny, err := codectypes.NewAnyWithValue(rec.req)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := c.MarshalLengthPrefixed(reqAny)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kvPair := range rec.kvPairs {
+		kvBz, err := c.MarshalLengthPrefixed(kvPair)
+		if err != nil {
+			return nil, err
+		}
+
+		bz = append(bz, kvBz...)
+	}
+
+	resAny, err := codectypes.NewAnyWithValue(rec.res)
+	if err != nil {
+		return nil, err
+	}
+
+	resBz, err := c.MarshalLengthPrefixed(resAny)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, resBz...), nil
+}
+
+// decodeRecord decodes a record encoded by encodeRecord.
+func decodeRecord(c codec.BinaryCodec, bz []byte) (record, error) {
+	var chunks [][]byte
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return record{}, errors.New("truncated record")
+		}
+
+		chunks = append(chunks, bz[n:n+int(size)])
+		bz = bz[n+int(size):]
+	}
+
+	if len(chunks) < 2 {
+		return record{}, errors.New("record is missing the ABCI request or response")
+	}
+
+	var rec record
+
+	req, err := unpackABCIMessage(c, chunks[0])
+	if err != nil {
+		return record{}, err
+	}
+	rec.req = req
+
+	for _, chunk := range chunks[1 : len(chunks)-1] {
+		kvPair := new(types.StoreKVPair)
+		if err := c.Unmarshal(chunk, kvPair); err != nil {
+			return record{}, err
+		}
+
+		rec.kvPairs = append(rec.kvPairs, kvPair)
+	}
+
+	res, err := unpackABCIMessage(c, chunks[len(chunks)-1])
+	if err != nil {
+		return record{}, err
+	}
+	rec.res = res
+
+	return rec, nil
+}
+
+// abciMessages maps the type URLs of the buffered ABCI messages to constructors.
+var abciMessages = map[string]func() codec.ProtoMarshaler{
+	"/tendermint.abci.RequestBeginBlock":  func() codec.ProtoMarshaler { return new(abci.RequestBeginBlock) },
+	"/tendermint.abci.ResponseBeginBlock": func() codec.ProtoMarshaler { return new(abci.ResponseBeginBlock) },
+	"/tendermint.abci.RequestDeliverTx":   func() codec.ProtoMarshaler { return new(abci.RequestDeliverTx) },
+	"/tendermint.abci.ResponseDeliverTx":  func() codec.ProtoMarshaler { return new(abci.ResponseDeliverTx) },
+	"/tendermint.abci.RequestEndBlock":    func() codec.ProtoMarshaler { return new(abci.RequestEndBlock) },
+	"/tendermint.abci.ResponseEndBlock":   func() codec.ProtoMarshaler { return new(abci.ResponseEndBlock) },
+}
+
+func unpackABCIMessage(c codec.BinaryCodec, bz []byte) (codec.ProtoMarshaler, error) {
+	var msgAny codectypes.Any
+	if err := c.Unmarshal(bz, &msgAny); err != nil {
+		return nil, err
+	}
+
+	newMsg, ok := abciMessages[msgAny.TypeUrl]
+	if !ok {
+		return nil, fmt.Errorf("unexpected ABCI message type %s", msgAny.TypeUrl)
+	}
+
+	msg := newMsg()
+	if err := c.Unmarshal(msgAny.Value, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,135 @@
+package buffered
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testMarshaller = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	mockStoreKey   = sdk.NewKVStoreKey("mockStore")
+	emptyContext   = sdk.Context{}
+)
+
+// mockService is a StreamingService recording the messages it receives, which
+// fails while it is disconnected.
+type mockService struct {
+	disconnected bool
+	kvPairs      []types.StoreKVPair
+	messages     []string
+}
+
+func (m *mockService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	m.kvPairs = append(m.kvPairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (m *mockService) listen(msg string) error {
+	if m.disconnected {
+		return errors.New("disconnected")
+	}
+
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func (m *mockService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return m.listen("begin")
+}
+
+func (m *mockService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return m.listen("tx-" + string(req.Tx))
+}
+
+func (m *mockService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return m.listen("end")
+}
+
+func (m *mockService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return map[types.StoreKey][]types.WriteListener{mockStoreKey: {m}}
+}
+
+func (m *mockService) Stream(*sync.WaitGroup) error { return nil }
+func (m *mockService) Close() error                 { return nil }
+
+func listenBlock(t *testing.T, bss *StreamingService, height int64) {
+	listener := bss.Listeners()[mockStoreKey][0]
+
+	require.NoError(t, listener.OnWrite(mockStoreKey, []byte("key"), []byte{byte(height)}, false))
+	require.NoError(t, bss.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, bss.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{Tx: []byte("a")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, bss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+}
+
+func TestBufferAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	inner := &mockService{}
+
+	bss, err := NewStreamingService(inner, dir, time.Millisecond, testMarshaller)
+	require.NoError(t, err)
+
+	listenBlock(t, bss, 1)
+	require.Equal(t, []string{"begin", "tx-a", "end"}, inner.messages)
+	require.Equal(t, 0, bss.Pending())
+
+	// messages are buffered while the wrapped service fails
+	inner.disconnected = true
+	listenBlock(t, bss, 2)
+	require.Equal(t, 3, bss.Pending())
+	require.Len(t, inner.messages, 3)
+
+	// the buffer survives a restart
+	bss, err = NewStreamingService(inner, dir, time.Millisecond, testMarshaller)
+	require.NoError(t, err)
+	require.Equal(t, 3, bss.Pending())
+
+	// the buffered messages are replayed in order once the wrapped service recovers
+	inner.disconnected = false
+	listenBlock(t, bss, 3)
+	require.Equal(t, 0, bss.Pending())
+	require.Equal(t, []string{"begin", "tx-a", "end", "begin", "tx-a", "end", "begin", "tx-a", "end"}, inner.messages)
+
+	// with the state changes in between, which failed deliveries sent at least once
+	var values []byte
+	for _, kvPair := range inner.kvPairs {
+		if len(values) == 0 || values[len(values)-1] != kvPair.Value[0] {
+			values = append(values, kvPair.Value[0])
+		}
+	}
+	require.Equal(t, []byte{1, 2, 3}, values)
+}
+
+func TestListenCommit(t *testing.T) {
+	inner := &mockService{disconnected: true}
+
+	bss, err := NewStreamingService(inner, t.TempDir(), time.Millisecond, testMarshaller)
+	require.NoError(t, err)
+
+	listenBlock(t, bss, 1)
+	require.Equal(t, 3, bss.Pending())
+
+	// ListenCommit blocks until the buffered messages are delivered
+	done := make(chan error)
+	go func() { done <- bss.ListenCommit(emptyContext) }()
+
+	select {
+	case <-done:
+		t.Fatal("expected ListenCommit to block while the wrapped service fails")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, bss.Close())
+	require.Error(t, <-done)
+	require.Equal(t, 3, bss.Pending())
+}
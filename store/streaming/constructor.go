@@ -8,7 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/buffered"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/streaming/kafka"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +26,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
+	Kafka
 	// add more in the future
 )
 
@@ -31,6 +36,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc":
+		return GRPC
+	case "kafka":
+		return Kafka
 	default:
 		return Unknown
 	}
@@ -41,6 +50,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
+	case Kafka:
+		return "kafka"
 	default:
 		return "unknown"
 	}
@@ -48,7 +61,9 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:  NewFileStreamingService,
+	GRPC:  NewGRPCStreamingService,
+	Kafka: NewKafkaStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -65,9 +80,43 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 
 // NewFileStreamingService is the streaming.ServiceConstructor function for creating a FileStreamingService
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
-	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
-	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+	return file.NewStreamingServiceWithConfig(file.Config{
+		WriteDir:          cast.ToString(opts.Get("streamers.file.write_dir")),
+		FilePrefix:        cast.ToString(opts.Get("streamers.file.prefix")),
+		MaxSegmentHeights: cast.ToInt64(opts.Get("streamers.file.max_segment_heights")),
+		MaxSegmentSize:    cast.ToInt64(opts.Get("streamers.file.max_segment_size")),
+		RetainHeights:     cast.ToInt64(opts.Get("streamers.file.retain_heights")),
+		RetainSize:        cast.ToInt64(opts.Get("streamers.file.retain_size")),
+	}, keys, marshaller)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get("streamers.grpc.address"))
+	bufferSize := cast.ToInt(opts.Get("streamers.grpc.buffer_size"))
+	return grpc.NewStreamingService(address, bufferSize, keys)
+}
+
+// NewKafkaStreamingService is the streaming.ServiceConstructor function for creating a Kafka StreamingService
+func NewKafkaStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	acks := kafka.WaitForLocal
+	if v := opts.Get("streamers.kafka.required_acks"); v != nil {
+		acks = kafka.RequiredAcks(cast.ToInt16(v))
+	}
+
+	producer, err := kafka.NewProducer(kafka.ProducerConfig{
+		Broker:       cast.ToString(opts.Get("streamers.kafka.broker")),
+		ClientID:     cast.ToString(opts.Get("streamers.kafka.client_id")),
+		RequiredAcks: acks,
+		Timeout:      cast.ToDuration(opts.Get("streamers.kafka.timeout")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topic := cast.ToString(opts.Get("streamers.kafka.topic"))
+	partition := cast.ToInt32(opts.Get("streamers.kafka.partition"))
+	return kafka.NewStreamingService(producer, topic, partition, keys, marshaller)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
@@ -107,8 +156,26 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 			}
 			return nil, nil, err
 		}
+		// get the policy applied to the errors of this streamer
+		policy, err := ListenerPolicyFromString(cast.ToString(appOpts.Get(fmt.Sprintf("streamers.%s.policy", streamerName))))
+		if err != nil {
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, err
+		}
 		// generate the streaming service using the constructor, appOptions, and the StoreKeys we want to expose
 		streamingService, err := constructor(appOpts, exposeStoreKeys, appCodec)
+		if err == nil {
+			// optionally wrap the streaming service with a durable buffer
+			streamingService, err = wrapBuffered(streamingService, appOpts, streamerName, appCodec)
+		}
+		if err == nil {
+			if _, ok := streamingService.(baseapp.CommitListener); policy == baseapp.ListenerPolicyBlockCommit && !ok {
+				streamingService.Close()
+				err = fmt.Errorf("streaming service %s does not support the %s policy, set streamers.%s.buffer_dir to enable it", streamerName, policy, streamerName)
+			}
+		}
 		if err != nil {
 			// close any services we may have already spun up before hitting the error on this one
 			for _, activeStreamer := range activeStreamers {
@@ -117,7 +184,7 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 			return nil, nil, err
 		}
 		// register the streaming service with the BaseApp
-		bApp.SetStreamingService(streamingService)
+		bApp.SetStreamingServiceWithPolicy(streamingService, policy)
 		// kick off the background streaming service loop
 		streamingService.Stream(wg)
 		// add to the list of active streamers
@@ -127,6 +194,38 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 	return activeStreamers, wg, nil
 }
 
+// ListenerPolicyFromString returns the baseapp.ListenerPolicy corresponding to the provided name,
+// an empty name selects the fire and forget policy
+func ListenerPolicyFromString(name string) (baseapp.ListenerPolicy, error) {
+	switch strings.ToLower(name) {
+	case "", "fire_and_forget":
+		return baseapp.ListenerPolicyFireAndForget, nil
+	case "halt":
+		return baseapp.ListenerPolicyHalt, nil
+	case "block_commit":
+		return baseapp.ListenerPolicyBlockCommit, nil
+	default:
+		return 0, fmt.Errorf("unrecognized streaming listener policy %s", name)
+	}
+}
+
+// wrapBuffered wraps the streaming service with a durable buffer if `streamers.x.buffer_dir` is set
+func wrapBuffered(streamingService baseapp.StreamingService, appOpts serverTypes.AppOptions, streamerName string, appCodec codec.BinaryCodec) (baseapp.StreamingService, error) {
+	bufferDir := cast.ToString(appOpts.Get(fmt.Sprintf("streamers.%s.buffer_dir", streamerName)))
+	if bufferDir == "" {
+		return streamingService, nil
+	}
+
+	retryInterval := cast.ToDuration(appOpts.Get(fmt.Sprintf("streamers.%s.retry_interval", streamerName)))
+	bufferedService, err := buffered.NewStreamingService(streamingService, bufferDir, retryInterval, appCodec)
+	if err != nil {
+		streamingService.Close()
+		return nil, err
+	}
+
+	return bufferedService, nil
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
@@ -9,6 +9,8 @@ import (
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/streaming/kafka"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +52,41 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(mapOptions{"streamers.grpc.address": "127.0.0.1:0"}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	require.Len(t, serv.Listeners(), len(mockKeys))
+	require.NoError(t, serv.Close())
+}
+
+func TestKafkaStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("kafka")
+	require.Nil(t, err)
+
+	// the broker and topic are required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+	_, err = constructor(mapOptions{"streamers.kafka.broker": "127.0.0.1:9092"}, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	serv, err := constructor(mapOptions{
+		"streamers.kafka.broker": "127.0.0.1:9092",
+		"streamers.kafka.topic":  "abci",
+	}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &kafka.StreamingService{}, serv)
+	require.Len(t, serv.Listeners(), len(mockKeys))
+	require.NoError(t, serv.Close())
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := testutil.MakeTestEncodingConfig()
@@ -59,6 +96,7 @@ func TestLoadStreamingServices(t *testing.T) {
 	testCases := map[string]struct {
 		appOpts            serverTypes.AppOptions
 		activeStreamersLen int
+		expectErr          bool
 	}{
 		"empty app options": {
 			appOpts: simtestutil.EmptyAppOptions{},
@@ -74,11 +112,31 @@ func TestLoadStreamingServices(t *testing.T) {
 		"not exposing anything": {
 			appOpts: streamingAppOptions{keys: []string{"mockKey3"}},
 		},
+		"halt policy": {
+			appOpts:            streamingAppOptions{keys: []string{"*"}, policy: "halt"},
+			activeStreamersLen: 1,
+		},
+		"block commit policy with a durable buffer": {
+			appOpts:            streamingAppOptions{keys: []string{"*"}, policy: "block_commit", bufferDir: t.TempDir()},
+			activeStreamersLen: 1,
+		},
+		"block commit policy without acknowledgements": {
+			appOpts:   streamingAppOptions{keys: []string{"*"}, policy: "block_commit"},
+			expectErr: true,
+		},
+		"unknown policy": {
+			appOpts:   streamingAppOptions{keys: []string{"*"}, policy: "unknown"},
+			expectErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			activeStreamers, _, err := streaming.LoadStreamingServices(bApp, tc.appOpts, encCdc.Codec, keys)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 			require.Equal(t, tc.activeStreamersLen, len(activeStreamers))
 		})
@@ -86,7 +144,9 @@ func TestLoadStreamingServices(t *testing.T) {
 }
 
 type streamingAppOptions struct {
-	keys []string
+	keys      []string
+	policy    string
+	bufferDir string
 }
 
 func (ao streamingAppOptions) Get(o string) interface{} {
@@ -95,6 +155,10 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return []string{"file"}
 	case "streamers.file.keys":
 		return ao.keys
+	case "streamers.file.policy":
+		return ao.policy
+	case "streamers.file.buffer_dir":
+		return ao.bufferDir
 	default:
 		return nil
 	}
@@ -0,0 +1,201 @@
+package file
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// recordHeaderSize is the size of the header preceding each record: the
+	// big-endian uint32 length of the payload followed by its CRC-32C checksum
+	recordHeaderSize = 8
+
+	segmentFilePrefix  = "segment-"
+	segmentFileSuffix  = ".log"
+	manifestFilePrefix = "block-"
+	manifestFileSuffix = ".manifest"
+	manifestTempSuffix = ".tmp"
+	filePermissions    = 0o600
+)
+
+// the kinds of records, stored in the first byte of their payload
+const (
+	recordBeginBlock byte = iota + 1
+	recordDeliverTx
+	recordEndBlock
+)
+
+var (
+	// ErrTruncated is returned when a record or block is cut short, e.g. by a crash mid-write
+	ErrTruncated = errors.New("truncated record")
+	// ErrChecksumMismatch is returned when the checksum of a record or block does not match its content
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrHeightNotFound is returned when no complete block is available for a height
+	ErrHeightNotFound = errors.New("height not found")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Manifest describes the records of a block height written to a segment file.
+// It is written once all the records of the height are synced to disk, so a height
+// without a manifest is incomplete and must not be consumed.
+type Manifest struct {
+	Height   int64  `json:"height"`   // the block height
+	Segment  string `json:"segment"`  // the name of the segment file holding the records
+	Offset   int64  `json:"offset"`   // the offset of the first record of the height in the segment
+	Size     int64  `json:"size"`     // the size of the records of the height
+	Records  int    `json:"records"`  // the number of records of the height
+	Checksum uint32 `json:"checksum"` // the CRC-32C checksum of the records of the height
+}
+
+// encodeRecord returns the payload preceded by its length and checksum
+func encodeRecord(payload []byte) []byte {
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:recordHeaderSize], crc32.Checksum(payload, crcTable))
+	return append(record, payload...)
+}
+
+// decodeRecords returns the payloads of the records contained in bz, verifying their checksums
+func decodeRecords(bz []byte) ([][]byte, error) {
+	var payloads [][]byte
+	for len(bz) > 0 {
+		if len(bz) < recordHeaderSize {
+			return nil, ErrTruncated
+		}
+		size := binary.BigEndian.Uint32(bz[:4])
+		checksum := binary.BigEndian.Uint32(bz[4:recordHeaderSize])
+		bz = bz[recordHeaderSize:]
+		if uint64(size) > uint64(len(bz)) {
+			return nil, ErrTruncated
+		}
+		payload := bz[:size]
+		if crc32.Checksum(payload, crcTable) != checksum {
+			return nil, ErrChecksumMismatch
+		}
+		payloads = append(payloads, payload)
+		bz = bz[size:]
+	}
+	return payloads, nil
+}
+
+// withPrefix prepends the optional file prefix to the file name
+func withPrefix(filePrefix, name string) string {
+	if filePrefix == "" {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", filePrefix, name)
+}
+
+// segmentFileName returns the name of the segment file starting at the given height
+func segmentFileName(filePrefix string, start int64) string {
+	return withPrefix(filePrefix, fmt.Sprintf("%s%020d%s", segmentFilePrefix, start, segmentFileSuffix))
+}
+
+// manifestFileName returns the name of the manifest file of the given height
+func manifestFileName(filePrefix string, height int64) string {
+	return withPrefix(filePrefix, fmt.Sprintf("%s%d%s", manifestFilePrefix, height, manifestFileSuffix))
+}
+
+// parseFileName returns the height encoded in a file name built by withPrefix from the
+// given name prefix and suffix, and whether the name matched
+func parseFileName(filePrefix, namePrefix, nameSuffix, fileName string) (int64, bool) {
+	name := fileName
+	if filePrefix != "" {
+		if !strings.HasPrefix(name, filePrefix+"-") {
+			return 0, false
+		}
+		name = strings.TrimPrefix(name, filePrefix+"-")
+	}
+	if !strings.HasPrefix(name, namePrefix) || !strings.HasSuffix(name, nameSuffix) {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), nameSuffix), 10, 64)
+	if err != nil || height < 0 {
+		return 0, false
+	}
+	return height, true
+}
+
+// listHeights returns the sorted heights of the files of dir matching the name prefix and suffix
+func listHeights(dir, filePrefix, namePrefix, nameSuffix string) ([]int64, error) {
+	// an empty directory is the working directory, as with the files written to it
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var heights []int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if height, ok := parseFileName(filePrefix, namePrefix, nameSuffix, entry.Name()); ok {
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// listManifests returns the sorted heights that have a manifest in dir
+func listManifests(dir, filePrefix string) ([]int64, error) {
+	return listHeights(dir, filePrefix, manifestFilePrefix, manifestFileSuffix)
+}
+
+// listSegments returns the sorted start heights of the segment files in dir
+func listSegments(dir, filePrefix string) ([]int64, error) {
+	return listHeights(dir, filePrefix, segmentFilePrefix, segmentFileSuffix)
+}
+
+// readManifest reads the manifest of the given height, it returns ErrHeightNotFound if there is none
+func readManifest(dir, filePrefix string, height int64) (Manifest, error) {
+	var manifest Manifest
+	bz, err := os.ReadFile(filepath.Join(dir, manifestFileName(filePrefix, height)))
+	if os.IsNotExist(err) {
+		return manifest, fmt.Errorf("%w: %d", ErrHeightNotFound, height)
+	}
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid manifest of height %d: %w", height, err)
+	}
+	return manifest, nil
+}
+
+// writeManifest atomically writes the manifest of its height by syncing it to a temporary
+// file that is renamed into place
+func writeManifest(dir, filePrefix string, manifest Manifest) error {
+	bz, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, manifestFileName(filePrefix, manifest.Height))
+	f, err := os.OpenFile(path+manifestTempSuffix, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(path+manifestTempSuffix, path)
+}
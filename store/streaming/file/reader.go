@@ -0,0 +1,203 @@
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// BeginBlock holds a BeginBlock request and response and the resulting state changes
+type BeginBlock struct {
+	Request      abci.RequestBeginBlock
+	Response     abci.ResponseBeginBlock
+	StateChanges []types.StoreKVPair
+}
+
+// DeliverTx holds a DeliverTx request and response and the resulting state changes
+type DeliverTx struct {
+	Request      abci.RequestDeliverTx
+	Response     abci.ResponseDeliverTx
+	StateChanges []types.StoreKVPair
+}
+
+// EndBlock holds an EndBlock request and response and the resulting state changes
+type EndBlock struct {
+	Request      abci.RequestEndBlock
+	Response     abci.ResponseEndBlock
+	StateChanges []types.StoreKVPair
+}
+
+// Block holds everything written by the StreamingService for a block height
+type Block struct {
+	Height     int64
+	BeginBlock BeginBlock
+	DeliverTxs []DeliverTx
+	EndBlock   EndBlock
+}
+
+// Reader reads the blocks written by the file StreamingService
+// Only the heights whose manifest was written are visible, and their records are
+// verified against their checksums before being decoded
+type Reader struct {
+	readDir    string            // directory the files are read from
+	filePrefix string            // optional prefix of the files
+	codec      codec.BinaryCodec // marshaller used for decoding the ABCI messages
+}
+
+// NewReader creates a new Reader of the files written to readDir with the (optional) filePrefix
+func NewReader(readDir, filePrefix string, c codec.BinaryCodec) *Reader {
+	return &Reader{
+		readDir:    readDir,
+		filePrefix: filePrefix,
+		codec:      c,
+	}
+}
+
+// Heights returns the sorted heights that are available to read
+func (r *Reader) Heights() ([]int64, error) {
+	return listManifests(r.readDir, r.filePrefix)
+}
+
+// ReadBlock reads the block of the given height
+// It returns ErrHeightNotFound if the height is not complete or has been pruned
+func (r *Reader) ReadBlock(height int64) (*Block, error) {
+	manifest, err := readManifest(r.readDir, r.filePrefix, height)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(r.readDir, manifest.Segment))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %d", ErrHeightNotFound, height)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	bz := make([]byte, manifest.Size)
+	if _, err := f.ReadAt(bz, manifest.Offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: height %d", ErrTruncated, height)
+		}
+		return nil, err
+	}
+	if crc32.Checksum(bz, crcTable) != manifest.Checksum {
+		return nil, fmt.Errorf("%w: height %d", ErrChecksumMismatch, height)
+	}
+	records, err := decodeRecords(bz)
+	if err != nil {
+		return nil, fmt.Errorf("%w: height %d", err, height)
+	}
+	if len(records) != manifest.Records {
+		return nil, fmt.Errorf("expected %d records for height %d, got %d", manifest.Records, height, len(records))
+	}
+
+	block := &Block{Height: height}
+	for _, record := range records {
+		if err := r.decodeRecord(block, record); err != nil {
+			return nil, fmt.Errorf("invalid record for height %d: %w", height, err)
+		}
+	}
+	return block, nil
+}
+
+// ReadFrom calls fn with each available block, in order, starting at the given height
+// It can be used to resume consuming the blocks after the last one that was processed, a height of 0
+// starts from the earliest available block. It returns ErrHeightNotFound if the height has been pruned.
+func (r *Reader) ReadFrom(height int64, fn func(*Block) error) error {
+	heights, err := r.Heights()
+	if err != nil {
+		return err
+	}
+	if height > 0 && len(heights) > 0 && height < heights[0] {
+		return fmt.Errorf("%w: %d, the earliest available height is %d", ErrHeightNotFound, height, heights[0])
+	}
+	for _, h := range heights {
+		if h < height {
+			continue
+		}
+		block, err := r.ReadBlock(h)
+		if err != nil {
+			return err
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeRecord decodes the request, state changes and response of the record into the block
+func (r *Reader) decodeRecord(block *Block, record []byte) error {
+	if len(record) == 0 {
+		return ErrTruncated
+	}
+	segments, err := segmentBytes(record[1:])
+	if err != nil {
+		return err
+	}
+	if len(segments) < 2 {
+		return fmt.Errorf("expected a request and a response, got %d messages", len(segments))
+	}
+	req, res := segments[0], segments[len(segments)-1]
+	stateChanges := make([]types.StoreKVPair, len(segments)-2)
+	for i, segment := range segments[1 : len(segments)-1] {
+		if err := r.codec.Unmarshal(segment, &stateChanges[i]); err != nil {
+			return err
+		}
+	}
+
+	switch record[0] {
+	case recordBeginBlock:
+		block.BeginBlock.StateChanges = stateChanges
+		if err := r.codec.Unmarshal(req, &block.BeginBlock.Request); err != nil {
+			return err
+		}
+		return r.codec.Unmarshal(res, &block.BeginBlock.Response)
+	case recordDeliverTx:
+		tx := DeliverTx{StateChanges: stateChanges}
+		if err := r.codec.Unmarshal(req, &tx.Request); err != nil {
+			return err
+		}
+		if err := r.codec.Unmarshal(res, &tx.Response); err != nil {
+			return err
+		}
+		block.DeliverTxs = append(block.DeliverTxs, tx)
+		return nil
+	case recordEndBlock:
+		block.EndBlock.StateChanges = stateChanges
+		if err := r.codec.Unmarshal(req, &block.EndBlock.Request); err != nil {
+			return err
+		}
+		return r.codec.Unmarshal(res, &block.EndBlock.Response)
+	default:
+		return fmt.Errorf("unknown record kind %d", record[0])
+	}
+}
+
+// segmentBytes returns all of the protobuf messages contained in the byte array as an array of byte arrays
+// The messages have their length prefix removed
+func segmentBytes(bz []byte) ([][]byte, error) {
+	segments := make([][]byte, 0)
+	for len(bz) > 0 {
+		size, prefixSize := binary.Uvarint(bz)
+		if prefixSize <= 0 {
+			return nil, fmt.Errorf("invalid number of bytes read from length-prefixed encoding: %d", prefixSize)
+		}
+		if size > uint64(len(bz)-prefixSize) {
+			return nil, fmt.Errorf("not enough bytes to read; want: %v, got: %v", size, len(bz)-prefixSize)
+		}
+		segments = append(segments, bz[prefixSize:uint64(prefixSize)+size])
+		bz = bz[uint64(prefixSize)+size:]
+	}
+	return segments, nil
+}
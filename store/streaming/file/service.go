@@ -3,6 +3,7 @@ package file
 import (
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"os"
 	"path"
 	"path/filepath"
@@ -18,18 +19,33 @@ import (
 
 var _ baseapp.StreamingService = &StreamingService{}
 
+// Config is the configuration of the file StreamingService
+type Config struct {
+	WriteDir          string // directory to write files into
+	FilePrefix        string // optional prefix for each of the generated files
+	MaxSegmentHeights int64  // number of heights after which a segment file is rotated, 0 disables it
+	MaxSegmentSize    int64  // size in bytes after which a segment file is rotated, 0 disables it
+	RetainHeights     int64  // number of recent heights whose rotated segment files are retained, 0 retains all
+	RetainSize        int64  // total size in bytes of the segment files to retain, 0 retains all
+}
+
 // StreamingService is a concrete implementation of StreamingService that writes state changes out to files
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
-	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
+	config             Config                                   // rotation and retention configuration
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
 	stateCache         [][]byte                                 // cache the protobuf binary encoded StoreKVPairs in the order they are received
 	stateCacheLock     *sync.Mutex                              // mutex for the state cache
 	currentBlockNumber int64                                    // the current block number
-	currentTxIndex     int64                                    // the index of the current tx
-	quitChan           chan struct{}                            // channel to synchronize closure
+	segment            *os.File                                 // the segment file the records are appended to
+	segmentStart       int64                                    // the first height of the current segment file
+	segmentSize        int64                                    // the size of the current segment file
+	block              Manifest                                 // the manifest of the block being written
+	blockErr           error                                    // the first error writing the block being written
+	skipBlock          bool                                     // whether the block being written was already written before a restart
+	lastHeight         int64                                    // the last height with a manifest
 }
 
 // IntermediateWriter is used so that we do not need to update the underlying io.Writer
@@ -51,30 +67,93 @@ func (iw *IntermediateWriter) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// cacheWriter is the io.Writer the StoreKVPairWriteListener writes the
+// length-prefixed StoreKVPairs to, it appends them to the state cache.
+type cacheWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (cw cacheWriter) Write(b []byte) (int, error) {
+	cw.fss.stateCacheLock.Lock()
+	cw.fss.stateCache = append(cw.fss.stateCache, b)
+	cw.fss.stateCacheLock.Unlock()
+
+	return len(b), nil
+}
+
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
 func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
-	listenChan := make(chan []byte)
-	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
-	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
-	// in this case, we are using the same listener for each Store
-	for _, key := range storeKeys {
-		listeners[key] = append(listeners[key], listener)
-	}
+	return NewStreamingServiceWithConfig(Config{WriteDir: writeDir, FilePrefix: filePrefix}, storeKeys, c)
+}
+
+// NewStreamingServiceWithConfig creates a new StreamingService for the provided configuration and storeKeys
+// Records left behind by a block that was not completed, e.g. due to a crash, are discarded
+func NewStreamingServiceWithConfig(config Config, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
 	// check that the writeDir exists and is writable so that we can catch the error here at initialization if it is not
-	// we don't open a dstFile until we receive our first ABCI message
-	if err := isDirWriteable(writeDir); err != nil {
+	if err := isDirWriteable(config.WriteDir); err != nil {
 		return nil, err
 	}
-	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
-		filePrefix:     filePrefix,
-		writeDir:       writeDir,
+	fss := &StreamingService{
+		filePrefix:     config.FilePrefix,
+		writeDir:       config.WriteDir,
+		config:         config,
 		codec:          c,
 		stateCache:     make([][]byte, 0),
 		stateCacheLock: new(sync.Mutex),
-	}, nil
+	}
+	listener := types.NewStoreKVPairWriteListener(cacheWriter{fss: fss}, c)
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		fss.listeners[key] = append(fss.listeners[key], listener)
+	}
+	if err := fss.recover(); err != nil {
+		return nil, err
+	}
+	return fss, nil
+}
+
+// recover reopens the segment file of the last complete block, truncating any records written
+// after it, and removes the segment files holding incomplete blocks only
+func (fss *StreamingService) recover() error {
+	heights, err := listManifests(fss.writeDir, fss.filePrefix)
+	if err != nil {
+		return err
+	}
+	if len(heights) > 0 {
+		manifest, err := readManifest(fss.writeDir, fss.filePrefix, heights[len(heights)-1])
+		if err != nil {
+			return err
+		}
+		start, ok := parseFileName(fss.filePrefix, segmentFilePrefix, segmentFileSuffix, manifest.Segment)
+		if !ok {
+			return fmt.Errorf("invalid segment file name %s in the manifest of height %d", manifest.Segment, manifest.Height)
+		}
+		if err := fss.openSegment(start); err != nil {
+			return err
+		}
+		if err := fss.segment.Truncate(manifest.Offset + manifest.Size); err != nil {
+			return err
+		}
+		fss.segmentSize = manifest.Offset + manifest.Size
+		fss.lastHeight = manifest.Height
+	}
+	starts, err := listSegments(fss.writeDir, fss.filePrefix)
+	if err != nil {
+		return err
+	}
+	for _, start := range starts {
+		if fss.segment == nil || start > fss.segmentStart {
+			if err := os.Remove(filepath.Join(fss.writeDir, segmentFileName(fss.filePrefix, start))); err != nil {
+				return err
+			}
+		}
+	}
+	if fss.segment != nil && fss.shouldRotate() {
+		return fss.rotate()
+	}
+	return nil
 }
 
 // Listeners satisfies the baseapp.StreamingService interface
@@ -85,198 +164,212 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 }
 
 // ListenBeginBlock satisfies the baseapp.ABCIListener interface
-// It writes the received BeginBlock request and response and the resulting state changes
-// out to a file as described in the above the naming schema
-func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) (rerr error) {
-	// generate the new file
-	dstFile, err := fss.openBeginBlockFile(req)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		cerr := dstFile.Close()
-		if rerr == nil {
-			rerr = cerr
+// It appends a record with the received BeginBlock request and response and the resulting state changes
+// to the current segment file, opening a new one if the previous was rotated
+func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.currentBlockNumber = req.GetHeader().Height
+	// a block replayed after a restart was already written
+	fss.skipBlock = fss.currentBlockNumber <= fss.lastHeight
+	fss.blockErr = nil
+	if !fss.skipBlock {
+		if fss.segment == nil {
+			if err := fss.openSegment(fss.currentBlockNumber); err != nil {
+				fss.blockErr = err
+			}
+		}
+		fss.block = Manifest{
+			Height:  fss.currentBlockNumber,
+			Segment: segmentFileName(fss.filePrefix, fss.segmentStart),
+			Offset:  fss.segmentSize,
 		}
-	}()
-
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
-		return err
+	return fss.writeRecord(recordBeginBlock, &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It appends a record with the received DeliverTx request and response and the resulting state changes
+// to the current segment file
+func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return fss.writeRecord(recordDeliverTx, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It appends a record with the received EndBlock request and response and the resulting state changes
+// to the current segment file, then syncs it and writes the manifest of the block
+func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	err := fss.writeRecord(recordEndBlock, &req, &res)
+	if ferr := fss.finishBlock(); err == nil {
+		err = ferr
 	}
-	// write all state changes cached for this stage to file
+	return err
+}
+
+// writeRecord appends a record made of the kind, the length-prefixed request, the state changes
+// cached for this stage and the length-prefixed response to the current segment file
+func (fss *StreamingService) writeRecord(kind byte, req, res codec.ProtoMarshaler) error {
+	// reset the cache whether or not the record is written
 	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
-	}
-	// reset cache
+	stateChanges := fss.stateCache
 	fss.stateCache = nil
 	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+
+	if fss.skipBlock {
+		return nil
+	}
+	if fss.blockErr != nil {
+		return fss.blockErr
+	}
+	if fss.segment == nil {
+		fss.blockErr = errors.New("no block in progress")
+		return fss.blockErr
+	}
+
+	payload := []byte{kind}
+	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(req)
 	if err != nil {
 		return err
 	}
-	_, err = dstFile.Write(lengthPrefixedResBytes)
-	return err
-}
-
-func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os.File, error) {
-	fss.currentBlockNumber = req.GetHeader().Height
-	fss.currentTxIndex = 0
-	fileName := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	payload = append(payload, lengthPrefixedReqBytes...)
+	for _, stateChange := range stateChanges {
+		payload = append(payload, stateChange...)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
-}
-
-// ListenDeliverTx satisfies the baseapp.ABCIListener interface
-// It writes the received DeliverTx request and response and the resulting state changes
-// out to a file as described in the above the naming schema
-func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) (rerr error) {
-	// generate the new file
-	dstFile, err := fss.openDeliverTxFile()
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(res)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		cerr := dstFile.Close()
-		if rerr == nil {
-			rerr = cerr
-		}
-	}()
+	payload = append(payload, lengthPrefixedResBytes...)
 
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
+	record := encodeRecord(payload)
+	if _, err := fss.segment.Write(record); err != nil {
+		fss.blockErr = err
 		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
+	fss.segmentSize += int64(len(record))
+	fss.block.Size += int64(len(record))
+	fss.block.Records++
+	fss.block.Checksum = crc32.Update(fss.block.Checksum, crcTable, record)
+	return nil
+}
+
+// finishBlock syncs the records of the block being written and writes its manifest, a block
+// that failed to be written is truncated from the segment file instead
+func (fss *StreamingService) finishBlock() error {
+	if fss.skipBlock || fss.segment == nil {
+		return fss.blockErr
+	}
+	if fss.blockErr != nil {
+		fss.segmentSize = fss.block.Offset
+		return fss.segment.Truncate(fss.block.Offset)
+	}
+	if err := fss.segment.Sync(); err != nil {
 		return err
 	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
+	if err := writeManifest(fss.writeDir, fss.filePrefix, fss.block); err != nil {
+		return err
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	fss.lastHeight = fss.block.Height
+	if fss.shouldRotate() {
+		return fss.rotate()
+	}
+	return nil
+}
+
+// openSegment opens the segment file starting at the given height for appending
+func (fss *StreamingService) openSegment(start int64) error {
+	f, err := os.OpenFile(filepath.Join(fss.writeDir, segmentFileName(fss.filePrefix, start)), os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
 	if err != nil {
 		return err
 	}
-	_, err = dstFile.Write(lengthPrefixedResBytes)
-	return err
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	fss.segment = f
+	fss.segmentStart = start
+	fss.segmentSize = info.Size()
+	return nil
 }
 
-func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+// shouldRotate returns whether the current segment file reached the configured number of heights or size
+func (fss *StreamingService) shouldRotate() bool {
+	return (fss.config.MaxSegmentHeights > 0 && fss.lastHeight-fss.segmentStart+1 >= fss.config.MaxSegmentHeights) ||
+		(fss.config.MaxSegmentSize > 0 && fss.segmentSize >= fss.config.MaxSegmentSize)
 }
 
-// ListenEndBlock satisfies the baseapp.ABCIListener interface
-// It writes the received EndBlock request and response and the resulting state changes
-// out to a file as described in the above the naming schema
-func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) (rerr error) {
-	// generate the new file
-	dstFile, err := fss.openEndBlockFile()
+// rotate closes the current segment file, the next block opens a new one, and removes the
+// segment files falling out of the retention window
+func (fss *StreamingService) rotate() error {
+	err := fss.segment.Close()
+	fss.segment = nil
 	if err != nil {
 		return err
 	}
-	defer func() {
-		cerr := dstFile.Close()
-		if rerr == nil {
-			rerr = cerr
-		}
-	}()
+	return fss.prune()
+}
 
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
+// prune removes the oldest rotated segment files, and the manifests of their heights, which only
+// hold heights older than the retained heights or exceed the retained size
+// The most recent segment file is always retained so that consumers can resume from the last height
+func (fss *StreamingService) prune() error {
+	if fss.config.RetainHeights <= 0 && fss.config.RetainSize <= 0 {
+		return nil
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
+	starts, err := listSegments(fss.writeDir, fss.filePrefix)
+	if err != nil {
 		return err
 	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
+	sizes := make([]int64, len(starts))
+	var totalSize int64
+	for i, start := range starts {
+		info, err := os.Stat(filepath.Join(fss.writeDir, segmentFileName(fss.filePrefix, start)))
+		if err != nil {
 			return err
 		}
+		sizes[i] = info.Size()
+		totalSize += sizes[i]
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	heights, err := listManifests(fss.writeDir, fss.filePrefix)
 	if err != nil {
 		return err
 	}
-	_, err = dstFile.Write(lengthPrefixedResBytes)
-	return err
-}
-
-func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	for i := 0; i < len(starts)-1; i++ {
+		end := starts[i+1] - 1
+		expired := fss.config.RetainHeights > 0 && end <= fss.lastHeight-fss.config.RetainHeights
+		oversized := fss.config.RetainSize > 0 && totalSize > fss.config.RetainSize
+		if !expired && !oversized {
+			break
+		}
+		// remove the manifests first so that consumers never see a height without its records
+		for len(heights) > 0 && heights[0] <= end {
+			if err := os.Remove(filepath.Join(fss.writeDir, manifestFileName(fss.filePrefix, heights[0]))); err != nil {
+				return err
+			}
+			heights = heights[1:]
+		}
+		if err := os.Remove(filepath.Join(fss.writeDir, segmentFileName(fss.filePrefix, starts[i]))); err != nil {
+			return err
+		}
+		totalSize -= sizes[i]
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return nil
 }
 
 // Stream satisfies the baseapp.StreamingService interface
-// It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
-// and caches them in the order they were received
-// returns an error if it is called twice
+// The state changes are cached synchronously by the WriteListeners, so there is no
+// background loop to start
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
-	if fss.quitChan != nil {
-		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
-	}
-	fss.quitChan = make(chan struct{})
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for {
-			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
-				return
-			case by := <-fss.srcChan:
-				fss.stateCacheLock.Lock()
-				fss.stateCache = append(fss.stateCache, by)
-				fss.stateCacheLock.Unlock()
-			}
-		}
-	}()
 	return nil
 }
 
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
 func (fss *StreamingService) Close() error {
-	close(fss.quitChan)
-	return nil
+	if fss.segment == nil {
+		return nil
+	}
+	err := fss.segment.Close()
+	fss.segment = nil
+	return err
 }
 
 // isDirWriteable checks if dir is writable by writing and removing a file
@@ -1,7 +1,6 @@
 package file
 
 import (
-	"encoding/binary"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -142,259 +141,179 @@ func TestFileStreamingService(t *testing.T) {
 	testListener2 = testStreamingService.listeners[mockStoreKey2][0]
 	wg := new(sync.WaitGroup)
 	testStreamingService.Stream(wg)
-	testListenBeginBlock(t)
-	testListenDeliverTx1(t)
-	testListenDeliverTx2(t)
-	testListenEndBlock(t)
-	testStreamingService.Close()
-	wg.Wait()
-}
 
-func testListenBeginBlock(t *testing.T) {
-	expectedBeginBlockReqBytes, err := testMarshaller.Marshal(&testBeginBlockReq)
-	require.Nil(t, err)
-	expectedBeginBlockResBytes, err := testMarshaller.Marshal(&testBeginBlockRes)
-	require.Nil(t, err)
+	reader := NewReader(testDir, testPrefix, testMarshaller)
 
 	// write state changes
 	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
 	testListener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey1, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
+	err = testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
 	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
+	testListener1.OnWrite(mockStoreKey1, mockKey3, nil, true)
+	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1)
 	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
+	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2)
 	require.Nil(t, err)
 
-	// send the ABCI messages
-	err = testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
-	require.Nil(t, err)
+	// the block is not visible until it is complete
+	_, err = reader.ReadBlock(testBeginBlockReq.Header.Height)
+	require.ErrorIs(t, err, ErrHeightNotFound)
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-begin", testPrefix, testBeginBlockReq.GetHeader().Height)
-	fileBytes, err := readInFile(fileName)
+	testListener2.OnWrite(mockStoreKey2, mockKey1, mockValue1, false)
+	err = testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes)
 	require.Nil(t, err)
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
-	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedBeginBlockReqBytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedBeginBlockResBytes, segments[4])
+	// the manifest and segment files are created with the expected names
+	_, err = os.Stat(filepath.Join(testDir, fmt.Sprintf("%s-block-%d.manifest", testPrefix, testEndBlockReq.Height)))
+	require.Nil(t, err)
+	_, err = os.Stat(filepath.Join(testDir, fmt.Sprintf("%s-segment-%020d.log", testPrefix, testBeginBlockReq.Header.Height)))
+	require.Nil(t, err)
+
+	block, err := reader.ReadBlock(testBeginBlockReq.Header.Height)
+	require.Nil(t, err)
+	require.Equal(t, testBeginBlockReq.Header.Height, block.Height)
+	requireEqualMessages(t, &testBeginBlockReq, &block.BeginBlock.Request)
+	requireEqualMessages(t, &testBeginBlockRes, &block.BeginBlock.Response)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+	}, block.BeginBlock.StateChanges)
+	require.Len(t, block.DeliverTxs, 2)
+	requireEqualMessages(t, &testDeliverTxReq1, &block.DeliverTxs[0].Request)
+	requireEqualMessages(t, &testDeliverTxRes1, &block.DeliverTxs[0].Response)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey3, Delete: true},
+	}, block.DeliverTxs[0].StateChanges)
+	requireEqualMessages(t, &testDeliverTxReq2, &block.DeliverTxs[1].Request)
+	requireEqualMessages(t, &testDeliverTxRes2, &block.DeliverTxs[1].Response)
+	require.Empty(t, block.DeliverTxs[1].StateChanges)
+	requireEqualMessages(t, &testEndBlockReq, &block.EndBlock.Request)
+	requireEqualMessages(t, &testEndBlockRes, &block.EndBlock.Response)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey1, Value: mockValue1},
+	}, block.EndBlock.StateChanges)
+
+	testStreamingService.Close()
+	wg.Wait()
 }
 
-func testListenDeliverTx1(t *testing.T) {
-	expectedDeliverTxReq1Bytes, err := testMarshaller.Marshal(&testDeliverTxReq1)
-	require.Nil(t, err)
-	expectedDeliverTxRes1Bytes, err := testMarshaller.Marshal(&testDeliverTxRes1)
+func TestFileStreamingServiceRecovery(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, "", []types.StoreKey{mockStoreKey1}, testMarshaller)
 	require.Nil(t, err)
+	listenBlock(t, fss, 1)
 
-	// write state changes
-	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
-	require.Nil(t, err)
+	// a crash in the middle of height 2 leaves a truncated record behind
+	require.Nil(t, fss.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: types1.Header{Height: 2}}, abci.ResponseBeginBlock{}))
+	require.Nil(t, fss.segment.Truncate(fss.segmentSize-1))
+	require.Nil(t, fss.Close())
 
-	// send the ABCI messages
-	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1)
+	reader := NewReader(dir, "", testMarshaller)
+	heights, err := reader.Heights()
 	require.Nil(t, err)
+	require.Equal(t, []int64{1}, heights)
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-tx-%d", testPrefix, testBeginBlockReq.GetHeader().Height, 0)
-	fileBytes, err := readInFile(fileName)
+	// the incomplete height is discarded on restart and written again
+	fss, err = NewStreamingService(dir, "", []types.StoreKey{mockStoreKey1}, testMarshaller)
 	require.Nil(t, err)
+	listenBlock(t, fss, 1)
+	listenBlock(t, fss, 2)
+	require.Nil(t, fss.Close())
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
+	var read []int64
+	err = reader.ReadFrom(0, func(block *Block) error {
+		read = append(read, block.Height)
+		require.Len(t, block.DeliverTxs, 1)
+		require.Equal(t, []byte{byte(block.Height)}, block.BeginBlock.StateChanges[0].Value)
+		return nil
+	})
 	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedDeliverTxReq1Bytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedDeliverTxRes1Bytes, segments[4])
+	require.Equal(t, []int64{1, 2}, read)
 }
 
-func testListenDeliverTx2(t *testing.T) {
-	expectedDeliverTxReq2Bytes, err := testMarshaller.Marshal(&testDeliverTxReq2)
-	require.Nil(t, err)
-	expectedDeliverTxRes2Bytes, err := testMarshaller.Marshal(&testDeliverTxRes2)
+func TestFileStreamingServiceChecksum(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, "", []types.StoreKey{mockStoreKey1}, testMarshaller)
 	require.Nil(t, err)
+	listenBlock(t, fss, 1)
+	require.Nil(t, fss.Close())
 
-	// write state changes
-	testListener1.OnWrite(mockStoreKey2, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
+	// corrupt the last byte of the block
+	path := filepath.Join(dir, segmentFileName("", 1))
+	bz, err := os.ReadFile(path)
 	require.Nil(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.Nil(t, os.WriteFile(path, bz, 0o600))
 
-	// send the ABCI messages
-	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2)
-	require.Nil(t, err)
+	_, err = NewReader(dir, "", testMarshaller).ReadBlock(1)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+}
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-tx-%d", testPrefix, testBeginBlockReq.GetHeader().Height, 1)
-	fileBytes, err := readInFile(fileName)
+func TestFileStreamingServiceRotationAndRetention(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingServiceWithConfig(Config{
+		WriteDir:          dir,
+		FilePrefix:        testPrefix,
+		MaxSegmentHeights: 3,
+		RetainHeights:     5,
+	}, []types.StoreKey{mockStoreKey1}, testMarshaller)
 	require.Nil(t, err)
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
-	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedDeliverTxReq2Bytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedDeliverTxRes2Bytes, segments[4])
-}
+	for height := int64(1); height <= 10; height++ {
+		listenBlock(t, fss, height)
+	}
+	require.Nil(t, fss.Close())
 
-func testListenEndBlock(t *testing.T) {
-	expectedEndBlockReqBytes, err := testMarshaller.Marshal(&testEndBlockReq)
-	require.Nil(t, err)
-	expectedEndBlockResBytes, err := testMarshaller.Marshal(&testEndBlockRes)
+	// segments are rotated every 3 heights and the ones older than the last 5 heights are removed
+	starts, err := listSegments(dir, testPrefix)
 	require.Nil(t, err)
+	require.Equal(t, []int64{4, 7, 10}, starts)
 
-	// write state changes
-	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
+	reader := NewReader(dir, testPrefix, testMarshaller)
+	heights, err := reader.Heights()
 	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
+	require.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, heights)
+
+	// consumers resume from the last height they processed
+	var read []int64
+	err = reader.ReadFrom(8, func(block *Block) error {
+		read = append(read, block.Height)
+		return nil
 	})
 	require.Nil(t, err)
+	require.Equal(t, []int64{8, 9, 10}, read)
 
-	// send the ABCI messages
-	err = testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes)
-	require.Nil(t, err)
+	// pruned heights cannot be resumed from
+	err = reader.ReadFrom(2, func(*Block) error { return nil })
+	require.ErrorIs(t, err, ErrHeightNotFound)
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-end", testPrefix, testEndBlockReq.Height)
-	fileBytes, err := readInFile(fileName)
+	// rotation by size
+	dir = t.TempDir()
+	fss, err = NewStreamingServiceWithConfig(Config{WriteDir: dir, MaxSegmentSize: 1}, []types.StoreKey{mockStoreKey1}, testMarshaller)
 	require.Nil(t, err)
-
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
+	for height := int64(1); height <= 3; height++ {
+		listenBlock(t, fss, height)
+	}
+	require.Nil(t, fss.Close())
+	starts, err = listSegments(dir, "")
 	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedEndBlockReqBytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedEndBlockResBytes, segments[4])
+	require.Equal(t, []int64{1, 2, 3}, starts)
 }
 
-func readInFile(name string) ([]byte, error) {
-	path := filepath.Join(testDir, name)
-	return os.ReadFile(path)
+// listenBlock streams a block of the given height with a single tx and state change
+func listenBlock(t *testing.T, fss *StreamingService, height int64) {
+	listener := fss.Listeners()[mockStoreKey1][0]
+	require.Nil(t, listener.OnWrite(mockStoreKey1, mockKey1, []byte{byte(height)}, false))
+	require.Nil(t, fss.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: types1.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.Nil(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1))
+	require.Nil(t, fss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
 }
 
-// segmentBytes returns all of the protobuf messages contained in the byte array as an array of byte arrays
-// The messages have their length prefix removed
-func segmentBytes(bz []byte) ([][]byte, error) {
-	var err error
-	segments := make([][]byte, 0)
-	for len(bz) > 0 {
-		var segment []byte
-		segment, bz, err = getHeadSegment(bz)
-		if err != nil {
-			return nil, err
-		}
-		segments = append(segments, segment)
-	}
-	return segments, nil
-}
-
-// getHeadSegment returns the bytes for the leading protobuf object in the byte array (removing the length prefix) and returns the remainder of the byte array
-func getHeadSegment(bz []byte) ([]byte, []byte, error) {
-	size, prefixSize := binary.Uvarint(bz)
-	if prefixSize < 0 {
-		return nil, nil, fmt.Errorf("invalid number of bytes read from length-prefixed encoding: %d", prefixSize)
-	}
-	if size > uint64(len(bz)-prefixSize) {
-		return nil, nil, fmt.Errorf("not enough bytes to read; want: %v, got: %v", size, len(bz)-prefixSize)
-	}
-	return bz[prefixSize:(uint64(prefixSize) + size)], bz[uint64(prefixSize)+size:], nil
+// requireEqualMessages checks that both messages have the same encoding
+func requireEqualMessages(t *testing.T, expected, actual codec.ProtoMarshaler) {
+	expectedBytes, err := testMarshaller.Marshal(expected)
+	require.Nil(t, err)
+	actualBytes, err := testMarshaller.Marshal(actual)
+	require.Nil(t, err)
+	require.Equal(t, expectedBytes, actualBytes)
 }
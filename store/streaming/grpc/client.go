@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ServiceName is the fully-qualified name of the gRPC service exposed by the
+// StreamingService.
+const ServiceName = "cosmos.base.store.v1beta1.StreamingService"
+
+// SubscribeClient receives the events pushed by a StreamingService.
+type SubscribeClient struct {
+	stream grpc.ClientStream
+}
+
+// Subscribe subscribes to the StreamingService served on the provided
+// connection. The subscription ends when ctx is canceled.
+func Subscribe(ctx context.Context, conn grpc.ClientConnInterface) (*SubscribeClient, error) {
+	stream, err := conn.NewStream(
+		ctx,
+		&serviceDesc.Streams[0],
+		"/"+ServiceName+"/Subscribe",
+		grpc.ForceCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.SendMsg(&gogotypes.Empty{}); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	return &SubscribeClient{stream: stream}, nil
+}
+
+// Recv returns the next event pushed by the StreamingService, i.e. an ABCI
+// request or response, or a StoreKVPair, wrapped in an Any.
+func (c *SubscribeClient) Recv() (*codectypes.Any, error) {
+	event := new(codectypes.Any)
+	if err := c.stream.RecvMsg(event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
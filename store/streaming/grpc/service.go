@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"errors"
+	"net"
+	"sync"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBufferSize is the default number of events buffered for each subscriber
+// before the StreamingService blocks the state machine.
+const DefaultBufferSize = 1000
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ types.WriteListener      = &StreamingService{}
+)
+
+// StreamingService is a concrete implementation of StreamingService that pushes
+// the ABCI messages and the resulting state changes to the clients subscribed
+// through its gRPC server.
+//
+// Each ABCI message is pushed as a google.protobuf.Any wrapping, in order, the
+// ABCI request, the StoreKVPairs for the state changes it caused, and the ABCI
+// response. Clients start receiving events at the next BeginBlock after they
+// subscribed. The events of a subscriber are buffered up to a limit, after
+// which the state machine blocks until the subscriber catches up or
+// disconnects, so that slow clients never miss events.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	listener   net.Listener                             // the listener the gRPC server is served on
+	server     *grpc.Server                             // the gRPC server clients subscribe through
+	bufferSize int                                      // number of events buffered for each subscriber
+
+	stateCache     []*codectypes.Any // the StoreKVPairs in the order they are received
+	stateCacheLock sync.Mutex        // mutex for the state cache
+
+	subscribers     map[*subscriber]struct{} // the subscribed clients
+	subscribersLock sync.Mutex               // mutex for the subscribers
+
+	quitChan chan struct{} // channel to synchronize closure
+}
+
+// subscriber holds the events pending delivery to a subscribed client.
+type subscriber struct {
+	events chan *codectypes.Any
+	done   <-chan struct{}
+	// started is set once the subscriber received a BeginBlock, it is only
+	// accessed by the ABCI listening hooks.
+	started bool
+}
+
+// NewStreamingService creates a new StreamingService serving subscriptions on
+// the provided address for the state changes of the provided storeKeys. A
+// bufferSize <= 0 uses the DefaultBufferSize.
+func NewStreamingService(address string, bufferSize int, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	// listen here so that we can catch the error at initialization
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	gss := &StreamingService{
+		listener:    listener,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+		quitChan:    make(chan struct{}),
+	}
+
+	gss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		gss.listeners[key] = append(gss.listeners[key], gss)
+	}
+
+	gss.server = grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()))
+	gss.server.RegisterService(&serviceDesc, gss)
+
+	return gss, nil
+}
+
+// Addr returns the network address the gRPC server listens on.
+func (gss *StreamingService) Addr() net.Addr {
+	return gss.listener.Addr()
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface by caching the state
+// change until the next ABCI listening hook pushes it.
+func (gss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair, err := codectypes.NewAnyWithValue(&types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	if err != nil {
+		return err
+	}
+
+	gss.stateCacheLock.Lock()
+	gss.stateCache = append(gss.stateCache, kvPair)
+	gss.stateCacheLock.Unlock()
+
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received BeginBlock request and response and the resulting state changes
+// to the subscribers
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	return gss.push(&req, &res, true)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It pushes the received DeliverTx request and response and the resulting state changes
+// to the subscribers
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return gss.push(&req, &res, false)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received EndBlock request and response and the resulting state changes
+// to the subscribers
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return gss.push(&req, &res, false)
+}
+
+// push sends the request, the cached state changes and the response to every
+// subscriber, blocking until each of them buffered the events or disconnected.
+func (gss *StreamingService) push(req, res codec.ProtoMarshaler, beginBlock bool) error {
+	gss.stateCacheLock.Lock()
+	stateChanges := gss.stateCache
+	gss.stateCache = nil
+	gss.stateCacheLock.Unlock()
+
+	reqAny, err := codectypes.NewAnyWithValue(req)
+	if err != nil {
+		return err
+	}
+	resAny, err := codectypes.NewAnyWithValue(res)
+	if err != nil {
+		return err
+	}
+
+	events := make([]*codectypes.Any, 0, len(stateChanges)+2)
+	events = append(events, reqAny)
+	events = append(events, stateChanges...)
+	events = append(events, resAny)
+
+	gss.subscribersLock.Lock()
+	subscribers := make([]*subscriber, 0, len(gss.subscribers))
+	for sub := range gss.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	gss.subscribersLock.Unlock()
+
+	for _, sub := range subscribers {
+		if beginBlock {
+			sub.started = true
+		}
+		if !sub.started {
+			continue
+		}
+
+	events:
+		for _, event := range events {
+			select {
+			case sub.events <- event:
+			case <-sub.done:
+				break events
+			case <-gss.quitChan:
+				return errors.New("streaming service is closed")
+			}
+		}
+	}
+
+	return nil
+}
+
+// subscribe registers a new subscriber and sends its events over the stream
+// until the client disconnects or the service is closed.
+func (gss *StreamingService) subscribe(stream grpc.ServerStream) error {
+	sub := &subscriber{
+		events: make(chan *codectypes.Any, gss.bufferSize),
+		done:   stream.Context().Done(),
+	}
+
+	gss.subscribersLock.Lock()
+	gss.subscribers[sub] = struct{}{}
+	gss.subscribersLock.Unlock()
+
+	defer func() {
+		gss.subscribersLock.Lock()
+		delete(gss.subscribers, sub)
+		gss.subscribersLock.Unlock()
+	}()
+
+	for {
+		select {
+		case <-gss.quitChan:
+			return nil
+		case <-sub.done:
+			return stream.Context().Err()
+		case event := <-sub.events:
+			if err := stream.SendMsg(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up a goroutine serving the gRPC subscriptions
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// the error returned once the server is stopped is irrelevant
+		_ = gss.server.Serve(gss.listener)
+	}()
+
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (gss *StreamingService) Close() error {
+	close(gss.quitChan)
+	gss.server.Stop()
+	return nil
+}
+
+// serviceDesc describes the server-streaming Subscribe method of the
+// StreamingService. It takes a google.protobuf.Empty and streams
+// google.protobuf.Any events.
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       subscribeHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+func subscribeHandler(srv interface{}, stream grpc.ServerStream) error {
+	if err := stream.RecvMsg(new(gogotypes.Empty)); err != nil {
+		return err
+	}
+
+	return srv.(*StreamingService).subscribe(stream)
+}
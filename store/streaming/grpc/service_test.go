@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey  = sdk.NewKVStoreKey("mockStore")
+	emptyContext  = sdk.Context{}
+	testBeginReq  = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testBeginRes  = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "testEventType"}}}
+	testTxReq     = abci.RequestDeliverTx{Tx: []byte{1, 2, 3}}
+	testTxRes     = abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	testEndReq    = abci.RequestEndBlock{Height: 1}
+	testEndRes    = abci.ResponseEndBlock{}
+	testKVPair    = types.StoreKVPair{StoreKey: mockStoreKey.Name(), Key: []byte("key"), Value: []byte("value")}
+	testDelKVPair = types.StoreKVPair{StoreKey: mockStoreKey.Name(), Key: []byte("key"), Delete: true}
+)
+
+func newTestService(t *testing.T, bufferSize int) (*StreamingService, *SubscribeClient) {
+	gss, err := NewStreamingService("127.0.0.1:0", bufferSize, []types.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	require.Len(t, gss.Listeners()[mockStoreKey], 1)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, gss.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, gss.Close())
+		wg.Wait()
+	})
+
+	conn, err := grpc.Dial(gss.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client, err := Subscribe(ctx, conn)
+	require.NoError(t, err)
+
+	// wait for the server to register the subscription
+	require.Eventually(t, func() bool {
+		gss.subscribersLock.Lock()
+		defer gss.subscribersLock.Unlock()
+		return len(gss.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return gss, client
+}
+
+func requireEvent(t *testing.T, client *SubscribeClient, expected codec.ProtoMarshaler) {
+	event, err := client.Recv()
+	require.NoError(t, err)
+
+	expectedAny, err := codectypes.NewAnyWithValue(expected)
+	require.NoError(t, err)
+	require.Equal(t, expectedAny.TypeUrl, event.TypeUrl)
+	require.True(t, bytes.Equal(expectedAny.Value, event.Value))
+}
+
+func TestStreamingService(t *testing.T) {
+	gss, client := newTestService(t, 0)
+	listener := gss.Listeners()[mockStoreKey][0]
+
+	// a subscriber does not receive events before the next BeginBlock
+	require.NoError(t, gss.ListenEndBlock(emptyContext, testEndReq, testEndRes))
+
+	require.NoError(t, listener.OnWrite(mockStoreKey, testKVPair.Key, testKVPair.Value, false))
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginReq, testBeginRes))
+	require.NoError(t, listener.OnWrite(mockStoreKey, testDelKVPair.Key, nil, true))
+	require.NoError(t, gss.ListenDeliverTx(emptyContext, testTxReq, testTxRes))
+	require.NoError(t, gss.ListenEndBlock(emptyContext, testEndReq, testEndRes))
+
+	requireEvent(t, client, &testBeginReq)
+	requireEvent(t, client, &testKVPair)
+	requireEvent(t, client, &testBeginRes)
+	requireEvent(t, client, &testTxReq)
+	requireEvent(t, client, &testDelKVPair)
+	requireEvent(t, client, &testTxRes)
+	requireEvent(t, client, &testEndReq)
+	requireEvent(t, client, &testEndRes)
+}
+
+func TestStreamingServiceBackpressure(t *testing.T) {
+	gss, err := NewStreamingService("127.0.0.1:0", 1, []types.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	defer gss.Close()
+
+	// a subscriber whose buffer is never drained by a stream
+	sub := &subscriber{events: make(chan *codectypes.Any, 1), done: make(chan struct{})}
+	gss.subscribers[sub] = struct{}{}
+
+	done := make(chan error)
+	go func() {
+		done <- gss.ListenBeginBlock(emptyContext, testBeginReq, testBeginRes)
+	}()
+
+	// the hook blocks until the subscriber consumed the events exceeding its buffer
+	select {
+	case <-done:
+		t.Fatal("expected ListenBeginBlock to block on a full subscriber buffer")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	reqAny, err := codectypes.NewAnyWithValue(&testBeginReq)
+	require.NoError(t, err)
+	require.Equal(t, reqAny.TypeUrl, (<-sub.events).TypeUrl)
+	require.NoError(t, <-done)
+	require.Len(t, sub.events, 1)
+}
@@ -0,0 +1,158 @@
+package kafka
+
+import (
+	"fmt"
+	"hash/crc32"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockBroker is an in-process stand-in for a Kafka broker. It accepts Produce
+// v0 requests, validates the encoded messages and records them per topic
+// partition.
+type mockBroker struct {
+	t        *testing.T
+	listener net.Listener
+
+	mtx       sync.Mutex
+	messages  map[string][]Message
+	errorCode int16
+}
+
+func newMockBroker(t *testing.T) *mockBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &mockBroker{t: t, listener: listener, messages: make(map[string][]Message)}
+	go b.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return b
+}
+
+func (b *mockBroker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+func (b *mockBroker) Messages(topic string, partition int32) []Message {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.messages[fmt.Sprintf("%s/%d", topic, partition)]
+}
+
+func (b *mockBroker) SetErrorCode(code int16) {
+	b.mtx.Lock()
+	b.errorCode = code
+	b.mtx.Unlock()
+}
+
+func (b *mockBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go b.handle(conn)
+	}
+}
+
+func (b *mockBroker) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		req, err := readFrame(conn)
+		if err != nil {
+			return
+		}
+
+		res, ok := b.handleProduce(req)
+		if !ok {
+			continue
+		}
+
+		frame := appendInt32(nil, int32(len(res)))
+		if _, err := conn.Write(append(frame, res...)); err != nil {
+			return
+		}
+	}
+}
+
+// handleProduce decodes a Produce v0 request and returns the response, if any
+// is expected.
+func (b *mockBroker) handleProduce(req []byte) ([]byte, bool) {
+	d := decoder{buf: req}
+
+	apiKey, apiVersion, correlationID := d.int16(), d.int16(), d.int32()
+	d.string() // client id
+	acks := d.int16()
+	d.int32() // timeout
+
+	require.Equal(b.t, apiKeyProduce, apiKey)
+	require.Equal(b.t, apiVersionProduce, apiVersion)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	res := appendInt32(nil, correlationID)
+	topics := d.int32()
+	res = appendInt32(res, topics)
+	for ; topics > 0; topics-- {
+		topic := d.string()
+		res = appendString(res, topic)
+
+		partitions := d.int32()
+		res = appendInt32(res, partitions)
+		for ; partitions > 0; partitions-- {
+			partition := d.int32()
+			messageSet := decoder{buf: d.next(int(d.int32()))}
+
+			key := fmt.Sprintf("%s/%d", topic, partition)
+			offset := int64(len(b.messages[key]))
+			for len(messageSet.buf) > 0 {
+				messageSet.int64() // offset
+				message := decoder{buf: messageSet.next(int(messageSet.int32()))}
+				crc := uint32(message.int32())
+				require.Equal(b.t, crc32.ChecksumIEEE(message.buf), crc)
+				require.Equal(b.t, messageMagicV0, message.int8())
+				message.int8() // attributes
+
+				msg := Message{Key: message.bytes(), Value: message.bytes()}
+				require.NoError(b.t, message.err)
+				if b.errorCode == 0 {
+					b.messages[key] = append(b.messages[key], msg)
+				}
+			}
+			require.NoError(b.t, messageSet.err)
+
+			res = appendInt32(res, partition)
+			res = appendInt16(res, b.errorCode)
+			res = appendInt64(res, offset)
+		}
+	}
+	require.NoError(b.t, d.err)
+
+	return res, RequiredAcks(acks) != NoResponse
+}
+
+func (d *decoder) int8() int8 {
+	bz := d.next(1)
+	if bz == nil {
+		return 0
+	}
+
+	return int8(bz[0])
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if n == -1 {
+		return nil
+	}
+
+	return d.next(int(n))
+}
@@ -0,0 +1,321 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// Kafka protocol constants used by the Producer.
+const (
+	apiKeyProduce     int16 = 0
+	apiVersionProduce int16 = 0
+	messageMagicV0    int8  = 0
+)
+
+// RequiredAcks defines how many broker acknowledgements a produce request waits for.
+type RequiredAcks int16
+
+const (
+	// NoResponse does not wait for the broker to acknowledge the messages.
+	NoResponse RequiredAcks = 0
+	// WaitForLocal waits for the partition leader to write the messages.
+	WaitForLocal RequiredAcks = 1
+	// WaitForAll waits for all the in-sync replicas to write the messages.
+	WaitForAll RequiredAcks = -1
+)
+
+// Message is a Kafka message produced to a topic partition.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// ProducerConfig defines the configuration of a Producer.
+type ProducerConfig struct {
+	// Broker is the address of the leader broker of the partition.
+	Broker string
+	// ClientID is the client id sent along with every request.
+	ClientID string
+	// RequiredAcks is the number of acknowledgements to wait for.
+	RequiredAcks RequiredAcks
+	// Timeout bounds dialing and every request/response round-trip.
+	Timeout time.Duration
+}
+
+// Producer is a minimal synchronous producer speaking the Kafka wire protocol
+// (Produce API version 0). It sends every batch of messages in a single
+// request to the configured broker, which must lead the target partition,
+// and returns once the batch is acknowledged according to RequiredAcks.
+// The connection is re-established on the next call after a failure.
+type Producer struct {
+	cfg           ProducerConfig
+	mtx           sync.Mutex
+	conn          net.Conn
+	correlationID int32
+}
+
+// NewProducer returns a new Producer for the given configuration. The
+// connection to the broker is established lazily.
+func NewProducer(cfg ProducerConfig) (*Producer, error) {
+	if cfg.Broker == "" {
+		return nil, errors.New("kafka broker address must be set")
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &Producer{cfg: cfg}, nil
+}
+
+// Produce writes the messages, in order, to the given topic partition.
+func (p *Producer) Produce(topic string, partition int32, msgs []Message) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if err := p.connect(); err != nil {
+		return err
+	}
+
+	err := p.produce(topic, partition, msgs)
+	if err != nil {
+		// drop the connection, its state is unknown
+		p.conn.Close()
+		p.conn = nil
+	}
+
+	return err
+}
+
+// Close closes the connection to the broker.
+func (p *Producer) Close() error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.conn == nil {
+		return nil
+	}
+
+	err := p.conn.Close()
+	p.conn = nil
+
+	return err
+}
+
+func (p *Producer) connect() error {
+	if p.conn != nil {
+		return nil
+	}
+
+	conn, err := net.DialTimeout("tcp", p.cfg.Broker, p.cfg.Timeout)
+	if err != nil {
+		return err
+	}
+
+	p.conn = conn
+
+	return nil
+}
+
+func (p *Producer) produce(topic string, partition int32, msgs []Message) error {
+	if err := p.conn.SetDeadline(time.Now().Add(p.cfg.Timeout)); err != nil {
+		return err
+	}
+
+	p.correlationID++
+	req := encodeProduceRequest(p.correlationID, p.cfg.ClientID, p.cfg.RequiredAcks, p.cfg.Timeout, topic, partition, msgs)
+	if _, err := p.conn.Write(req); err != nil {
+		return err
+	}
+
+	if p.cfg.RequiredAcks == NoResponse {
+		return nil
+	}
+
+	res, err := readFrame(p.conn)
+	if err != nil {
+		return err
+	}
+
+	return decodeProduceResponse(res, p.correlationID, topic, partition)
+}
+
+// encodeProduceRequest encodes a size-delimited Produce v0 request carrying a
+// single topic partition.
+func encodeProduceRequest(
+	correlationID int32, clientID string, acks RequiredAcks, timeout time.Duration, topic string, partition int32, msgs []Message,
+) []byte {
+	var messageSet []byte
+	for _, msg := range msgs {
+		messageSet = appendMessage(messageSet, msg)
+	}
+
+	var req []byte
+	req = appendInt32(req, 0) // size, set below
+	req = appendInt16(req, apiKeyProduce)
+	req = appendInt16(req, apiVersionProduce)
+	req = appendInt32(req, correlationID)
+	req = appendString(req, clientID)
+	req = appendInt16(req, int16(acks))
+	req = appendInt32(req, int32(timeout/time.Millisecond))
+	req = appendInt32(req, 1) // topics
+	req = appendString(req, topic)
+	req = appendInt32(req, 1) // partitions
+	req = appendInt32(req, partition)
+	req = appendInt32(req, int32(len(messageSet)))
+	req = append(req, messageSet...)
+
+	binary.BigEndian.PutUint32(req, uint32(len(req)-4))
+
+	return req
+}
+
+// appendMessage appends a v0 message, with its offset and size, to a message set.
+func appendMessage(messageSet []byte, msg Message) []byte {
+	var body []byte
+	body = append(body, byte(messageMagicV0), 0) // magic, attributes
+	body = appendBytes(body, msg.Key)
+	body = appendBytes(body, msg.Value)
+
+	messageSet = appendInt64(messageSet, 0) // offset, assigned by the broker
+	messageSet = appendInt32(messageSet, int32(4+len(body)))
+	messageSet = appendInt32(messageSet, int32(crc32.ChecksumIEEE(body)))
+
+	return append(messageSet, body...)
+}
+
+// decodeProduceResponse checks a Produce v0 response for the expected topic partition.
+func decodeProduceResponse(res []byte, correlationID int32, topic string, partition int32) error {
+	d := decoder{buf: res}
+
+	if id := d.int32(); id != correlationID {
+		return fmt.Errorf("unexpected kafka correlation id %d, expected %d", id, correlationID)
+	}
+
+	for topics := d.int32(); topics > 0; topics-- {
+		name := d.string()
+		for partitions := d.int32(); partitions > 0; partitions-- {
+			p, code := d.int32(), d.int16()
+			d.int64() // base offset
+
+			if name == topic && p == partition {
+				if d.err != nil {
+					return d.err
+				}
+				if code != 0 {
+					return fmt.Errorf("kafka broker rejected produce request to %s/%d: error code %d", topic, partition, code)
+				}
+
+				return nil
+			}
+		}
+	}
+
+	if d.err != nil {
+		return d.err
+	}
+
+	return fmt.Errorf("kafka produce response is missing %s/%d", topic, partition)
+}
+
+// readFrame reads a size-delimited request or response.
+func readFrame(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+
+	frame := make([]byte, binary.BigEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(r, frame); err != nil {
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+func appendInt16(b []byte, v int16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendInt32(b []byte, v int32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendInt64(b []byte, v int64) []byte {
+	return appendInt32(appendInt32(b, int32(v>>32)), int32(v))
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendInt16(b, int16(len(s)))
+	return append(b, s...)
+}
+
+// appendBytes appends a nullable byte array.
+func appendBytes(b []byte, bz []byte) []byte {
+	if bz == nil {
+		return appendInt32(b, -1)
+	}
+
+	b = appendInt32(b, int32(len(bz)))
+	return append(b, bz...)
+}
+
+// decoder reads Kafka primitive types, recording the first error encountered.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+
+	if n < 0 || len(d.buf) < n {
+		d.err = io.ErrUnexpectedEOF
+		d.buf = nil
+		return nil
+	}
+
+	bz := d.buf[:n]
+	d.buf = d.buf[n:]
+
+	return bz
+}
+
+func (d *decoder) int16() int16 {
+	bz := d.next(2)
+	if bz == nil {
+		return 0
+	}
+
+	return int16(binary.BigEndian.Uint16(bz))
+}
+
+func (d *decoder) int32() int32 {
+	bz := d.next(4)
+	if bz == nil {
+		return 0
+	}
+
+	return int32(binary.BigEndian.Uint32(bz))
+}
+
+func (d *decoder) int64() int64 {
+	bz := d.next(8)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (d *decoder) string() string {
+	return string(d.next(int(d.int16())))
+}
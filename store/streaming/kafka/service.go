@@ -0,0 +1,162 @@
+package kafka
+
+import (
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ baseapp.CommitListener   = &StreamingService{}
+)
+
+// StreamingService is a concrete implementation of StreamingService that produces state changes to a Kafka topic
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	producer           *Producer                                // the producer used to write the messages out to Kafka
+	topic              string                                   // the topic the messages are produced to
+	partition          int32                                    // the partition of the topic the messages are produced to
+	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to Kafka
+	stateCache         [][]byte                                 // cache the protobuf binary encoded StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+	produceErr         error                                    // the first error producing a message of the current block
+}
+
+// cacheWriter is the io.Writer the StoreKVPairWriteListener writes the
+// length-prefixed StoreKVPairs to, it appends them to the state cache.
+type cacheWriter struct {
+	kss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (cw cacheWriter) Write(b []byte) (int, error) {
+	cw.kss.stateCacheLock.Lock()
+	cw.kss.stateCache = append(cw.kss.stateCache, b)
+	cw.kss.stateCacheLock.Unlock()
+
+	return len(b), nil
+}
+
+// NewStreamingService creates a new StreamingService producing the state changes of the provided storeKeys
+// to the given topic partition
+func NewStreamingService(producer *Producer, topic string, partition int32, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	if topic == "" {
+		return nil, fmt.Errorf("kafka topic must be set")
+	}
+
+	kss := &StreamingService{
+		producer:       producer,
+		topic:          topic,
+		partition:      partition,
+		codec:          c,
+		stateCacheLock: new(sync.Mutex),
+	}
+
+	listener := types.NewStoreKVPairWriteListener(cacheWriter{kss: kss}, c)
+	kss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		kss.listeners[key] = append(kss.listeners[key], listener)
+	}
+
+	return kss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (kss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return kss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It produces the received BeginBlock request and response and the resulting state changes
+// as a single message keyed by `block-{N}-begin`
+func (kss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	kss.currentBlockNumber = req.GetHeader().Height
+	kss.currentTxIndex = 0
+
+	return kss.produce(fmt.Sprintf("block-%d-begin", kss.currentBlockNumber), &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It produces the received DeliverTx request and response and the resulting state changes
+// as a single message keyed by `block-{N}-tx-{M}`
+func (kss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	key := fmt.Sprintf("block-%d-tx-%d", kss.currentBlockNumber, kss.currentTxIndex)
+	kss.currentTxIndex++
+
+	return kss.produce(key, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It produces the received EndBlock request and response and the resulting state changes
+// as a single message keyed by `block-{N}-end`
+func (kss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return kss.produce(fmt.Sprintf("block-%d-end", kss.currentBlockNumber), &req, &res)
+}
+
+// produce writes a message whose value is the length-prefixed request, the
+// state changes cached for this stage and the length-prefixed response, i.e.
+// the same encoding as the records of the file streaming service without their kind.
+func (kss *StreamingService) produce(key string, req, res codec.ProtoMarshaler) error {
+	lengthPrefixedReqBytes, err := kss.codec.MarshalLengthPrefixed(req)
+	if err != nil {
+		return err
+	}
+
+	value := lengthPrefixedReqBytes
+
+	// write all state changes cached for this stage and reset the cache
+	kss.stateCacheLock.Lock()
+	for _, stateChange := range kss.stateCache {
+		value = append(value, stateChange...)
+	}
+	kss.stateCache = nil
+	kss.stateCacheLock.Unlock()
+
+	lengthPrefixedResBytes, err := kss.codec.MarshalLengthPrefixed(res)
+	if err != nil {
+		return err
+	}
+
+	value = append(value, lengthPrefixedResBytes...)
+
+	err = kss.producer.Produce(kss.topic, kss.partition, []Message{{Key: []byte(key), Value: value}})
+	if err != nil && kss.produceErr == nil {
+		kss.produceErr = err
+	}
+
+	return err
+}
+
+// ListenCommit satisfies the baseapp.CommitListener interface
+// Messages are acknowledged by the broker when produced, so it only returns the first
+// error encountered producing the messages of the block
+func (kss *StreamingService) ListenCommit(ctx sdk.Context) error {
+	err := kss.produceErr
+	kss.produceErr = nil
+
+	return err
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// Messages are produced synchronously by the ABCI listening hooks, so there is no
+// background loop to start
+func (kss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (kss *StreamingService) Close() error {
+	return kss.producer.Close()
+}
@@ -0,0 +1,126 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testMarshaller = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	mockStoreKey   = sdk.NewKVStoreKey("mockStore")
+	emptyContext   = sdk.Context{}
+	testBeginReq   = abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}
+	testBeginRes   = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "testEventType"}}}
+	testTxReq      = abci.RequestDeliverTx{Tx: []byte{1, 2, 3}}
+	testTxRes      = abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	testEndReq     = abci.RequestEndBlock{Height: 2}
+	testEndRes     = abci.ResponseEndBlock{}
+	testKVPair     = types.StoreKVPair{StoreKey: mockStoreKey.Name(), Key: []byte("key"), Value: []byte("value")}
+)
+
+func newTestProducer(t *testing.T, broker *mockBroker, acks RequiredAcks) *Producer {
+	producer, err := NewProducer(ProducerConfig{Broker: broker.Addr(), ClientID: "test", RequiredAcks: acks, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	t.Cleanup(func() { producer.Close() })
+
+	return producer
+}
+
+func TestProducer(t *testing.T) {
+	broker := newMockBroker(t)
+	producer := newTestProducer(t, broker, WaitForLocal)
+
+	msgs := []Message{{Key: []byte("a"), Value: []byte("1")}, {Value: []byte("2")}}
+	require.NoError(t, producer.Produce("topic", 3, msgs))
+	require.Equal(t, msgs, broker.Messages("topic", 3))
+
+	// errors returned by the broker are surfaced
+	broker.SetErrorCode(6)
+	require.Error(t, producer.Produce("topic", 3, msgs))
+
+	// the producer reconnects after a failure
+	broker.SetErrorCode(0)
+	require.NoError(t, producer.Produce("topic", 3, msgs[:1]))
+	require.Len(t, broker.Messages("topic", 3), 3)
+
+	// the producer fails once the broker is gone
+	require.NoError(t, broker.listener.Close())
+	require.NoError(t, producer.Close())
+	require.Error(t, producer.Produce("topic", 3, msgs))
+}
+
+func TestProducerNoResponse(t *testing.T) {
+	broker := newMockBroker(t)
+	producer := newTestProducer(t, broker, NoResponse)
+
+	require.NoError(t, producer.Produce("topic", 0, []Message{{Value: []byte("1")}}))
+	require.Eventually(t, func() bool { return len(broker.Messages("topic", 0)) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamingService(t *testing.T) {
+	broker := newMockBroker(t)
+	producer := newTestProducer(t, broker, WaitForAll)
+
+	kss, err := NewStreamingService(producer, "abci", 1, []types.StoreKey{mockStoreKey}, testMarshaller)
+	require.NoError(t, err)
+	listener := kss.Listeners()[mockStoreKey][0]
+
+	require.NoError(t, listener.OnWrite(mockStoreKey, testKVPair.Key, testKVPair.Value, false))
+	require.NoError(t, kss.ListenBeginBlock(emptyContext, testBeginReq, testBeginRes))
+	require.NoError(t, kss.ListenDeliverTx(emptyContext, testTxReq, testTxRes))
+	require.NoError(t, listener.OnWrite(mockStoreKey, testKVPair.Key, testKVPair.Value, false))
+	require.NoError(t, kss.ListenEndBlock(emptyContext, testEndReq, testEndRes))
+
+	msgs := broker.Messages("abci", 1)
+	require.Len(t, msgs, 3)
+
+	expected := []struct {
+		key      string
+		messages []proto.Message
+	}{
+		{"block-2-begin", []proto.Message{&testBeginReq, &testKVPair, &testBeginRes}},
+		{"block-2-tx-0", []proto.Message{&testTxReq, &testTxRes}},
+		{"block-2-end", []proto.Message{&testEndReq, &testKVPair, &testEndRes}},
+	}
+	for i, exp := range expected {
+		require.Equal(t, exp.key, string(msgs[i].Key))
+
+		var value []byte
+		for _, msg := range exp.messages {
+			bz, err := testMarshaller.MarshalLengthPrefixed(msg.(codec.ProtoMarshaler))
+			require.NoError(t, err)
+			value = append(value, bz...)
+		}
+		require.Equal(t, value, msgs[i].Value)
+	}
+}
+
+func TestStreamingServiceListenCommit(t *testing.T) {
+	broker := newMockBroker(t)
+	producer := newTestProducer(t, broker, WaitForLocal)
+
+	kss, err := NewStreamingService(producer, "abci", 0, []types.StoreKey{mockStoreKey}, testMarshaller)
+	require.NoError(t, err)
+
+	require.NoError(t, kss.ListenBeginBlock(emptyContext, testBeginReq, testBeginRes))
+	require.NoError(t, kss.ListenCommit(emptyContext))
+
+	// a block with a message rejected by the broker is not acknowledged
+	broker.SetErrorCode(6)
+	require.Error(t, kss.ListenBeginBlock(emptyContext, testBeginReq, testBeginRes))
+	broker.SetErrorCode(0)
+	require.NoError(t, kss.ListenEndBlock(emptyContext, testEndReq, testEndRes))
+	require.Error(t, kss.ListenCommit(emptyContext))
+
+	require.NoError(t, kss.ListenCommit(emptyContext))
+}
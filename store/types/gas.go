@@ -252,3 +252,62 @@ func TransientGasConfig() GasConfig {
 		IterNextCostFlat: 3,
 	}
 }
+
+// GasSchedule defines the gas configs of the KVStores by store and by message type,
+// so that the stores are charged differently depending on the store accessed and the
+// message executed. The zero GasSchedule charges the default configs of the store types.
+//
+// The configs must not be modified once the GasSchedule is in use.
+type GasSchedule struct {
+	// StoreConfigs are the gas configs of the stores, by store key name.
+	StoreConfigs map[string]GasConfig
+	// MsgConfigs are the gas configs of the stores accessed while executing a message,
+	// by message type URL and store key name, the empty name applying to all the stores.
+	MsgConfigs map[string]map[string]GasConfig
+
+	msgTypeURL string
+}
+
+// ForMsg returns the GasSchedule of the stores accessed while executing a message
+// of the type URL.
+func (s GasSchedule) ForMsg(msgTypeURL string) GasSchedule {
+	s.msgTypeURL = msgTypeURL
+	return s
+}
+
+// MsgTypeURL returns the type URL of the message the GasSchedule applies to, which is
+// empty outside of the message execution.
+func (s GasSchedule) MsgTypeURL() string {
+	return s.msgTypeURL
+}
+
+// KVGasConfig returns the gas config of a KVStore, KVGasConfig being the default.
+func (s GasSchedule) KVGasConfig(key StoreKey) GasConfig {
+	return s.gasConfig(key, KVGasConfig())
+}
+
+// TransientGasConfig returns the gas config of a TransientStore, TransientGasConfig
+// being the default.
+func (s GasSchedule) TransientGasConfig(key StoreKey) GasConfig {
+	return s.gasConfig(key, TransientGasConfig())
+}
+
+// gasConfig returns the most specific config of the store, in order the config of the
+// store for the message, the config of all the stores for the message, and the config
+// of the store.
+func (s GasSchedule) gasConfig(key StoreKey, defaultConfig GasConfig) GasConfig {
+	if s.msgTypeURL != "" {
+		if configs, ok := s.MsgConfigs[s.msgTypeURL]; ok {
+			if config, ok := configs[key.Name()]; ok {
+				return config
+			}
+			if config, ok := configs[""]; ok {
+				return config
+			}
+		}
+	}
+	if config, ok := s.StoreConfigs[key.Name()]; ok {
+		return config
+	}
+	return defaultConfig
+}
@@ -121,3 +121,40 @@ func TestTransientGasConfig(t *testing.T) {
 		IterNextCostFlat: 3,
 	})
 }
+
+func TestGasSchedule(t *testing.T) {
+	t.Parallel()
+	bankKey, wasmKey, tkey := NewKVStoreKey("bank"), NewKVStoreKey("wasm"), NewTransientStoreKey("transient_params")
+	bankConfig := GasConfig{ReadCostFlat: 1}
+	wasmConfig := GasConfig{ReadCostFlat: 2}
+	msgConfig := GasConfig{ReadCostFlat: 3}
+	msgWasmConfig := GasConfig{ReadCostFlat: 4}
+
+	var schedule GasSchedule
+	require.Equal(t, KVGasConfig(), schedule.KVGasConfig(bankKey))
+	require.Equal(t, TransientGasConfig(), schedule.TransientGasConfig(tkey))
+
+	schedule = GasSchedule{
+		StoreConfigs: map[string]GasConfig{bankKey.Name(): bankConfig, wasmKey.Name(): wasmConfig},
+		MsgConfigs: map[string]map[string]GasConfig{
+			"/msg.All":  {"": msgConfig},
+			"/msg.Wasm": {wasmKey.Name(): msgWasmConfig},
+		},
+	}
+	require.Equal(t, bankConfig, schedule.KVGasConfig(bankKey))
+	require.Equal(t, wasmConfig, schedule.KVGasConfig(wasmKey))
+	require.Equal(t, TransientGasConfig(), schedule.TransientGasConfig(tkey))
+	require.Equal(t, "", schedule.MsgTypeURL())
+
+	// the message configs take precedence over the store configs
+	msgSchedule := schedule.ForMsg("/msg.All")
+	require.Equal(t, "/msg.All", msgSchedule.MsgTypeURL())
+	require.Equal(t, msgConfig, msgSchedule.KVGasConfig(bankKey))
+	require.Equal(t, msgConfig, msgSchedule.TransientGasConfig(tkey))
+	msgSchedule = schedule.ForMsg("/msg.Wasm")
+	require.Equal(t, bankConfig, msgSchedule.KVGasConfig(bankKey))
+	require.Equal(t, msgWasmConfig, msgSchedule.KVGasConfig(wasmKey))
+	msgSchedule = schedule.ForMsg("/msg.Other")
+	require.Equal(t, wasmConfig, msgSchedule.KVGasConfig(wasmKey))
+	require.Equal(t, "", msgSchedule.ForMsg("").MsgTypeURL())
+}
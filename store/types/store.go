@@ -136,6 +136,22 @@ type MultiStore interface {
 	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
+// MetricsMultiStore is an optional interface of the MultiStores emitting the
+// metrics of the operations on some of their KVStores.
+type MetricsMultiStore interface {
+	// MetricsEnabled returns if the metrics of the operations on the KVStore belonging
+	// to the provided StoreKey are emitted
+	MetricsEnabled(key StoreKey) bool
+}
+
+// MetricsEnabled returns if the metrics of the operations on the KVStore belonging
+// to the provided StoreKey are emitted by the MultiStore, which is never the case
+// if it doesn't implement MetricsMultiStore.
+func MetricsEnabled(ms MultiStore, key StoreKey) bool {
+	mms, ok := ms.(MetricsMultiStore)
+	return ok && mms.MetricsEnabled(key)
+}
+
 // From MultiStore.CacheMultiStore()....
 type CacheMultiStore interface {
 	MultiStore
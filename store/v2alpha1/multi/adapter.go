@@ -0,0 +1,391 @@
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+)
+
+var (
+	_ v1.CommitMultiStore = (*Adapter)(nil)
+	_ v1.Queryable        = (*Adapter)(nil)
+)
+
+// ErrNotLoaded is returned when the store of an Adapter is accessed before a version is loaded.
+var ErrNotLoaded = errors.New("multistore is not loaded")
+
+// Adapter exposes a Store through the v1 CommitMultiStore interface, so that it can be used as the
+// CommitMultiStore of a BaseApp in place of the rootmulti store.
+//
+// As with rootmulti, the substores are mounted before a version is loaded, and the schema of the Store is
+// built from the mounted stores: IAVL stores are mapped to persistent substores, while memory and transient
+// stores keep their types. Only the latest version can be loaded, past versions being only readable through
+// CacheMultiStoreWithVersion and queries. Branches are v1 CacheMultiStores, which are traced and listened to
+// as with rootmulti.
+type Adapter struct {
+	db     dbm.Connection
+	config StoreConfig
+	store  *Store
+
+	mounts     map[v1.StoreKey]types.StoreType
+	keysByName map[string]v1.StoreKey
+
+	// backing store of the CacheMultiStores, which is never written to
+	cacheDB          v1.KVStore
+	snapshotInterval uint64
+
+	traceWriter       io.Writer
+	traceContext      v1.TraceContext
+	traceContextMutex sync.Mutex
+
+	listeners map[v1.StoreKey][]v1.WriteListener
+}
+
+// NewAdapter returns an Adapter of a Store to be loaded from db with the given config. The schema of the
+// config is ignored, as it is built from the mounted stores.
+func NewAdapter(db dbm.Connection, config StoreConfig) *Adapter {
+	return &Adapter{
+		db:         db,
+		config:     config,
+		mounts:     make(map[v1.StoreKey]types.StoreType),
+		keysByName: make(map[string]v1.StoreKey),
+		cacheDB:    dbadapter.Store{DB: tmdb.NewMemDB()},
+		listeners:  make(map[v1.StoreKey][]v1.WriteListener),
+	}
+}
+
+// Store returns the adapted store, or nil if no version is loaded.
+func (a *Adapter) Store() *Store {
+	return a.store
+}
+
+// Close closes the adapted store, if loaded.
+func (a *Adapter) Close() error {
+	if a.store == nil {
+		return nil
+	}
+	return a.store.Close()
+}
+
+// MountStoreWithDB implements CommitMultiStore. The stores cannot use a separate DB.
+func (a *Adapter) MountStoreWithDB(key v1.StoreKey, typ v1.StoreType, db tmdb.DB) {
+	if key == nil {
+		panic("MountIAVLStore() key cannot be nil")
+	}
+	if db != nil {
+		panic(fmt.Sprintf("store %s cannot be mounted with a separate DB", key.Name()))
+	}
+	if _, ok := a.mounts[key]; ok {
+		panic(fmt.Sprintf("store duplicate store key %v", key))
+	}
+	if _, ok := a.keysByName[key.Name()]; ok {
+		panic(fmt.Sprintf("store duplicate store key name %v", key))
+	}
+	switch typ {
+	case v1.StoreTypeIAVL, types.StoreTypePersistent:
+		typ = types.StoreTypePersistent
+	case types.StoreTypeMemory, types.StoreTypeTransient:
+	default:
+		panic(fmt.Sprintf("store %s cannot be mounted with type %v", key.Name(), typ))
+	}
+	a.mounts[key] = typ
+	a.keysByName[key.Name()] = key
+}
+
+// StoreKeysByName returns the mounted store keys by name.
+func (a *Adapter) StoreKeysByName() map[string]v1.StoreKey {
+	return a.keysByName
+}
+
+// LoadLatestVersion implements CommitMultiStore.
+func (a *Adapter) LoadLatestVersion() error {
+	return a.load(0, nil)
+}
+
+// LoadLatestVersionAndUpgrade implements CommitMultiStore.
+func (a *Adapter) LoadLatestVersionAndUpgrade(upgrades *v1.StoreUpgrades) error {
+	return a.load(0, upgrades)
+}
+
+// LoadVersion implements CommitMultiStore. The version must be the latest one.
+func (a *Adapter) LoadVersion(ver int64) error {
+	return a.load(ver, nil)
+}
+
+// LoadVersionAndUpgrade implements CommitMultiStore. The version must be the latest one.
+func (a *Adapter) LoadVersionAndUpgrade(ver int64, upgrades *v1.StoreUpgrades) error {
+	return a.load(ver, upgrades)
+}
+
+// Builds the schema of the store before the upgrades, which are then applied by the store.
+func (a *Adapter) load(ver int64, upgrades *v1.StoreUpgrades) error {
+	if a.store != nil {
+		return errors.New("multistore is already loaded")
+	}
+	versions, err := a.db.Versions()
+	if err != nil {
+		return err
+	}
+	if ver != 0 && uint64(ver) != versions.Last() {
+		return fmt.Errorf("cannot load version %d, only the latest version %d can be loaded", ver, versions.Last())
+	}
+
+	config := a.config
+	config.prefixRegistry = prefixRegistry{StoreSchema: StoreSchema{}}
+	config.traceListenMixin = newTraceListenMixin()
+	for key, typ := range a.mounts {
+		name := key.Name()
+		if upgrades.IsAdded(name) || upgrades.RenamedFrom(name) != "" {
+			continue
+		}
+		if err := config.RegisterSubstore(name, typ); err != nil {
+			return err
+		}
+	}
+	if upgrades != nil {
+		for _, rename := range upgrades.Renamed {
+			if err := config.RegisterSubstore(rename.OldKey, types.StoreTypePersistent); err != nil {
+				return err
+			}
+		}
+		config.Upgrades = append(config.Upgrades, *upgrades)
+	}
+
+	store, err := NewStore(a.db, config)
+	if err != nil {
+		return err
+	}
+	store.SetSnapshotInterval(a.snapshotInterval)
+	a.store = store
+	return nil
+}
+
+func (a *Adapter) mustGetStore() *Store {
+	if a.store == nil {
+		panic(ErrNotLoaded)
+	}
+	return a.store
+}
+
+// GetStoreType implements Store.
+func (a *Adapter) GetStoreType() v1.StoreType {
+	return v1.StoreTypeMulti
+}
+
+// GetStore implements MultiStore.
+func (a *Adapter) GetStore(key v1.StoreKey) v1.Store {
+	return a.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore. If tracing or listening is enabled, the store is wrapped accordingly.
+func (a *Adapter) GetKVStore(key v1.StoreKey) v1.KVStore {
+	store := a.mustGetStore().GetKVStore(key)
+	if a.TracingEnabled() {
+		store = tracekv.NewStore(store, a.traceWriter, a.getTracingContext())
+	}
+	if a.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, a.listeners[key])
+	}
+	return store
+}
+
+// GetCommitStore implements CommitMultiStore. It panics, as the substores cannot be committed separately.
+func (a *Adapter) GetCommitStore(key v1.StoreKey) v1.CommitStore {
+	panic(fmt.Sprintf("store %s cannot be committed separately from the multistore", key.Name()))
+}
+
+// GetCommitKVStore implements CommitMultiStore. It panics, as the substores cannot be committed separately.
+func (a *Adapter) GetCommitKVStore(key v1.StoreKey) v1.CommitKVStore {
+	panic(fmt.Sprintf("store %s cannot be committed separately from the multistore", key.Name()))
+}
+
+// CacheWrap implements CacheWrapper.
+func (a *Adapter) CacheWrap() v1.CacheWrap {
+	return a.CacheMultiStore().(v1.CacheWrap)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (a *Adapter) CacheWrapWithTrace(_ io.Writer, _ v1.TraceContext) v1.CacheWrap {
+	return a.CacheWrap()
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (a *Adapter) CacheWrapWithListeners(_ v1.StoreKey, _ []v1.WriteListener) v1.CacheWrap {
+	return a.CacheWrap()
+}
+
+// CacheMultiStore implements MultiStore.
+func (a *Adapter) CacheMultiStore() v1.CacheMultiStore {
+	store := a.mustGetStore()
+	stores := make(map[v1.StoreKey]v1.CacheWrapper, len(a.mounts))
+	for key := range a.mounts {
+		// stores deleted by an upgrade are no longer in the schema
+		if _, has := store.schema[key.Name()]; has {
+			stores[key] = store.GetKVStore(key)
+		}
+	}
+	return a.newCacheMultiStore(stores)
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. The persistent stores are read at the version, while the
+// memory and transient stores are the current ones.
+func (a *Adapter) CacheMultiStoreWithVersion(version int64) (v1.CacheMultiStore, error) {
+	store := a.mustGetStore()
+	view, err := store.getView(version)
+	if err != nil {
+		return nil, err
+	}
+	stores := make(map[v1.StoreKey]v1.CacheWrapper, len(a.mounts))
+	for key, typ := range a.mounts {
+		if typ == types.StoreTypePersistent {
+			if _, has := view.schema[key.Name()]; has {
+				stores[key] = view.GetKVStore(key)
+			}
+		} else if _, has := store.schema[key.Name()]; has {
+			stores[key] = store.GetKVStore(key)
+		}
+	}
+	return a.newCacheMultiStore(stores), nil
+}
+
+func (a *Adapter) newCacheMultiStore(stores map[v1.StoreKey]v1.CacheWrapper) v1.CacheMultiStore {
+	return cachemulti.NewFromKVStore(a.cacheDB, stores, a.keysByName, a.traceWriter, a.getTracingContext(), a.listeners)
+}
+
+// Commit implements Committer.
+func (a *Adapter) Commit() v1.CommitID {
+	return a.mustGetStore().Commit()
+}
+
+// LastCommitID implements Committer.
+func (a *Adapter) LastCommitID() v1.CommitID {
+	if a.store == nil {
+		return v1.CommitID{}
+	}
+	return a.store.LastCommitID()
+}
+
+// SetPruning implements Committer.
+func (a *Adapter) SetPruning(opts pruningtypes.PruningOptions) {
+	if a.store != nil {
+		a.store.SetPruning(opts)
+	}
+	a.config.Pruning = opts
+}
+
+// GetPruning implements Committer.
+func (a *Adapter) GetPruning() pruningtypes.PruningOptions {
+	return a.config.Pruning
+}
+
+// SetInitialVersion implements CommitMultiStore.
+func (a *Adapter) SetInitialVersion(version int64) error {
+	if version < 0 {
+		return fmt.Errorf("invalid initial version %d", version)
+	}
+	if a.store != nil {
+		return a.store.SetInitialVersion(uint64(version))
+	}
+	a.config.InitialVersion = uint64(version)
+	return nil
+}
+
+// SetInterBlockCache implements CommitMultiStore. It is a no-op, as the store has no inter-block cache.
+func (a *Adapter) SetInterBlockCache(v1.MultiStorePersistentCache) {}
+
+// SetIAVLCacheSize implements CommitMultiStore. It is a no-op, as the store has no IAVL trees.
+func (a *Adapter) SetIAVLCacheSize(int) {}
+
+// Query implements Queryable.
+func (a *Adapter) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return a.mustGetStore().Query(req)
+}
+
+// Snapshot implements snapshottypes.Snapshotter.
+func (a *Adapter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return a.mustGetStore().Snapshot(height, protoWriter)
+}
+
+// Restore implements snapshottypes.Snapshotter.
+func (a *Adapter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	return a.mustGetStore().Restore(height, format, protoReader)
+}
+
+// PruneSnapshotHeight implements snapshottypes.Snapshotter.
+func (a *Adapter) PruneSnapshotHeight(height int64) {
+	a.mustGetStore().PruneSnapshotHeight(height)
+}
+
+// SetSnapshotInterval implements snapshottypes.Snapshotter.
+func (a *Adapter) SetSnapshotInterval(snapshotInterval uint64) {
+	if a.store != nil {
+		a.store.SetSnapshotInterval(snapshotInterval)
+	}
+	a.snapshotInterval = snapshotInterval
+}
+
+// SetTracer sets the tracer for the MultiStore that the underlying
+// stores will utilize to trace operations. A MultiStore is returned.
+func (a *Adapter) SetTracer(w io.Writer) v1.MultiStore {
+	a.traceWriter = w
+	return a
+}
+
+// SetTracingContext updates the tracing context for the MultiStore by merging
+// the given context with the existing context by key. Any existing keys will
+// be overwritten. It returns a modified MultiStore.
+func (a *Adapter) SetTracingContext(tc v1.TraceContext) v1.MultiStore {
+	a.traceContextMutex.Lock()
+	defer a.traceContextMutex.Unlock()
+	a.traceContext = a.traceContext.Merge(tc)
+
+	return a
+}
+
+func (a *Adapter) getTracingContext() v1.TraceContext {
+	a.traceContextMutex.Lock()
+	defer a.traceContextMutex.Unlock()
+
+	if a.traceContext == nil {
+		return nil
+	}
+
+	ctx := v1.TraceContext{}
+	for k, v := range a.traceContext {
+		ctx[k] = v
+	}
+
+	return ctx
+}
+
+// TracingEnabled returns if tracing is enabled for the MultiStore.
+func (a *Adapter) TracingEnabled() bool {
+	return a.traceWriter != nil
+}
+
+// AddListeners adds listeners for a specific KVStore
+func (a *Adapter) AddListeners(key v1.StoreKey, listeners []v1.WriteListener) {
+	a.listeners[key] = append(a.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (a *Adapter) ListeningEnabled(key v1.StoreKey) bool {
+	return len(a.listeners[key]) != 0
+}
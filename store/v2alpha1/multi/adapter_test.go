@@ -0,0 +1,209 @@
+package multi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+)
+
+var (
+	memKey  = v1.NewMemoryStoreKey("mem")
+	tranKey = v1.NewTransientStoreKey("tran")
+)
+
+func newAdapter(t *testing.T, db dbm.Connection, keys ...v1.StoreKey) *Adapter {
+	adapter := NewAdapter(db, DefaultStoreConfig())
+	adapter.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	for _, key := range keys {
+		adapter.MountStoreWithDB(key, v1.StoreTypeIAVL, nil)
+	}
+	adapter.MountStoreWithDB(memKey, v1.StoreTypeMemory, nil)
+	adapter.MountStoreWithDB(tranKey, v1.StoreTypeTransient, nil)
+	return adapter
+}
+
+func TestAdapter(t *testing.T) {
+	db := memdb.NewDB()
+	adapter := newAdapter(t, db, skey_1, skey_2)
+	require.Panics(t, func() { adapter.MountStoreWithDB(skey_1, v1.StoreTypeIAVL, nil) })
+	require.Panics(t, func() { adapter.MountStoreWithDB(skey_3, v1.StoreTypeIAVL, tmdb.NewMemDB()) })
+	require.Panics(t, func() { adapter.CacheMultiStore() })
+	require.Equal(t, v1.CommitID{}, adapter.LastCommitID())
+	require.NoError(t, adapter.LoadLatestVersion())
+	require.Error(t, adapter.LoadLatestVersion())
+
+	// writes are made through branches of the multistore
+	cms := adapter.CacheMultiStore()
+	cms.GetKVStore(skey_1).Set([]byte("a"), []byte("1"))
+	cms.GetKVStore(memKey).Set([]byte("b"), []byte("2"))
+	cms.GetKVStore(tranKey).Set([]byte("c"), []byte("3"))
+	require.Nil(t, adapter.GetKVStore(skey_1).Get([]byte("a")))
+	cms.Write()
+	require.Equal(t, []byte("1"), adapter.GetKVStore(skey_1).Get([]byte("a")))
+	require.Equal(t, []byte("3"), adapter.GetKVStore(tranKey).Get([]byte("c")))
+
+	cid := adapter.Commit()
+	require.Equal(t, int64(1), cid.Version)
+	require.Equal(t, cid, adapter.LastCommitID())
+	require.Equal(t, []byte("2"), adapter.GetKVStore(memKey).Get([]byte("b")))
+	require.Nil(t, adapter.GetKVStore(tranKey).Get([]byte("c")))
+
+	adapter.GetKVStore(skey_1).Set([]byte("a"), []byte("4"))
+	adapter.Commit()
+
+	// past versions are readable
+	cms, err := adapter.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(skey_1).Get([]byte("a")))
+	require.Equal(t, []byte("2"), cms.GetKVStore(memKey).Get([]byte("b")))
+	_, err = adapter.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+
+	res := adapter.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("a"), Height: 1})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, []byte("1"), res.Value)
+	require.NoError(t, adapter.Close())
+
+	// only the latest version can be loaded
+	require.Error(t, newAdapter(t, db, skey_1, skey_2).LoadVersion(1))
+	adapter = newAdapter(t, db, skey_1, skey_2)
+	require.NoError(t, adapter.LoadVersion(2))
+	require.Equal(t, []byte("4"), adapter.GetKVStore(skey_1).Get([]byte("a")))
+	require.NoError(t, adapter.Close())
+
+	// the mounted stores must match the schema
+	require.Error(t, newAdapter(t, db, skey_1).LoadLatestVersion())
+}
+
+func TestAdapterUpgrades(t *testing.T) {
+	db := memdb.NewDB()
+	adapter := newAdapter(t, db, skey_1, skey_2, skey_3)
+	require.NoError(t, adapter.LoadLatestVersion())
+	for _, key := range []v1.StoreKey{skey_1, skey_2, skey_3} {
+		adapter.GetKVStore(key).Set([]byte("a"), []byte(key.Name()))
+	}
+	adapter.Commit()
+	require.NoError(t, adapter.Close())
+
+	// store3 is deleted, store2 renamed to store2b and store4 added
+	adapter = newAdapter(t, db, skey_1, skey_2b, skey_3, skey_4)
+	require.NoError(t, adapter.LoadLatestVersionAndUpgrade(&v1.StoreUpgrades{
+		Added:   []string{skey_4.Name()},
+		Renamed: []v1.StoreRename{{OldKey: skey_2.Name(), NewKey: skey_2b.Name()}},
+		Deleted: []string{skey_3.Name()},
+	}))
+	require.Equal(t, []byte(skey_2.Name()), adapter.GetKVStore(skey_2b).Get([]byte("a")))
+	require.Panics(t, func() { adapter.GetKVStore(skey_3) })
+	cms := adapter.CacheMultiStore()
+	cms.GetKVStore(skey_4).Set([]byte("a"), []byte(skey_4.Name()))
+	cms.Write()
+	adapter.Commit()
+	require.NoError(t, adapter.Close())
+
+	adapter = newAdapter(t, db, skey_1, skey_2b, skey_4)
+	require.NoError(t, adapter.LoadLatestVersion())
+	require.Equal(t, []byte(skey_1.Name()), adapter.GetKVStore(skey_1).Get([]byte("a")))
+	require.Equal(t, []byte(skey_2.Name()), adapter.GetKVStore(skey_2b).Get([]byte("a")))
+	require.Equal(t, []byte(skey_4.Name()), adapter.GetKVStore(skey_4).Get([]byte("a")))
+}
+
+func TestAdapterListenersAndTracing(t *testing.T) {
+	interfaceRegistry := codecTypes.NewInterfaceRegistry()
+	marshaller := codec.NewProtoCodec(interfaceRegistry)
+
+	adapter := newAdapter(t, memdb.NewDB(), skey_1)
+	var listened, traced bytes.Buffer
+	adapter.AddListeners(skey_1, []v1.WriteListener{v1.NewStoreKVPairWriteListener(&listened, marshaller)})
+	require.True(t, adapter.ListeningEnabled(skey_1))
+	require.False(t, adapter.ListeningEnabled(memKey))
+	adapter.SetTracer(&traced)
+	adapter.SetTracingContext(v1.TraceContext{"blockHeight": 1})
+	require.True(t, adapter.TracingEnabled())
+	require.NoError(t, adapter.LoadLatestVersion())
+
+	cms := adapter.CacheMultiStore()
+	cms.GetKVStore(skey_1).Set([]byte("a"), []byte("1"))
+	cms.GetKVStore(memKey).Set([]byte("b"), []byte("2"))
+	cms.Write()
+
+	var pair v1.StoreKVPair
+	require.NoError(t, marshaller.UnmarshalLengthPrefixed(listened.Bytes(), &pair))
+	require.Equal(t, v1.StoreKVPair{StoreKey: skey_1.Name(), Key: []byte("a"), Value: []byte("1")}, pair)
+	require.Contains(t, traced.String(), `"blockHeight":1`)
+}
+
+func TestAdapterSnapshots(t *testing.T) {
+	source := newAdapter(t, memdb.NewDB(), skey_1, skey_2)
+	source.SetPruning(pruningtypes.NewCustomPruningOptions(0, 2))
+	source.SetSnapshotInterval(3)
+	require.NoError(t, source.LoadLatestVersion())
+	for i := byte(1); i <= 4; i++ {
+		source.GetKVStore(skey_1).Set([]byte{i}, []byte{i})
+		source.GetKVStore(skey_2).Set([]byte{i}, []byte{i})
+		source.Commit()
+	}
+	// the snapshot height is retained until the snapshot is taken
+	versions, err := source.Store().stateDB.Versions()
+	require.NoError(t, err)
+	require.True(t, versions.Exists(3))
+	require.False(t, versions.Exists(2))
+
+	snapshotStore, err := snapshots.NewStore(tmdb.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(3, 1), source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(3)
+	require.NoError(t, err)
+
+	target := newAdapter(t, memdb.NewDB(), skey_1, skey_2)
+	require.NoError(t, target.LoadLatestVersion())
+	targetManager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(3, 1), target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+	cms, err := source.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, cms.GetKVStore(skey_1).Get([]byte{3}), target.GetKVStore(skey_1).Get([]byte{3}))
+	require.Nil(t, target.GetKVStore(skey_1).Get([]byte{4}))
+	require.Equal(t, uint64(3), uint64(target.LastCommitID().Version))
+
+	source.Commit()
+	source.Commit()
+	versions, err = source.Store().stateDB.Versions()
+	require.NoError(t, err)
+	require.False(t, versions.Exists(3))
+}
+
+func TestAdapterMigrateFromV1(t *testing.T) {
+	v1Store := rootmulti.NewStore(tmdb.NewMemDB(), log.NewNopLogger())
+	v1Store.MountStoreWithDB(skey_1, v1.StoreTypeIAVL, nil)
+	v1Store.MountStoreWithDB(memKey, v1.StoreTypeMemory, nil)
+	v1Store.MountStoreWithDB(tranKey, v1.StoreTypeTransient, nil)
+	require.NoError(t, v1Store.LoadLatestVersion())
+	v1Store.GetKVStore(skey_1).Set([]byte("a"), []byte("1"))
+	v1Store.Commit()
+
+	db := memdb.NewDB()
+	store, err := MigrateFromV1(v1Store, db, DefaultStoreConfig())
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	adapter := newAdapter(t, db, skey_1)
+	require.NoError(t, adapter.LoadLatestVersion())
+	require.Equal(t, int64(1), adapter.LastCommitID().Version)
+	require.Equal(t, []byte("1"), adapter.GetKVStore(skey_1).Get([]byte("a")))
+	require.Equal(t, types.StoreTypeMemory, adapter.Store().schema[memKey.Name()])
+}
@@ -0,0 +1,144 @@
+package multi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The benchmarks compare the Adapter of the v2alpha1 multistore with the rootmulti store, as the
+// CommitMultiStore of an app, both being backed by in-memory DBs.
+
+var benchStoreKeys = []v1.StoreKey{
+	v1.NewKVStoreKey("acc"),
+	v1.NewKVStoreKey("bank"),
+	v1.NewKVStoreKey("staking"),
+}
+
+type benchMultiStore struct {
+	name string
+	new  func(b *testing.B) v1.CommitMultiStore
+}
+
+var benchMultiStores = []benchMultiStore{
+	{"rootmulti", func(b *testing.B) v1.CommitMultiStore {
+		return loadBenchMultiStore(b, rootmulti.NewStore(tmdb.NewMemDB(), log.NewNopLogger()))
+	}},
+	{"v2alpha1", func(b *testing.B) v1.CommitMultiStore {
+		return loadBenchMultiStore(b, NewAdapter(memdb.NewDB(), DefaultStoreConfig()))
+	}},
+}
+
+func loadBenchMultiStore(b *testing.B, cms v1.CommitMultiStore) v1.CommitMultiStore {
+	cms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	for _, key := range benchStoreKeys {
+		cms.MountStoreWithDB(key, v1.StoreTypeIAVL, nil)
+	}
+	require.NoError(b, cms.LoadLatestVersion())
+	return cms
+}
+
+func benchKey(i uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, i)
+	return key
+}
+
+// Writes the blocks of keys through branches of the multistore, committing each block.
+func writeBenchBlocks(b *testing.B, cms v1.CommitMultiStore, r *rand.Rand, blocks, blockSize int) {
+	value := make([]byte, 128)
+	for block := 0; block < blocks; block++ {
+		cache := cms.CacheMultiStore()
+		for i := 0; i < blockSize; i++ {
+			r.Read(value)
+			key := benchStoreKeys[r.Intn(len(benchStoreKeys))]
+			cache.GetKVStore(key).Set(benchKey(r.Uint64()%uint64(blocks*blockSize)), value)
+		}
+		cache.Write()
+		cms.Commit()
+	}
+}
+
+func BenchmarkMultiStoreCommit(b *testing.B) {
+	for _, blockSize := range []int{100, 1000} {
+		for _, bms := range benchMultiStores {
+			b.Run(fmt.Sprintf("%s/block-%d", bms.name, blockSize), func(b *testing.B) {
+				cms := bms.new(b)
+				r := rand.New(rand.NewSource(49872768940))
+				b.ReportAllocs()
+				b.ResetTimer()
+				writeBenchBlocks(b, cms, r, b.N, blockSize)
+			})
+		}
+	}
+}
+
+func BenchmarkMultiStoreGet(b *testing.B) {
+	const size = 10000
+	for _, bms := range benchMultiStores {
+		b.Run(bms.name, func(b *testing.B) {
+			cms := bms.new(b)
+			r := rand.New(rand.NewSource(49872768940))
+			writeBenchBlocks(b, cms, r, 10, size/10)
+			cache := cms.CacheMultiStore()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := benchStoreKeys[i%len(benchStoreKeys)]
+				cache.GetKVStore(key).Get(benchKey(r.Uint64() % size))
+			}
+		})
+	}
+}
+
+func BenchmarkMultiStoreIterate(b *testing.B) {
+	const size = 10000
+	for _, bms := range benchMultiStores {
+		b.Run(bms.name, func(b *testing.B) {
+			cms := bms.new(b)
+			r := rand.New(rand.NewSource(49872768940))
+			writeBenchBlocks(b, cms, r, 10, size/10)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := benchStoreKeys[i%len(benchStoreKeys)]
+				itr := cms.CacheMultiStore().GetKVStore(key).Iterator(nil, nil)
+				for ; itr.Valid(); itr.Next() {
+					_ = itr.Value()
+				}
+				itr.Close()
+			}
+		})
+	}
+}
+
+func BenchmarkMultiStoreQuery(b *testing.B) {
+	const size = 10000
+	for _, bms := range benchMultiStores {
+		b.Run(bms.name, func(b *testing.B) {
+			cms := bms.new(b)
+			r := rand.New(rand.NewSource(49872768940))
+			writeBenchBlocks(b, cms, r, 10, size/10)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := benchStoreKeys[i%len(benchStoreKeys)]
+				cache, err := cms.CacheMultiStoreWithVersion(int64(1 + i%10))
+				if err != nil {
+					b.Fatal(err)
+				}
+				cache.GetKVStore(key).Get(benchKey(r.Uint64() % size))
+			}
+		})
+	}
+}
@@ -36,8 +36,15 @@ func MigrateFromV1(rootMultiStore *v1Store.Store, store2db dbm.Connection, store
 				return nil, err
 			}
 			stores = append(stores, namedStore{name: keyName, Store: store})
-		case *transient.Store, *mem.Store:
-			continue
+		// the non-persistent stores are registered so that the schema matches the mounted stores
+		case *transient.Store:
+			if err := storeConfig.RegisterSubstore(keyName, types.StoreTypeTransient); err != nil {
+				return nil, err
+			}
+		case *mem.Store:
+			if err := storeConfig.RegisterSubstore(keyName, types.StoreTypeMemory); err != nil {
+				return nil, err
+			}
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "don't know how to migrate store %q of type %T", keyName, store)
 		}
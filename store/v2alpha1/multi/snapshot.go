@@ -129,7 +129,14 @@ loop:
 				receivedStoreSchema[string(sKey)] = types.StoreTypePersistent
 			}
 
-			if !rs.schema.equal(receivedStoreSchema) {
+			// only the persistent substores are part of snapshots
+			persistentSchema := make(StoreSchema, len(rs.schema))
+			for sKey, sType := range rs.schema {
+				if sType == types.StoreTypePersistent {
+					persistentSchema[sKey] = sType
+				}
+			}
+			if !persistentSchema.equal(receivedStoreSchema) {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received schema does not match app schema")
 			}
 
@@ -1,6 +1,7 @@
 package multi
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -41,6 +42,8 @@ var (
 	schemaPrefix  = []byte{1} // Prefix for store keys (namespaces)
 	contentPrefix = []byte{2} // Prefix for store contents
 
+	pruneSnapshotHeightsKey = []byte{3} // Key for snapshot heights awaiting pruning
+
 	// Per-substore prefixes
 	substoreMerkleRootKey = []byte{0} // Key for root hashes of Merkle trees
 	dataPrefix            = []byte{1} // Prefix for state mappings
@@ -100,6 +103,12 @@ type Store struct {
 
 	PersistentCache types.MultiStorePersistentCache
 	substoreCache   map[string]*substore
+
+	// Heights at multiples of the snapshot interval are retained until their snapshot is taken, after
+	// which they are held here until they can be pruned.
+	snapshotInterval     uint64
+	pruneSnapshotHeights []int64
+	snapshotHeightsDirty bool
 }
 
 type substore struct {
@@ -277,6 +286,11 @@ func NewStore(db dbm.Connection, opts StoreConfig) (ret *Store, err error) {
 		InitialVersion: opts.InitialVersion,
 	}
 
+	ret.pruneSnapshotHeights, err = loadPruneSnapshotHeights(ret.stateTxn)
+	if err != nil {
+		return
+	}
+
 	// Now load the substore schema
 	schemaView := prefixdb.NewReader(ret.stateDB.Reader(), schemaPrefix)
 	defer func() {
@@ -541,24 +555,24 @@ func (s *Store) Commit() types.CommitID {
 	if s.InitialVersion != 0 && target < s.InitialVersion {
 		target = s.InitialVersion
 	}
+	// Prune if necessary, persisting the snapshot heights awaiting pruning along with the version
+	prunable := s.prunableVersions(int64(target))
+	if s.snapshotHeightsDirty {
+		if err = s.stateTxn.Set(pruneSnapshotHeightsKey, int64SliceToBytes(s.pruneSnapshotHeights)); err != nil {
+			panic(err)
+		}
+	}
 	cid, err := s.commit(target)
 	if err != nil {
 		panic(err)
 	}
+	s.snapshotHeightsDirty = false
 
-	// Prune if necessary
-	previous := cid.Version - 1
-	if s.Pruning.Interval != 0 && cid.Version%int64(s.Pruning.Interval) == 0 {
-		// The range of newly prunable versions
-		lastPrunable := previous - int64(s.Pruning.KeepRecent)
-		firstPrunable := lastPrunable - int64(s.Pruning.Interval)
-
-		for version := firstPrunable; version <= lastPrunable; version++ {
-			s.stateDB.DeleteVersion(uint64(version))
+	for _, version := range prunable {
+		s.stateDB.DeleteVersion(uint64(version))
 
-			if s.StateCommitmentDB != nil {
-				s.StateCommitmentDB.DeleteVersion(uint64(version))
-			}
+		if s.StateCommitmentDB != nil {
+			s.StateCommitmentDB.DeleteVersion(uint64(version))
 		}
 	}
 
@@ -566,6 +580,40 @@ func (s *Store) Commit() types.CommitID {
 	return *cid
 }
 
+// Returns the versions to prune when committing the target version, and removes the snapshot heights among
+// them from the ones awaiting pruning. Versions are pruned at every pruning interval, except for the heights
+// retained for snapshots.
+func (s *Store) prunableVersions(target int64) (ret []int64) {
+	if s.Pruning.Interval == 0 || target%int64(s.Pruning.Interval) != 0 {
+		return
+	}
+	// The range of newly prunable versions
+	lastPrunable := target - 1 - int64(s.Pruning.KeepRecent)
+	firstPrunable := lastPrunable - int64(s.Pruning.Interval)
+	if firstPrunable < 1 {
+		firstPrunable = 1
+	}
+	for version := firstPrunable; version <= lastPrunable; version++ {
+		if s.snapshotInterval == 0 || version%int64(s.snapshotInterval) != 0 {
+			ret = append(ret, version)
+		}
+	}
+
+	retained := s.pruneSnapshotHeights[:0]
+	for _, height := range s.pruneSnapshotHeights {
+		if height <= lastPrunable {
+			ret = append(ret, height)
+		} else {
+			retained = append(retained, height)
+		}
+	}
+	if len(retained) != len(s.pruneSnapshotHeights) {
+		s.snapshotHeightsDirty = true
+	}
+	s.pruneSnapshotHeights = retained
+	return
+}
+
 func (s *Store) getMerkleRoots() (ret map[string][]byte, err error) {
 	ret = map[string][]byte{}
 	for key := range s.schema {
@@ -696,18 +744,24 @@ func (s *Store) CacheMultiStore() types.CacheMultiStore {
 	}
 }
 
-// PruneSnapshotHeight prunes the given height according to the prune strategy.
+// PruneSnapshotHeight marks a height retained for a snapshot as prunable, once the snapshot is taken.
 // If PruneNothing, this is a no-op.
-// If other strategy, this height is persisted until it is
-// less than <current height> - KeepRecent and <current height> % Interval == 0
+// Otherwise the height is persisted until it is less than <current height> - KeepRecent
+// and <current height> % Interval == 0.
 func (s *Store) PruneSnapshotHeight(height int64) {
-	panic("not implemented")
+	if s.Pruning.Interval == 0 || height <= 0 {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.pruneSnapshotHeights = append(s.pruneSnapshotHeights, height)
+	s.snapshotHeightsDirty = true
 }
 
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (s *Store) SetSnapshotInterval(snapshotInterval uint64) {
-	panic("not implemented")
+	s.snapshotInterval = snapshotInterval
 }
 
 // parsePath expects a format like /<storeName>[/<subpath>]
@@ -822,6 +876,31 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return res
 }
 
+func loadPruneSnapshotHeights(reader dbm.Reader) ([]int64, error) {
+	bz, err := reader.Get(pruneSnapshotHeightsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz)%8 != 0 {
+		return nil, fmt.Errorf("invalid snapshot heights: %X", bz)
+	}
+	heights := make([]int64, 0, len(bz)/8)
+	for offset := 0; offset < len(bz); offset += 8 {
+		heights = append(heights, int64(binary.BigEndian.Uint64(bz[offset:offset+8])))
+	}
+	return heights, nil
+}
+
+func int64SliceToBytes(slice []int64) []byte {
+	bz := make([]byte, 0, len(slice)*8)
+	for _, i := range slice {
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], uint64(i))
+		bz = append(bz, buf[:]...)
+	}
+	return bz
+}
+
 func loadSMT(stateCommitmentTxn dbm.ReadWriter, root []byte) *smt.Store {
 	smtdb := prefixdb.NewReadWriter(stateCommitmentTxn, smtPrefix)
 	return smt.LoadStore(smtdb, root)
@@ -469,6 +469,54 @@ func TestPruning(t *testing.T) {
 			require.Equal(t, has, versions.Exists(v), "Version = %v; tc #%d", v, i)
 		}
 	}
+
+	// Test snapshot heights: they are retained until their snapshot is taken, and pruned at the next
+	// pruning interval once they are old enough
+	testCheckPoints = map[uint64][]uint64{
+		10: {4, 8, 10},
+		20: {4, 20},
+		30: {4, 28, 30},
+	}
+
+	db = memdb.NewDB()
+	opts = simpleStoreConfig(t)
+	opts.Pruning = pruningtypes.NewCustomPruningOptions(0, 10)
+	store, err = NewStore(db, opts)
+	require.NoError(t, err)
+	store.SetSnapshotInterval(4)
+
+	for i := byte(1); i <= 30; i++ {
+		store.GetKVStore(skey_1).Set([]byte{i}, []byte{i})
+
+		cid := store.Commit()
+		latest := uint64(i)
+		require.Equal(t, latest, uint64(cid.Version))
+		// each snapshot is taken 3 heights later, except the one of height 4
+		if snapshot := latest - 3; latest > 3 && snapshot%4 == 0 && snapshot != 4 {
+			store.PruneSnapshotHeight(int64(snapshot))
+		}
+		if latest == 17 {
+			// the snapshot heights awaiting pruning are persisted
+			require.NoError(t, store.Close())
+			store, err = NewStore(db, opts)
+			require.NoError(t, err)
+			store.SetSnapshotInterval(4)
+		}
+
+		kept, has := testCheckPoints[latest]
+		if !has {
+			continue
+		}
+
+		versions, err := db.Versions()
+		require.NoError(t, err)
+
+		keptMap := sliceToSet(kept)
+		for v := uint64(1); v <= latest; v++ {
+			_, has := keptMap[v]
+			require.Equal(t, has, versions.Exists(v), "Version = %v; tc #%d", v, i)
+		}
+	}
 }
 
 func queryPath(skey types.StoreKey, endp string) string { return "/" + skey.Name() + endp }
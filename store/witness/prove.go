@@ -0,0 +1,152 @@
+package witness
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Record returns the witness of the reads and writes of a block recorded by a
+// store, with the values of the keys as read by the block. It is the witness of
+// a store whose state is not committed, e.g. a memory store, which cannot be
+// proven.
+func Record(name string, rw *rwset.Store) *types.StoreWitness {
+	sw := &types.StoreWitness{
+		Name:   name,
+		Ranges: rw.ReadRanges(),
+		Writes: rw.Changeset(name),
+	}
+	for _, pair := range rw.Reads() {
+		sw.Entries = append(sw.Entries, &types.WitnessEntry{
+			Key:    pair.Key,
+			Exists: pair.Value != nil,
+			Value:  pair.Value,
+		})
+	}
+
+	return sw
+}
+
+// Prove returns the witness of the reads and writes of a block recorded by a
+// committed store, whose state before the block is the given height of the
+// store of the queryable multistore. The keys read and written are proven,
+// along with the absence of the keys of the ranges read by iterators which
+// don't exist.
+func Prove(queryable types.Queryable, height int64, name string, rw *rwset.Store) (*types.StoreWitness, error) {
+	entries := make(map[string]*types.WitnessEntry)
+	prove := func(key []byte) (*types.WitnessEntry, error) {
+		if entry, ok := entries[string(key)]; ok {
+			return entry, nil
+		}
+
+		res := queryable.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", name),
+			Data:   key,
+			Height: height,
+			Prove:  true,
+		})
+		if !res.IsOK() {
+			return nil, fmt.Errorf("failed to prove key %X of store %s: %s", key, name, res.Log)
+		}
+
+		entry := &types.WitnessEntry{
+			Key:      key,
+			Exists:   res.Value != nil,
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+		}
+		entries[string(key)] = entry
+
+		return entry, nil
+	}
+
+	sw := &types.StoreWitness{
+		Name:   name,
+		Ranges: rw.ReadRanges(),
+		Writes: rw.Changeset(name),
+	}
+	for _, key := range rw.ReadKeys() {
+		if _, err := prove(key); err != nil {
+			return nil, err
+		}
+	}
+	for _, pair := range sw.Writes {
+		if _, err := prove(pair.Key); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range sw.Ranges {
+		if err := walkRange(r, prove); err != nil {
+			return nil, err
+		}
+	}
+
+	sw.Entries = make([]*types.WitnessEntry, 0, len(entries))
+	for _, entry := range entries {
+		sw.Entries = append(sw.Entries, entry)
+	}
+	sort.Slice(sw.Entries, func(i, j int) bool { return bytes.Compare(sw.Entries[i].Key, sw.Entries[j].Key) < 0 })
+
+	return sw, nil
+}
+
+// walkRange walks through the keys of a range with their entries, from the
+// start of the range: an existing key is followed by its successor, and a
+// missing key by the next existing key, given by the right neighbour of its
+// proof of absence. It thereby visits the entries proving that every key of the
+// range has an entry.
+func walkRange(r *types.KeyRange, entry func(key []byte) (*types.WitnessEntry, error)) error {
+	key := r.Start
+	if len(key) == 0 {
+		// the lowest valid key
+		key = []byte{0}
+	}
+
+	for len(r.End) == 0 || bytes.Compare(key, r.End) < 0 {
+		e, err := entry(key)
+		if err != nil {
+			return err
+		}
+		if e.Exists {
+			key = successor(key)
+			continue
+		}
+
+		key, err = rightNeighbour(e)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// rightNeighbour returns the lowest key greater than the key of an entry of a
+// missing key, as given by its proof of absence, or nil if there is none.
+func rightNeighbour(entry *types.WitnessEntry) ([]byte, error) {
+	if entry.ProofOps == nil || len(entry.ProofOps.Ops) == 0 {
+		return nil, fmt.Errorf("missing proof of the absence of key %X", entry.Key)
+	}
+
+	op, err := types.CommitmentOpDecoder(entry.ProofOps.Ops[0])
+	if err != nil {
+		return nil, err
+	}
+	nonexist := op.(types.CommitmentOp).Proof.GetNonexist()
+	if nonexist == nil || !bytes.Equal(nonexist.Key, entry.Key) {
+		return nil, fmt.Errorf("invalid proof of the absence of key %X", entry.Key)
+	}
+	if nonexist.Right == nil {
+		return nil, nil
+	}
+
+	return nonexist.Right.Key, nil
+}
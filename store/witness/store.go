@@ -0,0 +1,76 @@
+package witness
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Store is a DB of the witnesses of the blocks executed by an app, by height.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a witness store backed by db.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Save saves the witness of a block, in place of any witness saved for the
+// height of the block, e.g. before a rollback.
+func (s *Store) Save(w *types.BlockWitness) error {
+	bz, err := w.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return s.db.SetSync(heightKey(w.BeginBlock.Header.Height), bz)
+}
+
+// Get returns the witness of the block of the given height.
+func (s *Store) Get(height int64) (*types.BlockWitness, error) {
+	bz, err := s.db.Get(heightKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no witness of the block at height %d", height)
+	}
+
+	var w types.BlockWitness
+	if err := w.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+// Heights returns the heights of the saved witnesses in ascending order.
+func (s *Store) Heights() ([]int64, error) {
+	itr, err := s.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var heights []int64
+	for ; itr.Valid(); itr.Next() {
+		heights = append(heights, int64(binary.BigEndian.Uint64(itr.Key())))
+	}
+
+	return heights, itr.Error()
+}
+
+// Close closes the DB of the store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func heightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
@@ -0,0 +1,42 @@
+package witness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/witness"
+)
+
+func blockWitness(height int64, txs ...[]byte) *types.BlockWitness {
+	w := &types.BlockWitness{Txs: txs}
+	w.BeginBlock.Header = tmproto.Header{Height: height}
+	return w
+}
+
+func TestStore(t *testing.T) {
+	s := witness.NewStore(dbm.NewMemDB())
+	require.NoError(t, s.Save(blockWitness(3)))
+	require.NoError(t, s.Save(blockWitness(2, []byte("tx"))))
+
+	heights, err := s.Heights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, heights)
+
+	w, err := s.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx")}, w.Txs)
+
+	// a witness replaces the witness of its height
+	require.NoError(t, s.Save(blockWitness(2)))
+	w, err = s.Get(2)
+	require.NoError(t, err)
+	require.Empty(t, w.Txs)
+
+	_, err = s.Get(4)
+	require.EqualError(t, err, "no witness of the block at height 4")
+	require.NoError(t, s.Close())
+}
@@ -0,0 +1,261 @@
+package witness
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Verify verifies the witness of a committed store against the app hash of the
+// state before the block: the proof of each entry, and that every key of the
+// ranges has an entry.
+func Verify(sw *types.StoreWitness, appHash []byte) error {
+	prt := rootmulti.DefaultProofRuntime()
+	entries := make(map[string]*types.WitnessEntry, len(sw.Entries))
+	for _, entry := range sw.Entries {
+		keyPath := merkle.KeyPath{}.
+			AppendKey([]byte(sw.Name), merkle.KeyEncodingURL).
+			AppendKey(entry.Key, merkle.KeyEncodingURL)
+
+		var err error
+		if entry.Exists {
+			err = prt.VerifyValue(entry.ProofOps, appHash, keyPath.String(), entry.Value)
+		} else {
+			err = prt.VerifyAbsence(entry.ProofOps, appHash, keyPath.String())
+		}
+		if err != nil {
+			return fmt.Errorf("invalid proof of key %X of store %s: %w", entry.Key, sw.Name, err)
+		}
+
+		entries[string(entry.Key)] = entry
+	}
+
+	for _, r := range sw.Ranges {
+		err := walkRange(r, func(key []byte) (*types.WitnessEntry, error) {
+			entry, ok := entries[string(key)]
+			if !ok {
+				return nil, fmt.Errorf("key %X of a range of store %s has no entry", key, sw.Name)
+			}
+			return entry, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ types.KVStore = &KVStore{}
+
+// KVStore is a read-only KVStore serving the state of a store before a block
+// from its witness: the keys with an entry, and the absence of the other keys
+// of its ranges. The reads of the other keys are served as reads of missing
+// keys, and recorded so that the execution of a block with an incomplete
+// witness is detected.
+type KVStore struct {
+	name    string
+	parent  dbadapter.Store
+	entries map[string]struct{}
+	ranges  []*types.KeyRange
+	missing []string
+}
+
+// NewKVStore returns a KVStore serving the state of a store from its witness,
+// which is not verified.
+func NewKVStore(sw *types.StoreWitness) *KVStore {
+	s := &KVStore{
+		name:    sw.Name,
+		parent:  dbadapter.Store{DB: dbm.NewMemDB()},
+		entries: make(map[string]struct{}, len(sw.Entries)),
+		ranges:  sw.Ranges,
+	}
+	for _, entry := range sw.Entries {
+		s.entries[string(entry.Key)] = struct{}{}
+		if entry.Exists {
+			s.parent.Set(entry.Key, entry.Value)
+		}
+	}
+
+	return s
+}
+
+// Err returns an error if a read of the block was not served by the witness.
+func (s *KVStore) Err() error {
+	if len(s.missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the witness of store %s is missing %s", s.name, s.missing[0])
+}
+
+// covered returns true if the keys of the domain [start, end) all have an entry
+// or are missing, i.e. if the domain is part of a range. An empty start or end
+// is unbounded.
+func (s *KVStore) covered(start, end []byte) bool {
+	for _, r := range s.ranges {
+		if (len(r.Start) == 0 || (len(start) > 0 && bytes.Compare(r.Start, start) <= 0)) &&
+			(len(r.End) == 0 || (len(end) > 0 && bytes.Compare(end, r.End) <= 0)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDomain records the domain [start, end) as missing if it is not part of
+// a range.
+func (s *KVStore) checkDomain(start, end []byte) {
+	if len(start) > 0 && len(end) > 0 && bytes.Compare(start, end) >= 0 {
+		return
+	}
+	if !s.covered(start, end) {
+		s.missing = append(s.missing, fmt.Sprintf("the keys in [%X, %X)", start, end))
+	}
+}
+
+// Get implements the KVStore interface.
+func (s *KVStore) Get(key []byte) []byte {
+	if _, ok := s.entries[string(key)]; !ok && !s.covered(key, successor(key)) {
+		s.missing = append(s.missing, fmt.Sprintf("the key %X", key))
+	}
+
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s *KVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements the KVStore interface. It panics as a KVStore is read-only.
+func (s *KVStore) Set(_, _ []byte) {
+	panic("cannot Set a witness KVStore")
+}
+
+// Delete implements the KVStore interface. It panics as a KVStore is read-only.
+func (s *KVStore) Delete(_ []byte) {
+	panic("cannot Delete a witness KVStore")
+}
+
+// Iterator implements the KVStore interface. The domain visited by the
+// returned iterator must be part of a range.
+func (s *KVStore) Iterator(start, end []byte) types.Iterator {
+	return &witnessIterator{parent: s.parent.Iterator(start, end), store: s, ascending: true, start: start, end: end}
+}
+
+// ReverseIterator implements the KVStore interface. The domain visited by the
+// returned iterator must be part of a range.
+func (s *KVStore) ReverseIterator(start, end []byte) types.Iterator {
+	return &witnessIterator{parent: s.parent.ReverseIterator(start, end), store: s, start: start, end: end}
+}
+
+// GetStoreType implements the KVStore interface.
+func (s *KVStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements the KVStore interface. It panics as a KVStore cannot be
+// cache wrapped.
+func (s *KVStore) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a witness KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a KVStore
+// cannot be cache wrapped.
+func (s *KVStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a witness KVStore")
+}
+
+// CacheWrapWithListeners implements the KVStore interface. It panics as a
+// KVStore cannot be cache wrapped.
+func (s *KVStore) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a witness KVStore")
+}
+
+// witnessIterator checks that the part of its domain visited so far is part of
+// a range of its store at each position it is observed at.
+type witnessIterator struct {
+	parent     types.Iterator
+	store      *KVStore
+	ascending  bool
+	start, end []byte
+	// checked is set once the current position has been checked
+	checked bool
+}
+
+func (wi *witnessIterator) observe() bool {
+	valid := wi.parent.Valid()
+	if wi.checked {
+		return valid
+	}
+	wi.checked = true
+
+	switch {
+	case !valid:
+		wi.store.checkDomain(wi.start, wi.end)
+
+	case wi.ascending:
+		next := successor(wi.parent.Key())
+		wi.store.checkDomain(wi.start, next)
+		wi.start = next
+
+	default:
+		key := wi.parent.Key()
+		wi.store.checkDomain(key, wi.end)
+		wi.end = key
+	}
+
+	return valid
+}
+
+// Domain implements the Iterator interface.
+func (wi *witnessIterator) Domain() (start []byte, end []byte) {
+	return wi.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (wi *witnessIterator) Valid() bool {
+	return wi.observe()
+}
+
+// Next implements the Iterator interface.
+func (wi *witnessIterator) Next() {
+	wi.observe()
+	wi.parent.Next()
+	wi.checked = false
+}
+
+// Key implements the Iterator interface.
+func (wi *witnessIterator) Key() []byte {
+	wi.observe()
+	return wi.parent.Key()
+}
+
+// Value implements the Iterator interface.
+func (wi *witnessIterator) Value() []byte {
+	wi.observe()
+	return wi.parent.Value()
+}
+
+// Close implements the Iterator interface.
+func (wi *witnessIterator) Close() error {
+	return wi.parent.Close()
+}
+
+// Error implements the Iterator interface.
+func (wi *witnessIterator) Error() error {
+	return wi.parent.Error()
+}
+
+// successor returns the lowest key greater than the given key.
+func successor(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
@@ -0,0 +1,108 @@
+package witness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/witness"
+)
+
+// proveBlock commits the keys a, c and e to a store, and returns the proven
+// witness of a block reading and writing it, along with the app hash of the
+// state before the block.
+func proveBlock(t *testing.T) (*types.StoreWitness, []byte) {
+	key := types.NewKVStoreKey("acc")
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	store := cms.GetKVStore(key)
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("c"), []byte("3"))
+	store.Set([]byte("e"), []byte("5"))
+	commitID := cms.Commit()
+
+	rw := rwset.NewStore(cms.GetKVStore(key))
+	rw.Get([]byte("a"))
+	rw.Get([]byte("b"))
+	iter := rw.Iterator([]byte("b"), []byte("d"))
+	for ; iter.Valid(); iter.Next() {
+	}
+	require.NoError(t, iter.Close())
+	rw.Set([]byte("f"), []byte("6"))
+
+	sw, err := witness.Prove(cms, commitID.Version, key.Name(), rw)
+	require.NoError(t, err)
+
+	return sw, commitID.Hash
+}
+
+func TestProve(t *testing.T) {
+	sw, appHash := proveBlock(t)
+	require.NoError(t, witness.Verify(sw, appHash))
+
+	// the keys read and written, and the keys proving the range [b, d)
+	var keys []string
+	for _, entry := range sw.Entries {
+		keys = append(keys, string(entry.Key))
+	}
+	require.Equal(t, []string{"a", "b", "c", "c\x00", "f"}, keys)
+	require.Equal(t, []*types.KeyRange{{Start: []byte("b"), End: []byte("d")}}, sw.Ranges)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "acc", Key: []byte("f"), Value: []byte("6")}}, sw.Writes)
+
+	// the other app hashes are rejected
+	require.ErrorContains(t, witness.Verify(sw, make([]byte, 32)), "invalid proof")
+
+	// the ranges must be proven entirely
+	sw.Entries = append(sw.Entries[:3], sw.Entries[4:]...)
+	require.ErrorContains(t, witness.Verify(sw, appHash), "key 6300 of a range of store acc has no entry")
+}
+
+func TestKVStore(t *testing.T) {
+	sw, _ := proveBlock(t)
+	store := witness.NewKVStore(sw)
+
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("f")))
+
+	iter := store.Iterator([]byte("b"), []byte("d"))
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"c"}, keys)
+
+	// the keys of a range need no entry
+	require.Nil(t, store.Get([]byte("bb")))
+	require.NoError(t, store.Err())
+
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("2")) })
+	require.Panics(t, func() { store.Delete([]byte("a")) })
+}
+
+func TestKVStoreMissingReads(t *testing.T) {
+	sw, _ := proveBlock(t)
+
+	store := witness.NewKVStore(sw)
+	require.Nil(t, store.Get([]byte("e")))
+	require.EqualError(t, store.Err(), "the witness of store acc is missing the key 65")
+
+	// an iterator must not leave the ranges
+	store = witness.NewKVStore(sw)
+	iter := store.ReverseIterator(nil, []byte("d"))
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("c"), iter.Key())
+	require.NoError(t, store.Err())
+	iter.Next()
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	require.EqualError(t, store.Err(), "the witness of store acc is missing the keys in [61, 63)")
+}
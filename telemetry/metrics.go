@@ -52,6 +52,34 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// TraceExporter, when set, enables the tracing of the blocks and queries,
+	// exporting their spans to an OTLP/HTTP collector ("otlp") or to a file
+	// ("file"). It doesn't depend on Enabled.
+	TraceExporter string `mapstructure:"trace-exporter"`
+
+	// TraceEndpoint is the URL of the OTLP/HTTP collector the spans are exported
+	// to, e.g. http://localhost:4318.
+	TraceEndpoint string `mapstructure:"trace-endpoint"`
+
+	// TraceFile is the path of the file the spans are appended to, one OTLP JSON
+	// request per line.
+	TraceFile string `mapstructure:"trace-file"`
+
+	// TraceSampleRate is the fraction of the blocks and queries traced, between
+	// 0 and 1.
+	TraceSampleRate float64 `mapstructure:"trace-sample-rate"`
+
+	// TraceStores enables a span for each operation of the KVStores within the
+	// traced spans. They are numerous, so it is meant for debugging.
+	TraceStores bool `mapstructure:"trace-stores"`
+
+	// StoreMetrics are the names of the stores whose operations emit metrics:
+	// the latency of reads, writes and iterators, and the number of bytes they
+	// read and write, labelled by store, message type and key prefix, and the
+	// hits and misses of the node cache of the IAVL stores. The labels make
+	// for many series per store, so it is meant for a few stores at a time.
+	StoreMetrics []string `mapstructure:"store-metrics"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -0,0 +1,345 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpTracesPath is the path of the OTLP/HTTP endpoint receiving the spans.
+const otlpTracesPath = "/v1/traces"
+
+// otlpExporter exports spans encoded as OTLP JSON, the JSON encoding of an
+// OTLP ExportTraceServiceRequest, by the given write function.
+type otlpExporter struct {
+	mtx   sync.Mutex
+	write func(ctx context.Context, bz []byte) error
+	close func() error
+}
+
+var _ sdktrace.SpanExporter = (*otlpExporter)(nil)
+
+// newOTLPHTTPExporter returns an exporter sending the spans to an OTLP/HTTP
+// collector, e.g. http://localhost:4318.
+func newOTLPHTTPExporter(endpoint string) *otlpExporter {
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, otlpTracesPath) {
+		url += otlpTracesPath
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	return &otlpExporter{
+		write: func(ctx context.Context, bz []byte) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bz))
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			defer res.Body.Close()
+			_, _ = io.Copy(io.Discard, res.Body)
+
+			if res.StatusCode < 200 || res.StatusCode >= 300 {
+				return fmt.Errorf("failed to export spans to %s: %s", url, res.Status)
+			}
+			return nil
+		},
+		close: func() error {
+			client.CloseIdleConnections()
+			return nil
+		},
+	}
+}
+
+// newOTLPFileExporter returns an exporter appending the spans to a file, one
+// OTLP JSON request per line, as read by the otlpjsonfile receiver of the
+// OpenTelemetry collector.
+func newOTLPFileExporter(path string) (*otlpExporter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &otlpExporter{
+		write: func(_ context.Context, bz []byte) error {
+			_, err := f.Write(append(bz, '\n'))
+			return err
+		},
+		close: f.Close,
+	}, nil
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	bz, err := json.Marshal(encodeOTLPTraces(spans))
+	if err != nil {
+		return err
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	return e.write(ctx, bz)
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *otlpExporter) Shutdown(context.Context) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	return e.close()
+}
+
+// The types below follow the JSON encoding of the OTLP trace protobuf
+// messages, where the trace and span IDs are hex-encoded and the 64-bit
+// integers are strings.
+
+type otlpTraces struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+	SchemaURL  string            `json:"schemaUrl,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope     otlpScope  `json:"scope"`
+	Spans     []otlpSpan `json:"spans"`
+	SchemaURL string     `json:"schemaUrl,omitempty"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID                string         `json:"traceId"`
+	SpanID                 string         `json:"spanId"`
+	ParentSpanID           string         `json:"parentSpanId,omitempty"`
+	Name                   string         `json:"name"`
+	Kind                   int            `json:"kind"`
+	StartTimeUnixNano      string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano        string         `json:"endTimeUnixNano"`
+	Attributes             []otlpKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+	Events                 []otlpEvent    `json:"events,omitempty"`
+	DroppedEventsCount     int            `json:"droppedEventsCount,omitempty"`
+	Links                  []otlpLink     `json:"links,omitempty"`
+	DroppedLinksCount      int            `json:"droppedLinksCount,omitempty"`
+	Status                 otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpLink struct {
+	TraceID    string         `json:"traceId"`
+	SpanID     string         `json:"spanId"`
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// encodeOTLPTraces groups the spans by resource and instrumentation scope.
+func encodeOTLPTraces(spans []sdktrace.ReadOnlySpan) *otlpTraces {
+	traces := &otlpTraces{}
+	resources := map[attribute.Distinct]*otlpResourceSpans{}
+	scopes := map[attribute.Distinct]map[instrumentation.Scope]*otlpScopeSpans{}
+
+	for _, span := range spans {
+		var key attribute.Distinct
+		res := span.Resource()
+		if res != nil {
+			key = res.Equivalent()
+		}
+
+		resourceSpans, ok := resources[key]
+		if !ok {
+			resourceSpans = &otlpResourceSpans{}
+			if res != nil {
+				resourceSpans.Resource.Attributes = encodeOTLPAttributes(res.Attributes())
+				resourceSpans.SchemaURL = res.SchemaURL()
+			}
+			resources[key] = resourceSpans
+			scopes[key] = map[instrumentation.Scope]*otlpScopeSpans{}
+			traces.ResourceSpans = append(traces.ResourceSpans, resourceSpans)
+		}
+
+		scope := span.InstrumentationScope()
+		scopeSpans, ok := scopes[key][scope]
+		if !ok {
+			scopeSpans = &otlpScopeSpans{
+				Scope:     otlpScope{Name: scope.Name, Version: scope.Version},
+				SchemaURL: scope.SchemaURL,
+			}
+			scopes[key][scope] = scopeSpans
+			resourceSpans.ScopeSpans = append(resourceSpans.ScopeSpans, scopeSpans)
+		}
+
+		scopeSpans.Spans = append(scopeSpans.Spans, encodeOTLPSpan(span))
+	}
+
+	return traces
+}
+
+func encodeOTLPSpan(span sdktrace.ReadOnlySpan) otlpSpan {
+	sc := span.SpanContext()
+	s := otlpSpan{
+		TraceID:                sc.TraceID().String(),
+		SpanID:                 sc.SpanID().String(),
+		Name:                   span.Name(),
+		Kind:                   int(span.SpanKind()), // the OTLP span kinds have the same values
+		StartTimeUnixNano:      encodeOTLPTime(span.StartTime()),
+		EndTimeUnixNano:        encodeOTLPTime(span.EndTime()),
+		Attributes:             encodeOTLPAttributes(span.Attributes()),
+		DroppedAttributesCount: span.DroppedAttributes(),
+		DroppedEventsCount:     span.DroppedEvents(),
+		DroppedLinksCount:      span.DroppedLinks(),
+		Status:                 otlpStatus{Message: span.Status().Description},
+	}
+	if parent := span.Parent(); parent.IsValid() {
+		s.ParentSpanID = parent.SpanID().String()
+	}
+
+	switch span.Status().Code {
+	case codes.Ok:
+		s.Status.Code = 1
+	case codes.Error:
+		s.Status.Code = 2
+	}
+
+	for _, event := range span.Events() {
+		s.Events = append(s.Events, otlpEvent{
+			TimeUnixNano: encodeOTLPTime(event.Time),
+			Name:         event.Name,
+			Attributes:   encodeOTLPAttributes(event.Attributes),
+		})
+	}
+	for _, link := range span.Links() {
+		s.Links = append(s.Links, otlpLink{
+			TraceID:    link.SpanContext.TraceID().String(),
+			SpanID:     link.SpanContext.SpanID().String(),
+			Attributes: encodeOTLPAttributes(link.Attributes),
+		})
+	}
+
+	return s
+}
+
+func encodeOTLPTime(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func encodeOTLPAttributes(attrs []attribute.KeyValue) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	kvs := make([]otlpKeyValue, len(attrs))
+	for i, attr := range attrs {
+		kvs[i] = otlpKeyValue{Key: string(attr.Key), Value: encodeOTLPValue(attr.Value)}
+	}
+	return kvs
+}
+
+func encodeOTLPValue(v attribute.Value) otlpAnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return otlpAnyValue{BoolValue: &b}
+
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return otlpAnyValue{IntValue: &i}
+
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return otlpAnyValue{DoubleValue: &f}
+
+	case attribute.BOOLSLICE:
+		values := []otlpAnyValue{}
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, encodeOTLPValue(attribute.BoolValue(b)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.INT64SLICE:
+		values := []otlpAnyValue{}
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, encodeOTLPValue(attribute.Int64Value(i)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.FLOAT64SLICE:
+		values := []otlpAnyValue{}
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, encodeOTLPValue(attribute.Float64Value(f)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.STRINGSLICE:
+		values := []otlpAnyValue{}
+		for _, s := range v.AsStringSlice() {
+			values = append(values, encodeOTLPValue(attribute.StringValue(s)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	default:
+		s := v.Emit()
+		return otlpAnyValue{StringValue: &s}
+	}
+}
@@ -0,0 +1,142 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing exporters supported.
+const (
+	TraceExporterNone = ""
+	TraceExporterOTLP = "otlp"
+	TraceExporterFile = "file"
+)
+
+// tracerName is the name of the tracer of the spans recorded by the SDK.
+const tracerName = "github.com/cosmos/cosmos-sdk"
+
+var (
+	// tracingEnabled is true once the tracing of the app is set up, so that
+	// the spans are not even started otherwise.
+	tracingEnabled bool
+
+	// traceStores is true if the operations of the KVStores are traced.
+	traceStores bool
+)
+
+// Tracing defines a wrapper around the OpenTelemetry tracer provider of the
+// app. When creating a Tracing object, it is registered as the global tracer
+// provider, and the spans started with StartSpan are exported as configured
+// by the operator.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+}
+
+// NewTracing creates a new instance of Tracing, exporting the spans to the
+// exporter of the config. It returns nil if no exporter is configured.
+func NewTracing(cfg Config) (*Tracing, error) {
+	if cfg.TraceExporter == TraceExporterNone {
+		return nil, nil
+	}
+	if cfg.TraceSampleRate < 0 || cfg.TraceSampleRate > 1 {
+		return nil, fmt.Errorf("invalid trace sample rate %v, must be between 0 and 1", cfg.TraceSampleRate)
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.TraceExporter {
+	case TraceExporterOTLP:
+		if cfg.TraceEndpoint == "" {
+			return nil, fmt.Errorf("the %s trace exporter requires a trace endpoint", cfg.TraceExporter)
+		}
+		exporter = newOTLPHTTPExporter(cfg.TraceEndpoint)
+
+	case TraceExporterFile:
+		if cfg.TraceFile == "" {
+			return nil, fmt.Errorf("the %s trace exporter requires a trace file", cfg.TraceExporter)
+		}
+		exporter, err = newOTLPFileExporter(cfg.TraceFile)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %s", cfg.TraceExporter)
+	}
+
+	return NewTracingWithExporter(cfg, exporter), nil
+}
+
+// NewTracingWithExporter creates a new instance of Tracing exporting the spans
+// to the given exporter, e.g. an in-memory exporter in tests.
+func NewTracingWithExporter(cfg Config, exporter sdktrace.SpanExporter) *Tracing {
+	// the spans of a block or a query are sampled together
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TraceSampleRate))
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(cfg.ServiceName),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	tracingEnabled = true
+	traceStores = cfg.TraceStores
+
+	return &Tracing{provider: provider}
+}
+
+// Shutdown exports the pending spans and stops the tracing.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	tracingEnabled = false
+	traceStores = false
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	return t.provider.Shutdown(ctx)
+}
+
+// TracingEnabled returns true if the tracing of the app is set up.
+func TracingEnabled() bool {
+	return tracingEnabled
+}
+
+// TraceStores returns true if the operations of the KVStores are traced within
+// the span of the context.
+func TraceStores(ctx context.Context) bool {
+	return traceStores && trace.SpanFromContext(ctx).IsRecording()
+}
+
+// StartSpan starts a span of the SDK tracer as a child of the span of the
+// context, if any. The span must be ended by the caller. It is a no-op if the
+// tracing is not set up.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !tracingEnabled {
+		// the span of the background context is a no-op span
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends a span, recording the error of the operation it traces if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
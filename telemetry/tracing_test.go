@@ -0,0 +1,157 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing_Disabled(t *testing.T) {
+	tracing, err := NewTracing(Config{})
+	require.Nil(t, tracing)
+	require.NoError(t, err)
+	require.False(t, TracingEnabled())
+
+	ctx, span := StartSpan(context.Background(), "noop")
+	require.False(t, span.IsRecording())
+	require.False(t, TraceStores(ctx))
+	EndSpan(span, errors.New("error"))
+}
+
+func TestTracing_InvalidConfig(t *testing.T) {
+	testCases := map[string]Config{
+		"unknown exporter":    {TraceExporter: "jaeger", TraceSampleRate: 1},
+		"invalid sample rate": {TraceExporter: TraceExporterFile, TraceFile: "trace.json", TraceSampleRate: 2},
+		"no endpoint":         {TraceExporter: TraceExporterOTLP, TraceSampleRate: 1},
+		"no file":             {TraceExporter: TraceExporterFile, TraceSampleRate: 1},
+	}
+
+	for name, cfg := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tracing, err := NewTracing(cfg)
+			require.Nil(t, tracing)
+			require.Error(t, err)
+		})
+	}
+}
+
+// recordSpans records a parent span and a failed child span.
+func recordSpans(t *testing.T, tracing *Tracing) (parent, child trace.SpanContext) {
+	ctx, parentSpan := StartSpan(context.Background(), "parent", attribute.Int64("height", 10))
+	require.True(t, parentSpan.IsRecording())
+	require.True(t, TraceStores(ctx))
+
+	_, childSpan := StartSpan(ctx, "child", attribute.StringSlice("keys", []string{"a", "b"}))
+	EndSpan(childSpan, errors.New("child failed"))
+	EndSpan(parentSpan, nil)
+
+	require.NoError(t, tracing.Shutdown(context.Background()))
+	require.False(t, TracingEnabled())
+
+	return parentSpan.SpanContext(), childSpan.SpanContext()
+}
+
+func requireOTLPTraces(t *testing.T, bz []byte, parent, child trace.SpanContext) {
+	var traces otlpTraces
+	require.NoError(t, json.Unmarshal(bz, &traces))
+	require.Len(t, traces.ResourceSpans, 1)
+	require.Equal(t, "test", *traces.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	require.Len(t, traces.ResourceSpans[0].ScopeSpans, 1)
+	require.Equal(t, tracerName, traces.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, child.TraceID().String(), spans[0].TraceID)
+	require.Equal(t, child.SpanID().String(), spans[0].SpanID)
+	require.Equal(t, parent.SpanID().String(), spans[0].ParentSpanID)
+	require.Equal(t, otlpStatus{Message: "child failed", Code: 2}, spans[0].Status)
+	require.Len(t, spans[0].Events, 1)
+	require.Equal(t, "exception", spans[0].Events[0].Name)
+	require.Len(t, spans[0].Attributes[0].Value.ArrayValue.Values, 2)
+
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, parent.TraceID().String(), spans[1].TraceID)
+	require.Empty(t, spans[1].ParentSpanID)
+	require.Equal(t, otlpStatus{}, spans[1].Status)
+	require.Equal(t, "height", spans[1].Attributes[0].Key)
+	require.Equal(t, "10", *spans[1].Attributes[0].Value.IntValue)
+}
+
+func TestTracing_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	tracing, err := NewTracing(Config{
+		ServiceName:     "test",
+		TraceExporter:   TraceExporterFile,
+		TraceFile:       path,
+		TraceSampleRate: 1,
+		TraceStores:     true,
+	})
+	require.NoError(t, err)
+	require.True(t, TracingEnabled())
+
+	parent, child := recordSpans(t, tracing)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	require.True(t, scanner.Scan())
+	requireOTLPTraces(t, scanner.Bytes(), parent, child)
+	require.False(t, scanner.Scan())
+}
+
+func TestTracing_OTLP(t *testing.T) {
+	requests := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, otlpTracesPath, r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests <- bz
+	}))
+	defer server.Close()
+
+	tracing, err := NewTracing(Config{
+		ServiceName:     "test",
+		TraceExporter:   TraceExporterOTLP,
+		TraceEndpoint:   server.URL,
+		TraceSampleRate: 1,
+		TraceStores:     true,
+	})
+	require.NoError(t, err)
+
+	parent, child := recordSpans(t, tracing)
+	requireOTLPTraces(t, <-requests, parent, child)
+}
+
+func TestTracing_NotSampled(t *testing.T) {
+	tracing, err := NewTracing(Config{
+		TraceExporter:   TraceExporterFile,
+		TraceFile:       filepath.Join(t.TempDir(), "trace.json"),
+		TraceSampleRate: 0,
+		TraceStores:     true,
+	})
+	require.NoError(t, err)
+	defer tracing.Shutdown(context.Background()) //nolint:errcheck
+
+	ctx, span := StartSpan(context.Background(), "parent")
+	defer span.End()
+	require.False(t, span.IsRecording())
+	require.False(t, TraceStores(ctx))
+}
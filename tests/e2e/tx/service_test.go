@@ -31,6 +31,7 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtest "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -235,6 +236,86 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestSimulateBundle_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.SimulateRequest
+		expErr    bool
+		expErrMsg string
+		expTxErrs []string
+	}{
+		{"empty bundle", &tx.SimulateRequest{Height: height}, true, "empty txBytes is not allowed", nil},
+		{"negative height", &tx.SimulateRequest{TxBytes: txBytes, Height: -1}, true, "invalid height", nil},
+		{
+			"invalid overridden address",
+			&tx.SimulateRequest{TxBytes: txBytes, Overrides: &tx.StateOverrides{Sequences: []*tx.SequenceOverride{{Address: "invalid"}}}},
+			true, "invalid overridden address", nil,
+		},
+		{"tx bundled with itself", &tx.SimulateRequest{TxBytes: txBytes, Bundle: [][]byte{txBytes}}, false, "", []string{"", "account sequence mismatch"}},
+		{"tx at the latest height", &tx.SimulateRequest{Bundle: [][]byte{txBytes}, Height: height}, false, "", []string{""}},
+		{
+			"overridden sequence",
+			&tx.SimulateRequest{TxBytes: txBytes, Overrides: &tx.StateOverrides{
+				Sequences: []*tx.SequenceOverride{{Address: val.Address.String(), Sequence: 100}},
+			}},
+			false, "", []string{"account sequence mismatch"},
+		},
+		{
+			"overridden balance",
+			&tx.SimulateRequest{TxBytes: txBytes, Overrides: &tx.StateOverrides{
+				Balances: []*tx.BalanceOverride{{Address: val.Address.String(), Balance: sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 5))}},
+			}},
+			false, "", []string{"insufficient funds"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.Simulate(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(res.Results, len(tc.expTxErrs))
+			s.Require().Nil(res.Result)
+
+			var gasUsed uint64
+			for i, txErr := range tc.expTxErrs {
+				result := res.Results[i]
+				gasUsed += result.GasInfo.GasUsed
+				if txErr != "" {
+					s.Require().Contains(result.Error, txErr)
+					s.Require().Nil(result.Result)
+					continue
+				}
+				s.Require().Empty(result.Error)
+				s.Require().Len(result.Result.Events, 13)
+			}
+			s.Require().Equal(gasUsed, res.GasInfo.GasUsed)
+
+			// a successful tx pays its fee and increments the sequence of its signer
+			stores := map[string]bool{}
+			for _, pair := range res.StateDiff {
+				stores[pair.StoreKey] = true
+			}
+			if tc.expTxErrs[0] == "" {
+				s.Require().True(stores[banktypes.StoreKey])
+				s.Require().True(stores[authtypes.StoreKey])
+			}
+		})
+	}
+}
+
 func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
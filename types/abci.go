@@ -17,5 +17,9 @@ type BeginBlocker func(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeg
 // e.g. BFT timestamps rather than block height for any periodic EndBlock logic
 type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBlock
 
+// GasScheduler returns the gas schedule of the KVStores accessed while processing a
+// block, which is read from the state at the beginning of the block
+type GasScheduler func(ctx Context) GasSchedule
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
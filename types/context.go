@@ -11,7 +11,10 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/metrickv"
+	"github.com/cosmos/cosmos-sdk/store/spankv"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 /*
@@ -33,12 +36,14 @@ type Context struct {
 	voteInfo      []abci.VoteInfo
 	gasMeter      GasMeter
 	blockGasMeter GasMeter
+	gasSchedule   GasSchedule
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
 	priority      int64 // The tx priority, only relevant in CheckTx
+	msgTypeURL    string
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -55,11 +60,13 @@ func (c Context) Logger() log.Logger          { return c.logger }
 func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
 func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
+func (c Context) GasSchedule() GasSchedule    { return c.gasSchedule }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
 func (c Context) Priority() int64             { return c.priority }
+func (c Context) MsgTypeURL() string          { return c.msgTypeURL }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -194,6 +201,12 @@ func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithGasSchedule returns a Context with an updated gas schedule of the KVStores
+func (c Context) WithGasSchedule(schedule GasSchedule) Context {
+	c.gasSchedule = schedule
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -234,6 +247,12 @@ func (c Context) WithPriority(p int64) Context {
 	return c
 }
 
+// WithMsgTypeURL returns a Context with the type URL of the message it executes
+func (c Context) WithMsgTypeURL(typeURL string) Context {
+	c.msgTypeURL = typeURL
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -256,14 +275,36 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore, charging the gas config of the
+// store in the gas schedule.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.KVGasConfig())
+	return c.traceStore(key, gaskv.NewStore(c.metricStore(key), c.GasMeter(), c.gasSchedule.KVGasConfig(key)))
 }
 
-// TransientStore fetches a TransientStore from the MultiStore.
+// TransientStore fetches a TransientStore from the MultiStore, charging the gas
+// config of the store in the gas schedule.
 func (c Context) TransientStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.TransientGasConfig())
+	return c.traceStore(key, gaskv.NewStore(c.metricStore(key), c.GasMeter(), c.gasSchedule.TransientGasConfig(key)))
+}
+
+// metricStore fetches a KVStore from the MultiStore, wrapped to emit the metrics
+// of its operations labelled by the message type of the Context if the metrics
+// of the store are enabled.
+func (c Context) metricStore(key storetypes.StoreKey) KVStore {
+	store := c.MultiStore().GetKVStore(key)
+	if !storetypes.MetricsEnabled(c.MultiStore(), key) {
+		return store
+	}
+	return metrickv.NewStore(store, key.Name(), c.msgTypeURL)
+}
+
+// traceStore wraps a store to record the spans of its operations if the stores
+// are traced within the span of the Context.
+func (c Context) traceStore(key storetypes.StoreKey, store KVStore) KVStore {
+	if !telemetry.TraceStores(c.baseCtx) {
+		return store
+	}
+	return spankv.NewStore(store, c.baseCtx, key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
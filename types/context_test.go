@@ -5,12 +5,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -51,6 +57,73 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Equal(v2, store.Get(k2))
 }
 
+func (s *contextTestSuite) TestGasSchedule() {
+	key := types.NewKVStoreKey(s.T().Name())
+	tkey := types.NewTransientStoreKey("transient_" + s.T().Name())
+	ctx := testutil.DefaultContext(key, tkey)
+
+	// the default configs are charged without a schedule
+	ctx = ctx.WithGasMeter(types.NewInfiniteGasMeter())
+	ctx.KVStore(key).Get([]byte("key"))
+	ctx.TransientStore(tkey).Get([]byte("key"))
+	kvConfig, tConfig := storetypes.KVGasConfig(), storetypes.TransientGasConfig()
+	s.Require().Equal(kvConfig.ReadCostFlat+3*kvConfig.ReadCostPerByte+tConfig.ReadCostFlat+3*tConfig.ReadCostPerByte, ctx.GasMeter().GasConsumed())
+
+	schedule := types.GasSchedule{
+		StoreConfigs: map[string]types.GasConfig{key.Name(): {ReadCostFlat: 10}, tkey.Name(): {ReadCostFlat: 1}},
+		MsgConfigs:   map[string]map[string]types.GasConfig{"/msg": {key.Name(): {ReadCostFlat: 100}}},
+	}
+	ctx = ctx.WithGasSchedule(schedule).WithGasMeter(types.NewInfiniteGasMeter())
+	s.Require().Equal(schedule, ctx.GasSchedule())
+	ctx.KVStore(key).Get([]byte("key"))
+	ctx.TransientStore(tkey).Get([]byte("key"))
+	s.Require().Equal(types.Gas(11), ctx.GasMeter().GasConsumed())
+
+	ctx = ctx.WithGasSchedule(schedule.ForMsg("/msg")).WithGasMeter(types.NewInfiniteGasMeter())
+	ctx.KVStore(key).Get([]byte("key"))
+	s.Require().Equal(types.Gas(100), ctx.GasMeter().GasConsumed())
+}
+
+func (s *contextTestSuite) TestStoreMetrics() {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	s.Require().NoError(err)
+
+	key := types.NewKVStoreKey("metrics")
+	otherKey := types.NewKVStoreKey("other")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	cms.(*rootmulti.Store).EnableMetrics(key.Name())
+	s.Require().NoError(cms.LoadLatestVersion())
+
+	ctx := types.NewContext(cms.CacheMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+	ctx.KVStore(key).Get([]byte{0x01})
+	ctx.KVStore(otherKey).Get([]byte{0x01})
+	ctx = ctx.WithMsgTypeURL("/msg")
+	s.Require().Equal("/msg", ctx.MsgTypeURL())
+	ctx.KVStore(key).Set([]byte{0x02}, []byte("value"))
+
+	data := sink.Data()
+	s.Require().Len(data, 1)
+	s.Require().Equal(1, data[0].Samples["store.kv.get;store=metrics;msg=none;prefix=01"].Count)
+	s.Require().Equal(1, data[0].Samples["store.kv.set;store=metrics;msg=/msg;prefix=02"].Count)
+	s.Require().NotContains(data[0].Samples, "store.kv.get;store=other;msg=none;prefix=01")
+
+	// a MultiStore which doesn't implement MetricsMultiStore emits no metrics
+	ctx = ctx.WithMultiStore(plainMultiStore{cms.CacheMultiStore()})
+	ctx.KVStore(key).Get([]byte{0x03})
+	s.Require().NotContains(sink.Data()[0].Samples, "store.kv.get;store=metrics;msg=/msg;prefix=03")
+}
+
+// plainMultiStore hides the optional interfaces of a MultiStore.
+type plainMultiStore struct {
+	storetypes.MultiStore
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := types.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_"+s.T().Name()))
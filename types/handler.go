@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -37,10 +45,34 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		// the decorators are only traced within a traced transaction, e.g. not
+		// in CheckTx
+		if telemetry.TracingEnabled() && trace.SpanFromContext(ctx.Context()).IsRecording() {
+			return traceAnteDecorator(ctx, tx, simulate, chain[0], ChainAnteDecorators(chain[1:]...))
+		}
 		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
 	}
 }
 
+// traceAnteDecorator runs an AnteDecorator within a span, which includes the
+// decorators further along the chain it wraps. The span of the Context is
+// restored in the returned Context, so that the spans started with it, e.g.
+// those of the messages, are not children of the span of the decorator.
+func traceAnteDecorator(ctx Context, tx Tx, simulate bool, decorator AnteDecorator, next AnteHandler) (Context, error) {
+	if _, ok := decorator.(Terminator); ok {
+		return decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	spanCtx, span := telemetry.StartSpan(ctx.Context(), fmt.Sprintf("%T", decorator))
+	newCtx, err := decorator.AnteHandle(ctx.WithContext(spanCtx), tx, simulate, next)
+	telemetry.EndSpan(span, err)
+
+	if !newCtx.IsZero() {
+		newCtx = newCtx.WithContext(trace.ContextWithSpan(newCtx.Context(), trace.SpanFromContext(ctx.Context())))
+	}
+	return newCtx, err
+}
+
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
 // Don't need to check if next == nil further up the chain
 //
@@ -0,0 +1,78 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the required methods of an application-side mempool. The
+// mempool is populated by CheckTx and drained by DeliverTx. It is only an
+// extension point: Tendermint v0.34 always builds blocks from its own mempool,
+// and nothing in the SDK selects transactions from the app-side mempool, which
+// applications may read with BaseApp.SelectTxs.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the app-side mempool returning an
+	// error upon failure. The provided context carries the priority computed
+	// by the AnteHandler.
+	Insert(sdk.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the app-side mempool in the order in
+	// which transactions should be included in a block. If txs are specified,
+	// then they shall be incorporated into the Iterator. Select returns nil if
+	// the mempool is empty.
+	Select(sdk.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning an
+	// error upon failure.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an app-side mempool iterator interface that is as minimal
+// as possible. The order of iteration is determined by the app-side mempool
+// implementation.
+type Iterator interface {
+	// Next returns the next transaction from the mempool. If there are no more
+	// transactions, it returns nil.
+	Next() Iterator
+
+	// Tx returns the transaction at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when a transaction that is not in the mempool
+	// is removed.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrMempoolTxMaxCapacity is returned when a transaction is inserted into a
+	// mempool that has reached its configured maximum number of transactions.
+	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+)
+
+// senderNonce returns the address of the first signer of tx together with the
+// sequence of its signature. Mempool implementations that order transactions
+// per sender use it to key their internal indexes.
+func senderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, errors.New("tx must have at least one signer")
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
@@ -0,0 +1,240 @@
+package mempool
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a mempool implementation that orders transactions
+// by priority, as computed by the AnteHandler (e.g. gas price), while keeping
+// the transactions of every sender in strictly increasing nonce (sequence)
+// order.
+//
+// Iteration always yields the highest-priority transaction among the lowest
+// nonce transaction of every sender. Transactions of equal priority are
+// yielded in insertion order, which makes the iteration order deterministic.
+type PriorityNonceMempool struct {
+	mtx     sync.RWMutex
+	senders map[string][]*txEntry
+	count   int
+	maxTx   int
+	seq     uint64
+}
+
+// PriorityNonceMempoolOption defines a functional option for configuring a
+// PriorityNonceMempool.
+type PriorityNonceMempoolOption func(*PriorityNonceMempool)
+
+// PriorityNonceWithMaxTx sets the maximum number of transactions held by the
+// mempool. A value <= 0 means the mempool is unbounded.
+func PriorityNonceWithMaxTx(maxTx int) PriorityNonceMempoolOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// NewPriorityMempool returns a new, empty PriorityNonceMempool.
+func NewPriorityMempool(opts ...PriorityNonceMempoolOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senders: make(map[string][]*txEntry),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// txEntry is a transaction held by the mempool together with the metadata
+// used to order it.
+type txEntry struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	seq      uint64
+}
+
+// Insert implements the Mempool interface. If a transaction with the same
+// sender and nonce is already present, it is replaced only if the new
+// transaction has a strictly higher priority.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	entry := &txEntry{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+		seq:      mp.seq,
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i < len(txs) && txs[i].nonce == nonce {
+		if txs[i].priority >= entry.priority {
+			return fmt.Errorf(
+				"tx with nonce %d already exists for sender %s with priority %d >= %d",
+				nonce, sender, txs[i].priority, entry.priority,
+			)
+		}
+
+		mp.seq++
+		txs[i] = entry
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	mp.seq++
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = entry
+	mp.senders[sender] = txs
+	mp.count++
+
+	return nil
+}
+
+// Select implements the Mempool interface. The returned iterator operates on a
+// snapshot of the mempool, so it is safe to Insert or Remove transactions
+// while iterating. The txs argument is currently ignored.
+func (mp *PriorityNonceMempool) Select(_ sdk.Context, _ [][]byte) Iterator {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	h := make(cursorHeap, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		if len(txs) == 0 {
+			continue
+		}
+
+		snapshot := make([]*txEntry, len(txs))
+		copy(snapshot, txs)
+		h = append(h, &cursor{txs: snapshot})
+	}
+
+	heap.Init(&h)
+
+	return (&priorityNonceIterator{heap: &h}).Next()
+}
+
+// CountTx implements the Mempool interface.
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Remove implements the Mempool interface. It returns ErrTxNotFound if no
+// transaction with the same sender and nonce is present in the mempool.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i == len(txs) || txs[i].nonce != nonce {
+		return ErrTxNotFound
+	}
+
+	txs = append(txs[:i], txs[i+1:]...)
+	if len(txs) == 0 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = txs
+	}
+	mp.count--
+
+	return nil
+}
+
+// priorityNonceIterator walks a snapshot of the mempool, always yielding the
+// best transaction among the heads of every sender's nonce-ordered queue.
+type priorityNonceIterator struct {
+	heap  *cursorHeap
+	entry *txEntry
+}
+
+// Next implements the Iterator interface.
+func (it *priorityNonceIterator) Next() Iterator {
+	if it.heap.Len() == 0 {
+		return nil
+	}
+
+	c := (*it.heap)[0]
+	entry := c.txs[c.pos]
+
+	c.pos++
+	if c.pos == len(c.txs) {
+		heap.Pop(it.heap)
+	} else {
+		heap.Fix(it.heap, 0)
+	}
+
+	return &priorityNonceIterator{heap: it.heap, entry: entry}
+}
+
+// Tx implements the Iterator interface.
+func (it *priorityNonceIterator) Tx() sdk.Tx {
+	return it.entry.tx
+}
+
+// cursor tracks the position of the next transaction to yield from a single
+// sender's nonce-ordered queue.
+type cursor struct {
+	txs []*txEntry
+	pos int
+}
+
+func (c *cursor) head() *txEntry { return c.txs[c.pos] }
+
+// cursorHeap is a max-heap of cursors ordered by the priority of their head
+// transaction, falling back to insertion order for equal priorities.
+type cursorHeap []*cursor
+
+func (h cursorHeap) Len() int { return len(h) }
+
+func (h cursorHeap) Less(i, j int) bool {
+	a, b := h[i].head(), h[j].head()
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	return a.seq < b.seq
+}
+
+func (h cursorHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *cursorHeap) Push(x interface{}) { *h = append(*h, x.(*cursor)) }
+
+func (h *cursorHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return c
+}
@@ -0,0 +1,167 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ authsigning.SigVerifiableTx = testTx{}
+
+// testTx is a minimal SigVerifiableTx carrying a single signer and sequence.
+type testTx struct {
+	id      int
+	address sdk.AccAddress
+	pubKey  cryptotypes.PubKey
+	nonce   uint64
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg           { return nil }
+func (tx testTx) ValidateBasic() error         { return nil }
+func (tx testTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.address} }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx testTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.nonce}}, nil
+}
+
+type account struct {
+	pubKey  cryptotypes.PubKey
+	address sdk.AccAddress
+}
+
+func newAccounts(n int) []account {
+	accounts := make([]account, n)
+	for i := range accounts {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		accounts[i] = account{pubKey: pubKey, address: addr}
+	}
+
+	return accounts
+}
+
+func newCtx() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, nil)
+}
+
+func fetchTxIDs(it mempool.Iterator) []int {
+	var ids []int
+	for ; it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+
+	return ids
+}
+
+func TestPriorityNonceMempool_Ordering(t *testing.T) {
+	accounts := newAccounts(3)
+	sa, sb, sc := accounts[0], accounts[1], accounts[2]
+
+	testCases := []struct {
+		name     string
+		senders  []account
+		txs      []testTx
+		priority []int64
+		expected []int
+	}{
+		{
+			name:    "single sender is ordered by nonce regardless of priority",
+			senders: []account{sa, sa, sa},
+			txs: []testTx{
+				{id: 0, nonce: 2}, {id: 1, nonce: 0}, {id: 2, nonce: 1},
+			},
+			priority: []int64{100, 1, 50},
+			expected: []int{1, 2, 0},
+		},
+		{
+			name:    "senders are interleaved by head priority",
+			senders: []account{sa, sa, sb, sc},
+			txs: []testTx{
+				{id: 0, nonce: 0}, {id: 1, nonce: 1}, {id: 2, nonce: 0}, {id: 3, nonce: 0},
+			},
+			priority: []int64{10, 30, 20, 5},
+			expected: []int{2, 0, 1, 3},
+		},
+		{
+			name:    "equal priorities preserve insertion order",
+			senders: []account{sa, sb, sc},
+			txs: []testTx{
+				{id: 0, nonce: 0}, {id: 1, nonce: 0}, {id: 2, nonce: 0},
+			},
+			priority: []int64{7, 7, 7},
+			expected: []int{0, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			for i, tx := range tc.txs {
+				acc := tc.senders[i]
+				tx.address, tx.pubKey = acc.address, acc.pubKey
+				require.NoError(t, mp.Insert(newCtx().WithPriority(tc.priority[i]), tx))
+			}
+
+			require.Equal(t, len(tc.txs), mp.CountTx())
+			require.Equal(t, tc.expected, fetchTxIDs(mp.Select(newCtx(), nil)))
+		})
+	}
+}
+
+func TestPriorityNonceMempool_Replace(t *testing.T) {
+	acc := newAccounts(1)[0]
+	mp := mempool.NewPriorityMempool()
+
+	tx := testTx{id: 0, address: acc.address, pubKey: acc.pubKey, nonce: 0}
+	require.NoError(t, mp.Insert(newCtx().WithPriority(10), tx))
+
+	// same nonce with lower or equal priority is rejected
+	tx.id = 1
+	require.Error(t, mp.Insert(newCtx().WithPriority(10), tx))
+
+	// same nonce with a higher priority replaces the existing tx
+	tx.id = 2
+	require.NoError(t, mp.Insert(newCtx().WithPriority(11), tx))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{2}, fetchTxIDs(mp.Select(newCtx(), nil)))
+}
+
+func TestPriorityNonceMempool_RemoveAndCapacity(t *testing.T) {
+	accounts := newAccounts(2)
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(2))
+
+	tx0 := testTx{id: 0, address: accounts[0].address, pubKey: accounts[0].pubKey, nonce: 0}
+	tx1 := testTx{id: 1, address: accounts[1].address, pubKey: accounts[1].pubKey, nonce: 0}
+	tx2 := testTx{id: 2, address: accounts[1].address, pubKey: accounts[1].pubKey, nonce: 1}
+
+	require.NoError(t, mp.Insert(newCtx(), tx0))
+	require.NoError(t, mp.Insert(newCtx(), tx1))
+	require.ErrorIs(t, mp.Insert(newCtx(), tx2), mempool.ErrMempoolTxMaxCapacity)
+
+	// an iterator is not affected by later removals
+	it := mp.Select(newCtx(), nil)
+
+	require.NoError(t, mp.Remove(tx0))
+	require.ErrorIs(t, mp.Remove(tx0), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{0, 1}, fetchTxIDs(it))
+
+	require.NoError(t, mp.Insert(newCtx(), tx2))
+	require.Equal(t, []int{1, 2}, fetchTxIDs(mp.Select(newCtx(), nil)))
+
+	require.NoError(t, mp.Remove(tx1))
+	require.NoError(t, mp.Remove(tx2))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newCtx(), nil))
+}
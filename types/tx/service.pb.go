@@ -6,11 +6,14 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
-	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
+	types2 "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -375,6 +378,22 @@ type SimulateRequest struct {
 	//
 	// Since: cosmos-sdk 0.43
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// bundle are raw transactions simulated in sequence after tx_bytes, if set,
+	// each against the state left by the previous ones. Setting a bundle, a
+	// height or overrides simulates the transactions as a bundle.
+	//
+	// Since: cosmos-sdk 0.47
+	Bundle [][]byte `protobuf:"bytes,3,rep,name=bundle,proto3" json:"bundle,omitempty"`
+	// height is the height of the state the transactions are simulated against.
+	// It defaults to the state of the latest block and the transactions checked
+	// since.
+	//
+	// Since: cosmos-sdk 0.47
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// overrides are applied to the state before simulating the transactions.
+	//
+	// Since: cosmos-sdk 0.47
+	Overrides *StateOverrides `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -425,20 +444,235 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetBundle() [][]byte {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SimulateRequest) GetOverrides() *StateOverrides {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// StateOverrides are the overrides of the state a simulation runs against.
+//
+// Since: cosmos-sdk 0.47
+type StateOverrides struct {
+	// balances override the balances of accounts.
+	Balances []*BalanceOverride `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	// sequences override the sequences of accounts, which are created if they
+	// don't exist.
+	Sequences []*SequenceOverride `protobuf:"bytes,2,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// store_kv_pairs set or delete keys of the stores, by store key name. They
+	// are applied after the balances and sequences.
+	StoreKvPairs []*types1.StoreKVPair `protobuf:"bytes,3,rep,name=store_kv_pairs,json=storeKvPairs,proto3" json:"store_kv_pairs,omitempty"`
+}
+
+func (m *StateOverrides) Reset()         { *m = StateOverrides{} }
+func (m *StateOverrides) String() string { return proto.CompactTextString(m) }
+func (*StateOverrides) ProtoMessage()    {}
+func (*StateOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{5}
+}
+func (m *StateOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateOverrides.Merge(m, src)
+}
+func (m *StateOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateOverrides proto.InternalMessageInfo
+
+func (m *StateOverrides) GetBalances() []*BalanceOverride {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *StateOverrides) GetSequences() []*SequenceOverride {
+	if m != nil {
+		return m.Sequences
+	}
+	return nil
+}
+
+func (m *StateOverrides) GetStoreKvPairs() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StoreKvPairs
+	}
+	return nil
+}
+
+// BalanceOverride overrides the balance of an account.
+//
+// Since: cosmos-sdk 0.47
+type BalanceOverride struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance replaces all the balances of the account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *BalanceOverride) Reset()         { *m = BalanceOverride{} }
+func (m *BalanceOverride) String() string { return proto.CompactTextString(m) }
+func (*BalanceOverride) ProtoMessage()    {}
+func (*BalanceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *BalanceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceOverride.Merge(m, src)
+}
+func (m *BalanceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceOverride proto.InternalMessageInfo
+
+func (m *BalanceOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceOverride) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// SequenceOverride overrides the sequence of an account.
+//
+// Since: cosmos-sdk 0.47
+type SequenceOverride struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sequence is the sequence of the account.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *SequenceOverride) Reset()         { *m = SequenceOverride{} }
+func (m *SequenceOverride) String() string { return proto.CompactTextString(m) }
+func (*SequenceOverride) ProtoMessage()    {}
+func (*SequenceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *SequenceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceOverride.Merge(m, src)
+}
+func (m *SequenceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceOverride proto.InternalMessageInfo
+
+func (m *SequenceOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SequenceOverride) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
-	// gas_info is the information about gas used in the simulation.
+	// gas_info is the information about gas used in the simulation. It is the
+	// total gas of the transactions simulated as a bundle.
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
-	// result is the result of the simulation.
+	// result is the result of the simulation. It is not set for the
+	// transactions simulated as a bundle.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// results are the results of the transactions simulated as a bundle, in
+	// order.
+	//
+	// Since: cosmos-sdk 0.47
+	Results []*SimulateTxResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	// state_diff are the changes of the state made by the transactions
+	// simulated as a bundle, by store key name and in ascending key order.
+	//
+	// Since: cosmos-sdk 0.47
+	StateDiff []*types1.StoreKVPair `protobuf:"bytes,4,rep,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
 func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateResponse) ProtoMessage()    {}
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{5}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,6 +715,87 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetResults() []*SimulateTxResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetStateDiff() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
+}
+
+// SimulateTxResult is the result of a transaction simulated in a bundle.
+//
+// Since: cosmos-sdk 0.47
+type SimulateTxResult struct {
+	// gas_info is the information about gas used by the transaction.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the transaction, if it succeeded.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// error is the error of the transaction, if it failed. The state changes
+	// of its messages are reverted, as they are in a block.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SimulateTxResult) Reset()         { *m = SimulateTxResult{} }
+func (m *SimulateTxResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateTxResult) ProtoMessage()    {}
+func (*SimulateTxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *SimulateTxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateTxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateTxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateTxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTxResult.Merge(m, src)
+}
+func (m *SimulateTxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateTxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateTxResult proto.InternalMessageInfo
+
+func (m *SimulateTxResult) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateTxResult) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SimulateTxResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -492,7 +807,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +855,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +913,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,8 +962,8 @@ func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
 type GetBlockWithTxsResponse struct {
 	// txs are the transactions in the block.
 	Txs     []*Tx           `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	BlockId *types1.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Block   *types1.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	BlockId *types2.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types2.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 	// pagination defines a pagination for the response.
 	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -657,7 +972,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,14 +1008,14 @@ func (m *GetBlockWithTxsResponse) GetTxs() []*Tx {
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlockId() *types1.BlockID {
+func (m *GetBlockWithTxsResponse) GetBlockId() *types2.BlockID {
 	if m != nil {
 		return m.BlockId
 	}
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlock() *types1.Block {
+func (m *GetBlockWithTxsResponse) GetBlock() *types2.Block {
 	if m != nil {
 		return m.Block
 	}
@@ -722,7 +1037,11 @@ func init() {
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	proto.RegisterType((*StateOverrides)(nil), "cosmos.tx.v1beta1.StateOverrides")
+	proto.RegisterType((*BalanceOverride)(nil), "cosmos.tx.v1beta1.BalanceOverride")
+	proto.RegisterType((*SequenceOverride)(nil), "cosmos.tx.v1beta1.SequenceOverride")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateTxResult)(nil), "cosmos.tx.v1beta1.SimulateTxResult")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -732,70 +1051,89 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1a, 0xc7,
-	0x17, 0xf7, 0x2e, 0x60, 0xc8, 0xc3, 0x4e, 0xc8, 0xd8, 0x5f, 0x7b, 0x43, 0xf2, 0xc5, 0x64, 0x53,
-	0x6c, 0x82, 0xe4, 0x5d, 0x85, 0xa6, 0x52, 0x55, 0x55, 0xaa, 0xcc, 0x8f, 0x50, 0x9a, 0x26, 0x44,
-	0x8b, 0xab, 0x28, 0x55, 0x25, 0xb4, 0xc0, 0x04, 0x56, 0x81, 0x1d, 0xcc, 0x0c, 0xd6, 0x22, 0xc7,
-	0xaa, 0xd4, 0x63, 0x4f, 0x55, 0x7b, 0xe8, 0xbf, 0xd4, 0x63, 0xa4, 0x5e, 0xda, 0x5b, 0x65, 0xf7,
-	0xd4, 0x53, 0xff, 0x84, 0x6a, 0x67, 0x07, 0x58, 0x60, 0x89, 0x93, 0x5c, 0xec, 0x19, 0xe6, 0xf3,
-	0xde, 0xfb, 0xbc, 0xcf, 0x9b, 0xf7, 0x66, 0x61, 0xaf, 0x45, 0x68, 0x9f, 0x50, 0x9d, 0x39, 0xfa,
-	0xe9, 0x83, 0x26, 0x66, 0xe6, 0x03, 0x9d, 0xe2, 0xe1, 0xa9, 0xd5, 0xc2, 0xda, 0x60, 0x48, 0x18,
-	0x41, 0x37, 0x3d, 0x80, 0xc6, 0x1c, 0x4d, 0x00, 0x92, 0x77, 0x3a, 0x84, 0x74, 0x7a, 0x58, 0x37,
-	0x07, 0x96, 0x6e, 0xda, 0x36, 0x61, 0x26, 0xb3, 0x88, 0x4d, 0x3d, 0x83, 0xe4, 0x3d, 0xe1, 0xb1,
-	0x69, 0x52, 0xac, 0x9b, 0xcd, 0x96, 0x35, 0x75, 0xec, 0x6e, 0x04, 0x28, 0xb9, 0x1c, 0x96, 0x39,
-	0xe2, 0x2c, 0xe7, 0x77, 0x70, 0x32, 0xc2, 0xc3, 0xf1, 0x14, 0x33, 0x30, 0x3b, 0x96, 0xcd, 0xa3,
-	0x09, 0xec, 0x1d, 0x86, 0xed, 0x36, 0x1e, 0xf6, 0x2d, 0x9b, 0xe9, 0x6c, 0x3c, 0xc0, 0x54, 0x6f,
-	0xf6, 0x48, 0xeb, 0xd5, 0xca, 0x53, 0xfe, 0xd7, 0x3b, 0x55, 0xff, 0x94, 0x00, 0x55, 0x30, 0x3b,
-	0x76, 0x68, 0xf9, 0x14, 0xdb, 0xcc, 0xc0, 0x27, 0x23, 0x4c, 0x19, 0xda, 0x81, 0x75, 0xec, 0xee,
-	0xa9, 0x22, 0xa5, 0x43, 0xd9, 0x6b, 0x86, 0xd8, 0xa1, 0xaf, 0x00, 0x66, 0xe1, 0x15, 0x39, 0x2d,
-	0x65, 0xe3, 0xf9, 0x7d, 0x4d, 0xa8, 0xe3, 0x72, 0xd5, 0x38, 0xd7, 0x89, 0x4a, 0xda, 0x33, 0xb3,
-	0x83, 0x85, 0xcf, 0x82, 0xac, 0x48, 0x86, 0xcf, 0x1a, 0x7d, 0x02, 0x31, 0x32, 0x6c, 0xe3, 0x61,
-	0xa3, 0x39, 0x56, 0x42, 0x69, 0x29, 0x7b, 0x3d, 0x9f, 0xd4, 0x96, 0x74, 0xd6, 0x6a, 0x2e, 0xa4,
-	0x30, 0x36, 0xa2, 0xc4, 0x5b, 0x20, 0x04, 0xe1, 0x81, 0xd9, 0xc1, 0x4a, 0x38, 0x2d, 0x65, 0xc3,
-	0x06, 0x5f, 0xa3, 0x6d, 0x88, 0xf4, 0xac, 0xbe, 0xc5, 0x94, 0x08, 0xff, 0xd1, 0xdb, 0xa8, 0xff,
-	0x48, 0xb0, 0x35, 0x97, 0x1b, 0x1d, 0x10, 0x9b, 0x62, 0x74, 0x00, 0x21, 0xe6, 0x78, 0x99, 0xc5,
-	0xf3, 0xff, 0x0b, 0x88, 0x79, 0xec, 0x18, 0x2e, 0x02, 0x55, 0x60, 0x83, 0x39, 0x8d, 0xa1, 0xb0,
-	0xa3, 0x8a, 0xcc, 0x2d, 0x3e, 0x9a, 0xcb, 0x97, 0xd7, 0xd3, 0x67, 0x28, 0xc0, 0x46, 0x9c, 0x4d,
-	0xd7, 0x14, 0x3d, 0x9e, 0x93, 0x2d, 0xc4, 0x65, 0x3b, 0xb8, 0x52, 0x36, 0xcf, 0x7a, 0x49, 0xb7,
-	0x6d, 0x88, 0x30, 0xc2, 0xcc, 0x9e, 0x50, 0xc0, 0xdb, 0xa8, 0x18, 0x50, 0x61, 0x48, 0xcc, 0x76,
-	0xcb, 0xa4, 0xcc, 0xa5, 0xe1, 0xd5, 0xf1, 0x16, 0xc4, 0x98, 0xd3, 0x68, 0x8e, 0x19, 0x76, 0xf3,
-	0x95, 0xb2, 0x1b, 0x46, 0x94, 0x39, 0x05, 0x77, 0x8b, 0x1e, 0x42, 0xb8, 0x4f, 0xda, 0x98, 0x17,
-	0xf1, 0x7a, 0x3e, 0x1d, 0x20, 0xc3, 0xd4, 0xdf, 0x13, 0xd2, 0xc6, 0x06, 0x47, 0xab, 0xdf, 0xc1,
-	0xd6, 0x5c, 0x18, 0x21, 0x69, 0x19, 0xe2, 0x3e, 0xa5, 0x78, 0xa8, 0x77, 0x15, 0x0a, 0x66, 0x42,
-	0xa9, 0xcf, 0xe1, 0x46, 0xdd, 0xea, 0x8f, 0x7a, 0x26, 0x9b, 0xdc, 0x1a, 0x74, 0x1f, 0x64, 0xe6,
-	0x08, 0x87, 0xc1, 0xb5, 0xe2, 0x02, 0xc9, 0xcc, 0x99, 0x4b, 0x56, 0x9e, 0x4b, 0x56, 0xfd, 0x51,
-	0x82, 0xc4, 0xcc, 0xb3, 0x20, 0xfd, 0x39, 0xc4, 0x3a, 0x26, 0x6d, 0x58, 0xf6, 0x4b, 0x22, 0x02,
-	0xdc, 0x5d, 0xcd, 0xb8, 0x62, 0xd2, 0xaa, 0xfd, 0x92, 0x18, 0xd1, 0x8e, 0xb7, 0x40, 0x9f, 0xc2,
-	0xfa, 0x10, 0xd3, 0x51, 0x8f, 0x89, 0x36, 0x48, 0xaf, 0xb6, 0x35, 0x38, 0xce, 0x10, 0x78, 0x55,
-	0x85, 0x0d, 0x7e, 0x2d, 0x27, 0x29, 0x22, 0x08, 0x77, 0x4d, 0xda, 0xe5, 0x1c, 0xae, 0x19, 0x7c,
-	0xad, 0x9e, 0xc3, 0xa6, 0xc0, 0x08, 0xb2, 0x99, 0x2b, 0x75, 0xe0, 0x1a, 0x2c, 0x14, 0x42, 0xfe,
-	0xc0, 0x42, 0x38, 0xb0, 0x53, 0xc1, 0xac, 0xe0, 0x8e, 0x91, 0xe7, 0x16, 0xeb, 0x1e, 0x3b, 0xd4,
-	0x37, 0x19, 0xba, 0xd8, 0xea, 0x74, 0x19, 0xe7, 0x12, 0x32, 0xc4, 0x0e, 0x3d, 0xfa, 0xf0, 0xc9,
-	0xe0, 0xbf, 0xdd, 0xea, 0xbf, 0x12, 0xec, 0x2e, 0x85, 0x7e, 0xdf, 0xc6, 0x7d, 0x08, 0x31, 0x3e,
-	0x02, 0x1b, 0x56, 0x5b, 0x50, 0xb9, 0xa5, 0xcd, 0xc6, 0xa0, 0xe6, 0x0d, 0x40, 0x1e, 0xa2, 0x5a,
-	0x32, 0xa2, 0x1c, 0x5a, 0x6d, 0xa3, 0x43, 0x88, 0xf0, 0xa5, 0x68, 0xd0, 0xdd, 0x15, 0x26, 0x86,
-	0x87, 0x42, 0x95, 0xb9, 0x8c, 0xc3, 0xef, 0xd5, 0xd4, 0xfe, 0x94, 0x73, 0x5f, 0x42, 0x54, 0x4c,
-	0x39, 0xa4, 0xc0, 0x76, 0xcd, 0x28, 0x95, 0x8d, 0x46, 0xe1, 0x45, 0xe3, 0x9b, 0xa7, 0xf5, 0x67,
-	0xe5, 0x62, 0xf5, 0x51, 0xb5, 0x5c, 0x4a, 0xac, 0xa1, 0x04, 0x6c, 0x4c, 0x4f, 0x8e, 0xea, 0xc5,
-	0x84, 0x84, 0x6e, 0xc2, 0xe6, 0xf4, 0x97, 0x52, 0xb9, 0x5e, 0x4c, 0xc8, 0xb9, 0xd7, 0xb0, 0x39,
-	0xd7, 0xb4, 0x28, 0x05, 0xc9, 0x82, 0x51, 0x3b, 0x2a, 0x15, 0x8f, 0xea, 0xc7, 0x8d, 0x27, 0xb5,
-	0x52, 0x79, 0xc1, 0xab, 0x02, 0xdb, 0x0b, 0xe7, 0x85, 0xaf, 0x6b, 0xc5, 0xc7, 0x09, 0x09, 0xed,
-	0xc2, 0xd6, 0xc2, 0x49, 0xfd, 0xc5, 0xd3, 0x62, 0x42, 0x0e, 0x30, 0x39, 0xe2, 0x27, 0xa1, 0xfc,
-	0xcf, 0x11, 0x88, 0xd6, 0xbd, 0x77, 0x13, 0x9d, 0x41, 0x6c, 0xd2, 0x6f, 0x48, 0x0d, 0xa8, 0xd4,
-	0x42, 0x9b, 0x27, 0xef, 0xbd, 0x15, 0x23, 0x6e, 0xe5, 0xfe, 0x0f, 0xbf, 0xff, 0xfd, 0x8b, 0x9c,
-	0x56, 0x6f, 0xeb, 0x01, 0x0f, 0xb6, 0x00, 0x7f, 0x26, 0xe5, 0xd0, 0x09, 0x44, 0x78, 0xf3, 0xa0,
-	0xbd, 0x00, 0xaf, 0xfe, 0xd6, 0x4b, 0xa6, 0x57, 0x03, 0x44, 0xcc, 0x0c, 0x8f, 0xb9, 0x87, 0xfe,
-	0xaf, 0x07, 0xbd, 0xd6, 0x54, 0x3f, 0x73, 0xdb, 0xf5, 0x1c, 0x7d, 0x0f, 0x71, 0xdf, 0x5c, 0x44,
-	0x99, 0xb7, 0x8d, 0xd3, 0x59, 0xf8, 0xfd, 0xab, 0x60, 0x82, 0xc4, 0x5d, 0x4e, 0xe2, 0xb6, 0xba,
-	0x13, 0x4c, 0xc2, 0xcd, 0xf9, 0x35, 0xc4, 0x7d, 0x6f, 0x5d, 0x20, 0x81, 0xe5, 0x77, 0x3e, 0x90,
-	0x40, 0xc0, 0x93, 0xa9, 0xa6, 0x38, 0x01, 0x05, 0xad, 0x20, 0x80, 0x7e, 0x95, 0xe0, 0xc6, 0x42,
-	0xd7, 0xa2, 0xfb, 0xc1, 0xbe, 0x03, 0x86, 0x4a, 0x32, 0xf7, 0x2e, 0x50, 0x41, 0xe5, 0x90, 0x53,
-	0x39, 0x40, 0x99, 0x15, 0x05, 0xe1, 0xcd, 0xa9, 0x9f, 0x79, 0x63, 0xe9, 0xbc, 0xf0, 0xc5, 0x6f,
-	0x17, 0x29, 0xe9, 0xcd, 0x45, 0x4a, 0xfa, 0xeb, 0x22, 0x25, 0xfd, 0x74, 0x99, 0x5a, 0x7b, 0x73,
-	0x99, 0x5a, 0xfb, 0xe3, 0x32, 0xb5, 0xf6, 0x6d, 0xa6, 0x63, 0xb1, 0xee, 0xa8, 0xa9, 0xb5, 0x48,
-	0x7f, 0xe2, 0xca, 0xfb, 0x77, 0x48, 0xdb, 0xaf, 0x26, 0x9f, 0x4a, 0x4e, 0x73, 0x9d, 0x7f, 0x28,
-	0x7d, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x12, 0x2b, 0xbc, 0x25, 0x0a, 0x00, 0x00,
+	// 1311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xfa, 0x4f, 0xec, 0x3c, 0x87, 0x60, 0x86, 0x34, 0x2c, 0xa6, 0x75, 0xcc, 0xd2, 0x04,
+	0x13, 0x09, 0x6f, 0x49, 0xa9, 0x54, 0x55, 0x6d, 0x51, 0xfc, 0x87, 0x90, 0x06, 0x08, 0x1a, 0xa7,
+	0xad, 0xa8, 0x2a, 0x59, 0x6b, 0xef, 0x64, 0xb3, 0xc2, 0xd9, 0x31, 0x3b, 0x93, 0x68, 0x23, 0x40,
+	0x95, 0xfa, 0x09, 0x10, 0x3d, 0xf4, 0xda, 0x73, 0x3f, 0x09, 0x47, 0xa4, 0x5e, 0xda, 0x4b, 0xff,
+	0x40, 0x4f, 0x3d, 0xf5, 0x23, 0x54, 0x3b, 0x3b, 0x6b, 0xef, 0x3a, 0xeb, 0x04, 0xb8, 0xf4, 0x92,
+	0xcc, 0xdb, 0xf7, 0x7b, 0xf3, 0xde, 0xfb, 0xcd, 0x9b, 0x37, 0xcf, 0xb0, 0xd8, 0xa3, 0x6c, 0x8f,
+	0x32, 0x9d, 0x7b, 0xfa, 0xc1, 0xb5, 0x2e, 0xe1, 0xc6, 0x35, 0x9d, 0x11, 0xf7, 0xc0, 0xee, 0x91,
+	0xda, 0xc0, 0xa5, 0x9c, 0xa2, 0x33, 0x01, 0xa0, 0xc6, 0xbd, 0x9a, 0x04, 0x94, 0xe6, 0x2d, 0x6a,
+	0x51, 0xa1, 0xd5, 0xfd, 0x55, 0x00, 0x2c, 0xbd, 0x6b, 0x51, 0x6a, 0xf5, 0x89, 0x6e, 0x0c, 0x6c,
+	0xdd, 0x70, 0x1c, 0xca, 0x0d, 0x6e, 0x53, 0x87, 0x49, 0xed, 0x25, 0xe9, 0xa7, 0x6b, 0x30, 0xa2,
+	0x1b, 0xdd, 0x9e, 0x3d, 0x74, 0xe7, 0x0b, 0x12, 0x74, 0x25, 0x0a, 0x62, 0x9c, 0xba, 0x64, 0x88,
+	0xea, 0xdb, 0x8c, 0x13, 0xc7, 0x76, 0x2c, 0x09, 0x2d, 0x47, 0xa1, 0x21, 0xa8, 0x47, 0x6d, 0x47,
+	0xea, 0x4b, 0x47, 0xf3, 0xe2, 0x9e, 0xd4, 0xad, 0x44, 0x6d, 0x1f, 0xee, 0x13, 0xf7, 0x70, 0x88,
+	0x19, 0x18, 0x96, 0xed, 0x88, 0xc0, 0xc3, 0xac, 0x38, 0x71, 0x4c, 0xe2, 0xee, 0xd9, 0x0e, 0xd7,
+	0xf9, 0xe1, 0x80, 0x30, 0xbd, 0xdb, 0xa7, 0xbd, 0x07, 0x13, 0xb5, 0xe2, 0x6f, 0xa0, 0xd5, 0x7e,
+	0x53, 0x00, 0xad, 0x13, 0xbe, 0xed, 0xb1, 0xd6, 0x01, 0x71, 0x38, 0x26, 0x0f, 0xf7, 0x09, 0xe3,
+	0x68, 0x01, 0xa6, 0x89, 0x2f, 0x33, 0x55, 0xa9, 0xa4, 0xab, 0x33, 0x58, 0x4a, 0xe8, 0x0b, 0x80,
+	0x91, 0x7b, 0x35, 0x55, 0x51, 0xaa, 0x85, 0xd5, 0xe5, 0x9a, 0xa4, 0xdf, 0x8f, 0xb5, 0x26, 0x62,
+	0x0d, 0x8f, 0xa1, 0x76, 0xcf, 0xb0, 0x88, 0xdc, 0xb3, 0x9e, 0x52, 0x15, 0x1c, 0xb1, 0x46, 0x1f,
+	0x41, 0x9e, 0xba, 0x26, 0x71, 0x3b, 0xdd, 0x43, 0x35, 0x5d, 0x51, 0xaa, 0x73, 0xab, 0xa5, 0xda,
+	0x91, 0x83, 0xac, 0x6d, 0xf9, 0x90, 0xfa, 0x21, 0xce, 0xd1, 0x60, 0x81, 0x10, 0x64, 0x06, 0x86,
+	0x45, 0xd4, 0x4c, 0x45, 0xa9, 0x66, 0xb0, 0x58, 0xa3, 0x79, 0xc8, 0xf6, 0xed, 0x3d, 0x9b, 0xab,
+	0x59, 0xf1, 0x31, 0x10, 0xb4, 0x7f, 0x14, 0x38, 0x1b, 0xcb, 0x8d, 0x0d, 0xa8, 0xc3, 0x08, 0xba,
+	0x0c, 0x69, 0xee, 0x05, 0x99, 0x15, 0x56, 0xdf, 0x49, 0xf0, 0xb9, 0xed, 0x61, 0x1f, 0x81, 0xd6,
+	0x61, 0x96, 0x7b, 0x1d, 0x57, 0xda, 0x31, 0x35, 0x25, 0x2c, 0xde, 0x8f, 0xe5, 0x2b, 0x4a, 0x23,
+	0x62, 0x28, 0xc1, 0xb8, 0xc0, 0x87, 0x6b, 0x86, 0x36, 0x63, 0xb4, 0xa5, 0x05, 0x6d, 0x97, 0x4f,
+	0xa4, 0x2d, 0xb0, 0x3e, 0xc2, 0xdb, 0x3c, 0x64, 0x39, 0xe5, 0x46, 0x5f, 0x32, 0x10, 0x08, 0x1a,
+	0x01, 0x54, 0x77, 0xa9, 0x61, 0xf6, 0x0c, 0xc6, 0xb7, 0x3d, 0xc9, 0x39, 0x3a, 0x0f, 0x79, 0xee,
+	0x75, 0xba, 0x87, 0x9c, 0xf8, 0xf9, 0x2a, 0xd5, 0x59, 0x9c, 0xe3, 0x5e, 0xdd, 0x17, 0xd1, 0x75,
+	0xc8, 0xec, 0x51, 0x93, 0x88, 0x43, 0x9c, 0x5b, 0xad, 0x24, 0xd0, 0x30, 0xdc, 0xef, 0x0e, 0x35,
+	0x09, 0x16, 0x68, 0xed, 0x5b, 0x38, 0x1b, 0x73, 0x23, 0x29, 0x6d, 0x41, 0x21, 0xc2, 0x94, 0x70,
+	0xf5, 0xba, 0x44, 0xc1, 0x88, 0x28, 0xed, 0xb9, 0x02, 0xa7, 0xdb, 0xf6, 0xde, 0x7e, 0xdf, 0xe0,
+	0x61, 0xd9, 0xa0, 0x2b, 0x90, 0xe2, 0x9e, 0xdc, 0x31, 0xf9, 0xb0, 0x04, 0x43, 0x29, 0xee, 0xc5,
+	0xb2, 0x4d, 0xc5, 0xb3, 0x5d, 0x80, 0xe9, 0xee, 0xbe, 0x63, 0xf6, 0x89, 0x9a, 0xae, 0xa4, 0xab,
+	0xb3, 0x58, 0x4a, 0xfe, 0xf7, 0x5d, 0x62, 0x5b, 0xbb, 0x5c, 0xb0, 0x99, 0xc6, 0x52, 0x42, 0x37,
+	0x60, 0x86, 0x1e, 0x10, 0xd7, 0xb5, 0x4d, 0xc2, 0x44, 0x55, 0x15, 0x56, 0x2f, 0x26, 0x38, 0x6f,
+	0x73, 0x83, 0x93, 0xad, 0x10, 0x88, 0x47, 0x36, 0xda, 0x5f, 0x0a, 0xcc, 0xc5, 0xb5, 0xe8, 0x73,
+	0xc8, 0x77, 0x8d, 0xbe, 0xe1, 0xf4, 0x48, 0x58, 0x7c, 0x5a, 0x12, 0xeb, 0x01, 0x24, 0x34, 0xc3,
+	0x43, 0x1b, 0xb4, 0x06, 0x33, 0xcc, 0x27, 0xc5, 0xe9, 0x0d, 0x6b, 0xf1, 0x52, 0x52, 0x4c, 0x12,
+	0x33, 0xdc, 0x61, 0x64, 0x85, 0x6e, 0xc3, 0x9c, 0xe8, 0x59, 0x9d, 0x07, 0x07, 0x9d, 0x81, 0x61,
+	0xbb, 0x4c, 0xd0, 0x31, 0x7e, 0x87, 0x05, 0x24, 0x92, 0x23, 0x75, 0xc9, 0xe6, 0x57, 0xf7, 0x0c,
+	0xdb, 0xc5, 0xb3, 0x42, 0xb5, 0x79, 0xe0, 0x0b, 0x4c, 0x7b, 0xa6, 0xc0, 0xe9, 0xb1, 0x70, 0x91,
+	0x0a, 0x39, 0xc3, 0x34, 0x5d, 0xc2, 0x82, 0x82, 0x9b, 0xc1, 0xa1, 0x88, 0x08, 0xe4, 0x64, 0x2a,
+	0x32, 0xf8, 0xf3, 0x31, 0xa7, 0xa1, 0xbb, 0x06, 0xb5, 0x9d, 0xfa, 0x07, 0xcf, 0x7f, 0x5f, 0x9c,
+	0xfa, 0xf9, 0x8f, 0xc5, 0xaa, 0x65, 0xf3, 0xdd, 0xfd, 0x6e, 0xad, 0x47, 0xf7, 0x74, 0xd9, 0x11,
+	0x83, 0x7f, 0x57, 0x99, 0xf9, 0x40, 0x36, 0x32, 0xdf, 0x80, 0xe1, 0x70, 0x6f, 0xed, 0x16, 0x14,
+	0xc7, 0x19, 0x38, 0x26, 0xa8, 0x12, 0xe4, 0x43, 0x76, 0x44, 0xc9, 0x64, 0xf0, 0x50, 0xd6, 0x9e,
+	0xa6, 0xa0, 0x38, 0xaa, 0x46, 0x59, 0xe9, 0x9f, 0x42, 0xde, 0x32, 0x58, 0xc7, 0x76, 0x76, 0xa8,
+	0xaa, 0xc4, 0xeb, 0xe2, 0x68, 0x99, 0xaf, 0x1b, 0x6c, 0xc3, 0xd9, 0xa1, 0x38, 0x67, 0x05, 0x0b,
+	0xf4, 0x31, 0x4c, 0xbb, 0x84, 0xed, 0xf7, 0xb9, 0xec, 0x9d, 0x95, 0xc9, 0xb6, 0x58, 0xe0, 0xb0,
+	0xc4, 0xa3, 0xcf, 0x20, 0x17, 0xac, 0xc2, 0x23, 0x4b, 0x3c, 0x7a, 0x19, 0xed, 0xb6, 0x27, 0xad,
+	0x43, 0x1b, 0xd4, 0x02, 0x60, 0x7e, 0x35, 0x76, 0x4c, 0x7b, 0x67, 0x47, 0xcd, 0xbc, 0xd1, 0xa1,
+	0xcf, 0x08, 0xcb, 0xa6, 0xbd, 0xb3, 0xa3, 0xfd, 0xa4, 0x40, 0x71, 0xdc, 0xc9, 0xff, 0x46, 0xc9,
+	0x3c, 0x64, 0x89, 0xeb, 0x52, 0x57, 0x34, 0xd4, 0x19, 0x1c, 0x08, 0x9a, 0x06, 0xb3, 0xa2, 0xe9,
+	0x87, 0xfd, 0x03, 0x41, 0x66, 0xd7, 0x60, 0xbb, 0xf2, 0xe0, 0xc5, 0x5a, 0x7b, 0x02, 0xa7, 0x24,
+	0x46, 0x9e, 0xea, 0xd2, 0x89, 0x4d, 0x46, 0x34, 0x98, 0xb1, 0x36, 0x97, 0x7a, 0xcb, 0x36, 0xe7,
+	0xc1, 0xc2, 0x3a, 0xe1, 0x75, 0xff, 0x91, 0xfe, 0xda, 0xe6, 0xbb, 0xdb, 0x1e, 0x8b, 0xbc, 0xbb,
+	0xb2, 0x1d, 0x29, 0xb1, 0x76, 0x74, 0xf3, 0xed, 0xdf, 0xdd, 0xe8, 0xdb, 0xa1, 0xfd, 0xab, 0xc0,
+	0xb9, 0x23, 0xae, 0xdf, 0xf4, 0x59, 0xbc, 0x0e, 0x79, 0x31, 0x60, 0x74, 0x6c, 0x53, 0x86, 0x72,
+	0xbe, 0x36, 0x1a, 0x32, 0x6a, 0xc1, 0xad, 0x14, 0x2e, 0x36, 0x9a, 0x38, 0x27, 0xa0, 0x1b, 0x26,
+	0xba, 0x0a, 0x59, 0xb1, 0x94, 0xcf, 0xdf, 0xb9, 0x09, 0x26, 0x38, 0x40, 0xa1, 0xf5, 0x58, 0xc6,
+	0x99, 0x37, 0x7a, 0x32, 0xa3, 0x29, 0xaf, 0xdc, 0x82, 0x9c, 0x9c, 0x21, 0x90, 0x0a, 0xf3, 0x5b,
+	0xb8, 0xd9, 0xc2, 0x9d, 0xfa, 0xfd, 0xce, 0x97, 0x77, 0xdb, 0xf7, 0x5a, 0x8d, 0x8d, 0x9b, 0x1b,
+	0xad, 0x66, 0x71, 0x0a, 0x15, 0x61, 0x76, 0xa8, 0x59, 0x6b, 0x37, 0x8a, 0x0a, 0x3a, 0x03, 0xa7,
+	0x86, 0x5f, 0x9a, 0xad, 0x76, 0xa3, 0x98, 0x5a, 0x79, 0x0c, 0xa7, 0x62, 0x4f, 0x22, 0x2a, 0x43,
+	0xa9, 0x8e, 0xb7, 0xd6, 0x9a, 0x8d, 0xb5, 0xf6, 0x76, 0xe7, 0xce, 0x56, 0xb3, 0x35, 0xb6, 0xab,
+	0x0a, 0xf3, 0x63, 0xfa, 0xfa, 0xed, 0xad, 0xc6, 0x66, 0x51, 0x41, 0xe7, 0xe0, 0xec, 0x98, 0xa6,
+	0x7d, 0xff, 0x6e, 0xa3, 0x98, 0x4a, 0x30, 0x59, 0x13, 0x9a, 0xf4, 0xea, 0xb3, 0x2c, 0xe4, 0xda,
+	0xc1, 0xd8, 0x8b, 0x1e, 0x41, 0x3e, 0xbc, 0x85, 0x48, 0x3b, 0xa6, 0x0f, 0xc8, 0x12, 0x28, 0x5d,
+	0x3a, 0x16, 0x23, 0xab, 0x72, 0xf9, 0xfb, 0x5f, 0xfe, 0xfe, 0x21, 0x55, 0xd1, 0x2e, 0xe8, 0x09,
+	0xf3, 0xb6, 0x04, 0x7f, 0xa2, 0xac, 0xa0, 0x87, 0x90, 0x15, 0x97, 0x07, 0x2d, 0x26, 0xec, 0x1a,
+	0xbd, 0x7a, 0xa5, 0xca, 0x64, 0x80, 0xf4, 0xb9, 0x24, 0x7c, 0x2e, 0xa2, 0xf7, 0xf4, 0xa4, 0x59,
+	0x98, 0xe9, 0x8f, 0xfc, 0xeb, 0xfa, 0x04, 0x7d, 0x07, 0x85, 0xc8, 0xd4, 0x81, 0x96, 0x8e, 0x1b,
+	0x56, 0x46, 0xee, 0x97, 0x4f, 0x82, 0xc9, 0x20, 0x2e, 0x8a, 0x20, 0x2e, 0x68, 0x0b, 0xc9, 0x41,
+	0xf8, 0x39, 0x3f, 0x86, 0x42, 0x64, 0x92, 0x4c, 0x0c, 0xe0, 0xe8, 0x14, 0x5d, 0x5a, 0x3e, 0x09,
+	0x26, 0x03, 0x28, 0x8b, 0x00, 0x54, 0x34, 0x21, 0x00, 0xf4, 0xa3, 0x02, 0xa7, 0xc7, 0x6e, 0x2d,
+	0xba, 0x92, 0xbc, 0x77, 0x42, 0x53, 0x29, 0xad, 0xbc, 0x0e, 0x54, 0x86, 0x72, 0x55, 0x84, 0x72,
+	0x19, 0x2d, 0x4d, 0x38, 0x10, 0x71, 0x39, 0xf5, 0x47, 0x41, 0x5b, 0x7a, 0x52, 0xbf, 0xf1, 0xfc,
+	0x65, 0x59, 0x79, 0xf1, 0xb2, 0xac, 0xfc, 0xf9, 0xb2, 0xac, 0x3c, 0x7d, 0x55, 0x9e, 0x7a, 0xf1,
+	0xaa, 0x3c, 0xf5, 0xeb, 0xab, 0xf2, 0xd4, 0x37, 0x4b, 0x27, 0xbf, 0xdc, 0x3a, 0xf7, 0xba, 0xd3,
+	0xe2, 0x67, 0xc8, 0x87, 0xff, 0x0d, 0x00, 0x37, 0xef, 0x0b, 0xd2, 0xe4, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1253,6 +1591,32 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Overrides != nil {
+		{
+			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Bundle) > 0 {
+		for iNdEx := len(m.Bundle) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bundle[iNdEx])
+			copy(dAtA[i:], m.Bundle[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Bundle[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1275,7 +1639,7 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+func (m *StateOverrides) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1285,44 +1649,62 @@ func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StateOverrides) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StateOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StoreKvPairs) > 0 {
+		for iNdEx := len(m.StoreKvPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreKvPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.GasInfo != nil {
-		{
-			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Sequences) > 0 {
+		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *BalanceOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1332,28 +1714,236 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BalanceOverride) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BalanceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SequenceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateDiff) > 0 {
+		for iNdEx := len(m.StateDiff) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateDiff[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateTxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateTxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -1622,85 +2212,193 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.Bundle) > 0 {
+		for _, b := range m.Bundle {
+			l = len(b)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Overrides != nil {
+		l = m.Overrides.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
-func (m *SimulateResponse) Size() (n int) {
+func (m *StateOverrides) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GasInfo != nil {
-		l = m.GasInfo.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Sequences) > 0 {
+		for _, e := range m.Sequences {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.StoreKvPairs) > 0 {
+		for _, e := range m.StoreKvPairs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *GetTxRequest) Size() (n int) {
+func (m *BalanceOverride) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetTxResponse) Size() (n int) {
+func (m *SequenceOverride) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
 	}
 	return n
 }
 
-func (m *GetBlockWithTxsRequest) Size() (n int) {
+func (m *SimulateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovService(uint64(m.Height))
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	if m.Result != nil {
+		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.StateDiff) > 0 {
+		for _, e := range m.StateDiff {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetBlockWithTxsResponse) Size() (n int) {
+func (m *SimulateTxResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.BlockId != nil {
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.BlockId != nil {
 		l = m.BlockId.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -1841,7 +2539,559 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			m.Page = 0
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &Tx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
+			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BroadcastMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundle = append(m.Bundle, make([]byte, postIndex-iNdEx))
+			copy(m.Bundle[len(m.Bundle)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1851,16 +3101,16 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1870,11 +3120,28 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Overrides == nil {
+				m.Overrides = &StateOverrides{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1896,7 +3163,7 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+func (m *StateOverrides) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1919,15 +3186,15 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateOverrides: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1954,14 +3221,14 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &Tx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, &BalanceOverride{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1988,14 +3255,14 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
-			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sequences = append(m.Sequences, &SequenceOverride{})
+			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKvPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2022,32 +3289,11 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StoreKvPairs = append(m.StoreKvPairs, &types1.StoreKVPair{})
+			if err := m.StoreKvPairs[len(m.StoreKvPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2069,7 +3315,7 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+func (m *BalanceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2092,17 +3338,17 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BalanceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BalanceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2112,31 +3358,29 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2146,11 +3390,26 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= BroadcastMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2172,7 +3431,7 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+func (m *SequenceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,17 +3454,17 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SequenceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SequenceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2215,28 +3474,43 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxResponse == nil {
-				m.TxResponse = &types.TxResponse{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2258,7 +3532,7 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2281,15 +3555,15 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2316,18 +3590,18 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
 			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2337,24 +3611,94 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &SimulateTxResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateDiff = append(m.StateDiff, &types1.StoreKVPair{})
+			if err := m.StateDiff[len(m.StateDiff)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2378,7 +3722,7 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateTxResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2401,10 +3745,10 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateTxResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2479,6 +3823,38 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2902,7 +4278,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
+				m.BlockId = &types2.BlockID{}
 			}
 			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2938,7 +4314,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Block == nil {
-				m.Block = &types1.Block{}
+				m.Block = &types2.Block{}
 			}
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -106,3 +108,26 @@ func (ak AccountKeeper) IterateAccounts(ctx sdk.Context, cb func(account types.A
 		}
 	}
 }
+
+// OverrideState sets the sequences of the accounts overridden in the state a
+// simulation runs against, creating the accounts which don't exist. It is set
+// as a state overrider of the BaseApp.
+func (ak AccountKeeper) OverrideState(ctx sdk.Context, overrides *tx.StateOverrides) error {
+	for _, override := range overrides.Sequences {
+		addr, err := sdk.AccAddressFromBech32(override.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid overridden address %s: %s", override.Address, err)
+		}
+
+		acc := ak.GetAccount(ctx, addr)
+		if acc == nil {
+			acc = ak.NewAccountWithAddress(ctx, addr)
+		}
+		if err := acc.SetSequence(override.Sequence); err != nil {
+			return err
+		}
+		ak.SetAccount(ctx, acc)
+	}
+
+	return nil
+}
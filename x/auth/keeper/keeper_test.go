@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -135,6 +136,35 @@ func (suite *KeeperTestSuite) TestAccountMapperRemoveAccount() {
 	suite.Require().Equal(accSeq2, acc2.GetSequence())
 }
 
+func (suite *KeeperTestSuite) TestOverrideState() {
+	ctx := suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	acc1 := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.accountKeeper.SetAccount(ctx, acc1)
+
+	overrides := &tx.StateOverrides{
+		Sequences: []*tx.SequenceOverride{
+			{Address: addr1.String(), Sequence: 5},
+			{Address: addr2.String(), Sequence: 7},
+		},
+	}
+	suite.Require().NoError(suite.accountKeeper.OverrideState(ctx, overrides))
+
+	// the overridden accounts are created if they don't exist
+	acc1 = suite.accountKeeper.GetAccount(ctx, addr1)
+	suite.Require().NotNil(acc1)
+	suite.Require().Equal(uint64(5), acc1.GetSequence())
+	acc2 := suite.accountKeeper.GetAccount(ctx, addr2)
+	suite.Require().NotNil(acc2)
+	suite.Require().Equal(uint64(7), acc2.GetSequence())
+	suite.Require().NotEqual(acc1.GetAccountNumber(), acc2.GetAccountNumber())
+
+	overrides = &tx.StateOverrides{Sequences: []*tx.SequenceOverride{{Address: "invalid"}}}
+	suite.Require().Error(suite.accountKeeper.OverrideState(ctx, overrides))
+}
+
 func (suite *KeeperTestSuite) TestGetSetParams() {
 	ctx := suite.ctx
 	params := types.DefaultParams()
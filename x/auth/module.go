@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -208,6 +209,7 @@ type authOutputs struct {
 
 	AccountKeeper keeper.AccountKeeper
 	Module        runtime.AppModuleWrapper
+	BaseAppOption runtime.BaseAppOption
 }
 
 func provideModule(in authInputs) authOutputs {
@@ -219,5 +221,10 @@ func provideModule(in authInputs) authOutputs {
 	k := keeper.NewAccountKeeper(in.Cdc, in.Key, types.ProtoBaseAccount, maccPerms, in.Config.Bech32Prefix, types.NewModuleAddress(govtypes.ModuleName).String())
 	m := NewAppModule(in.Cdc, k, simulation.RandomGenesisAccounts, in.LegacySubspace)
 
-	return authOutputs{AccountKeeper: k, Module: runtime.WrapAppModule(m)}
+	// the account sequences can be overridden in the state of simulations
+	baseAppOption := func(app *baseapp.BaseApp) {
+		app.AddStateOverrider(k.OverrideState)
+	}
+
+	return authOutputs{AccountKeeper: k, Module: runtime.WrapAppModule(m), BaseAppOption: baseAppOption}
 }
@@ -79,8 +79,9 @@ func provideModule(in txInputs) txOutputs {
 			app.SetPostHandler(postHandler)
 		}
 
-		// TxDecoder
+		// TxDecoder/TxEncoder
 		app.SetTxDecoder(txConfig.TxDecoder())
+		app.SetTxEncoder(txConfig.TxEncoder())
 	}
 
 	return txOutputs{TxConfig: txConfig, BaseAppOption: baseAppOption}
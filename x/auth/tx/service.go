@@ -12,9 +12,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -24,18 +26,24 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateBundleFn is the signature of the Baseapp#SimulateBundle function.
+type baseAppSimulateBundleFn func(height int64, overrides *txtypes.StateOverrides, txs [][]byte) ([]baseapp.SimulationResult, []*storetypes.StoreKVPair, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateBundle    baseAppSimulateBundleFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
-// NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+// NewTxServer creates a new Tx service server. simulateBundle may be nil if
+// the app doesn't support simulating bundles of txs with state overrides.
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, simulateBundle baseAppSimulateBundleFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
+		simulateBundle:    simulateBundle,
 		interfaceRegistry: interfaceRegistry,
 	}
 }
@@ -123,6 +131,10 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		}
 	}
 
+	if len(req.Bundle) > 0 || req.Height != 0 || req.Overrides != nil {
+		return s.simulateBundleRequest(txBytes, req)
+	}
+
 	if txBytes == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
@@ -138,6 +150,57 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// simulateBundleRequest simulates the tx of the request, if any, followed by
+// its bundle of txs, at the height of the request with its state overrides.
+func (s txServer) simulateBundleRequest(txBytes []byte, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if s.simulateBundle == nil {
+		return nil, status.Error(codes.Unimplemented, "simulating bundles is not supported by the app")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	txs := req.Bundle
+	if txBytes != nil {
+		txs = append([][]byte{txBytes}, req.Bundle...)
+	}
+	if len(txs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+	for i, tx := range txs {
+		if len(tx) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty tx %d in bundle", i)
+		}
+	}
+
+	results, diff, err := s.simulateBundle(req.Height, req.Overrides, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	gasInfo := sdk.GasInfo{}
+	txResults := make([]*txtypes.SimulateTxResult, len(results))
+	for i, result := range results {
+		gasInfo.GasWanted += result.GasInfo.GasWanted
+		gasInfo.GasUsed += result.GasInfo.GasUsed
+
+		txResult := &txtypes.SimulateTxResult{
+			GasInfo: &results[i].GasInfo,
+			Result:  result.Result,
+		}
+		if result.Err != nil {
+			txResult.Error = result.Err.Error()
+		}
+		txResults[i] = txResult
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo:   &gasInfo,
+		Results:   txResults,
+		StateDiff: diff,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -257,11 +320,12 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateBundleFn baseAppSimulateBundleFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateBundleFn, interfaceRegistry),
 	)
 }
 
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Query command flags
+const (
+	FlagInterval = "interval"
+)
+
+// GetQueryCmd returns the vesting module's query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryProjection(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryProjection returns a CLI command handler for querying the coins
+// vested and locked by the schedule of a vesting account over time.
+func GetCmdQueryProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection [address]",
+		Short: "Query the coins vested and locked by a vesting account over time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins vested and locked by the schedule of a vesting account, from the
+current block time to the end time of the account. The points of the projection are the times at which the
+schedule of the account changes, or the times the given interval in seconds apart.
+
+Example:
+$ %s query %s projection [address] --%s=86400
+`, version.AppName, types.ModuleName, FlagInterval),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetInt64(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Projection(cmd.Context(), &types.QueryProjectionRequest{
+				Address:  addr.String(),
+				Interval: interval,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagInterval, 0, "Number of seconds between the points of the projection, the schedule changes if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,7 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +35,10 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+		NewMsgAddVestingGrantCmd(),
+		NewMsgAssignVestingFunderCmd(),
 	)
 
 	return txCmd
@@ -178,23 +183,247 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			var periods []types.Period
+			periods, err := parsePeriods(vestingData.Periods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ClawbackVestingData is the schedules of a clawback vesting account, read
+// from a JSON file.
+type ClawbackVestingData struct {
+	StartTime      int64         `json:"start_time"`
+	LockupPeriods  []InputPeriod `json:"lockup_periods"`
+	VestingPeriods []InputPeriod `json:"vesting_periods"`
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens, which the sender can claw back until vested.",
+		Long: `Create a new vesting account whose coins unlock by the lockup periods and vest by the vesting periods,
+both starting at the start time, and are spendable once unlocked and vested. The sender is the funder of the
+account and can claw back its unvested coins. The coins are unlocked, or vested, at the start time if the lockup,
+or vesting, periods are omitted. For instance, the following periods.json file shows 20 "test" coins vesting
+30 days apart from each other, and unlocked all together after a year.
+		Where periods.json contains:
+
+{ "start_time": 1625204910,
+"lockup_periods":[
+ {
+  "coins": "20test",
+  "length_seconds":31536000 //1 year
+ }
+],
+"vesting_periods":[
+ {
+  "coins": "10test",
+  "length_seconds":2592000 //30 days
+ },
+ {
+	"coins": "10test",
+	"length_seconds":2592000 //30 days
+ }
+]
+	}
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData ClawbackVestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			lockupPeriods, err := parsePeriods(vestingData.LockupPeriods)
+			if err != nil {
+				return err
+			}
+			vestingPeriods, err := parsePeriods(vestingData.VestingPeriods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested coins of a clawback vesting account funded by the sender.",
+		Long: `Claw back the coins of a clawback vesting account which are not vested yet, including the
+delegated ones, which are undelegated. The coins are sent to the sender, or to the address given by the
+'--dest' flag. The coins vested remain in the account, locked until unlocked by the lockup periods.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+			var dest sdk.AccAddress
+			if destArg, _ := cmd.Flags().GetString(FlagDest); destArg != "" {
+				dest, err = sdk.AccAddressFromBech32(destArg)
 				if err != nil {
 					return err
 				}
+			}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
-				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the coins clawed back, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgAddVestingGrantCmd returns a CLI command handler for creating a
+// MsgAddVestingGrant transaction.
+func NewMsgAddVestingGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vesting-grant [to_address] [periods_json_file]",
+		Short: "Add a grant of tokens to an existing periodic vesting account.",
+		Long: `Add a grant of tokens vesting by the given periods, starting at the start time, to an existing
+periodic vesting account. The periods of the grant are merged into the vesting schedule of the account, so that
+the tokens of both vest at the times they would vest on their own. The periods_json_file has the same format as
+the one of the create-periodic-vesting-account command. Only the funder of the account can add grants to it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData VestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			periods, err := parsePeriods(vestingData.Periods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddVestingGrant(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePeriods parses the periods of a vesting data file.
+func parsePeriods(inputPeriods []InputPeriod) ([]types.Period, error) {
+	var periods []types.Period
+
+	for i, p := range inputPeriods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Length < 0 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return periods, nil
+}
+
+// NewMsgAssignVestingFunderCmd returns a CLI command handler for creating a
+// MsgAssignVestingFunder transaction.
+func NewMsgAssignVestingFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assign-vesting-funder [funder_address]",
+		Short: "Assign the funder of the periodic vesting account of the sender.",
+		Long: `Assign the funder which can add grants to the periodic vesting account of the sender. Only the
+accounts without funder, such as the ones created in the genesis, can assign one, and the funder can't be
+changed once assigned.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			funder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAssignVestingFunder(clientCtx.GetFromAddress(), funder)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -0,0 +1,127 @@
+package vesting
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// maxProjectionPoints is the maximum number of points of a projection with an
+// interval.
+const maxProjectionPoints = 1000
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the given AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+func (q queryServer) Projection(c context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	if req.Interval < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid interval of %d, interval must not be negative", req.Interval)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	acc := q.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+	va, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	now := ctx.BlockTime().Unix()
+	var times []int64
+	if req.Interval > 0 {
+		end := va.GetEndTime()
+		if end < now {
+			end = now
+		}
+		if (end-now)/req.Interval+2 > maxProjectionPoints {
+			return nil, status.Errorf(codes.InvalidArgument, "interval of %d is too short, the projection can't have more than %d points", req.Interval, maxProjectionPoints)
+		}
+
+		for t := now; t < end; t += req.Interval {
+			times = append(times, t)
+		}
+		times = append(times, end)
+	} else {
+		times = append([]int64{now}, scheduleTimes(va)...)
+	}
+
+	var points []types.ProjectionPoint
+	for _, t := range times {
+		if len(points) > 0 && t <= points[len(points)-1].Time {
+			continue
+		}
+
+		blockTime := time.Unix(t, 0)
+		point := types.ProjectionPoint{
+			Time:   t,
+			Vested: sdk.NewCoins(va.GetVestedCoins(blockTime)...),
+			Locked: sdk.NewCoins(va.GetVestingCoins(blockTime)...),
+		}
+		if cva, ok := va.(*types.ClawbackVestingAccount); ok {
+			point.Vested = sdk.NewCoins(cva.GetVestedOnly(blockTime)...)
+		}
+
+		points = append(points, point)
+	}
+
+	return &types.QueryProjectionResponse{Points: points}, nil
+}
+
+// scheduleTimes returns the times at which the schedule of a vesting account
+// changes, in ascending order.
+func scheduleTimes(va exported.VestingAccount) []int64 {
+	times := []int64{va.GetEndTime()}
+	switch va := va.(type) {
+	case *types.ContinuousVestingAccount:
+		times = append(times, va.GetStartTime())
+
+	case *types.PeriodicVestingAccount:
+		times = append(times, periodEnds(va.StartTime, va.VestingPeriods)...)
+
+	case *types.ClawbackVestingAccount:
+		times = append(times, periodEnds(va.StartTime, va.LockupPeriods)...)
+		times = append(times, periodEnds(va.StartTime, va.VestingPeriods)...)
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	return times
+}
+
+// periodEnds returns the times at which the given periods, starting at start,
+// end, as well as the start itself.
+func periodEnds(start int64, periods []types.Period) []int64 {
+	ends := []int64{start}
+	for _, period := range periods {
+		start += period.Length
+		ends = append(ends, start)
+	}
+
+	return ends
+}
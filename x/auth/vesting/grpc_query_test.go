@@ -0,0 +1,81 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func TestProjection(t *testing.T) {
+	start := time.Unix(1000, 0)
+	f := setupFixture(t, start)
+	ctx, msgServer, queryServer := f.ctx, f.msgServer, f.queryServer
+
+	addrs := simtestutil.CreateIncrementalAccounts(5)
+	funder, periodic, clawback, continuous, unknown := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+	require.NoError(t, banktestutil.FundAccount(f.bankKeeper, ctx, funder, stake(2000)))
+
+	_, err := msgServer.CreatePeriodicVestingAccount(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, periodic, start.Unix(),
+		[]types.Period{{Length: 100, Amount: stake(50)}, {Length: 100, Amount: stake(50)}}))
+	require.NoError(t, err)
+	_, err = msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, clawback, start.Unix(),
+		[]types.Period{{Length: 200, Amount: stake(100)}}, []types.Period{{Length: 100, Amount: stake(100)}}))
+	require.NoError(t, err)
+	_, err = msgServer.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(funder, continuous, stake(100), start.Unix()+1000000, false))
+	require.NoError(t, err)
+
+	point := func(t int64, vested, locked int64) types.ProjectionPoint {
+		return types.ProjectionPoint{Time: t, Vested: stake(vested), Locked: stake(locked)}
+	}
+
+	// the points are the times the schedule changes
+	res, err := queryServer.Projection(ctx, &types.QueryProjectionRequest{Address: periodic.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectionPoint{point(1000, 0, 100), point(1100, 50, 50), point(1200, 100, 0)}, res.Points)
+
+	// coins vested but still locked remain locked
+	res, err = queryServer.Projection(ctx, &types.QueryProjectionRequest{Address: clawback.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectionPoint{point(1000, 0, 100), point(1100, 100, 100), point(1200, 100, 0)}, res.Points)
+
+	// the points are the given interval apart, from the current block time
+	res, err = queryServer.Projection(ctx.WithBlockTime(start.Add(50*time.Second)), &types.QueryProjectionRequest{Address: periodic.String(), Interval: 60})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectionPoint{point(1050, 0, 100), point(1110, 50, 50), point(1170, 50, 50), point(1200, 100, 0)}, res.Points)
+
+	// an account fully vested has a single point
+	res, err = queryServer.Projection(ctx.WithBlockTime(start.Add(time.Hour)), &types.QueryProjectionRequest{Address: periodic.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectionPoint{point(4600, 100, 0)}, res.Points)
+
+	testCases := map[string]struct {
+		req  *types.QueryProjectionRequest
+		code codes.Code
+	}{
+		"empty request":         {nil, codes.InvalidArgument},
+		"invalid address":       {&types.QueryProjectionRequest{Address: "invalid"}, codes.InvalidArgument},
+		"negative interval":     {&types.QueryProjectionRequest{Address: periodic.String(), Interval: -1}, codes.InvalidArgument},
+		"too many points":       {&types.QueryProjectionRequest{Address: continuous.String(), Interval: 60}, codes.InvalidArgument},
+		"not a vesting account": {&types.QueryProjectionRequest{Address: funder.String()}, codes.InvalidArgument},
+		"unknown account":       {&types.QueryProjectionRequest{Address: unknown.String()}, codes.NotFound},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := queryServer.Projection(ctx, tc.req)
+			require.Equal(t, tc.code, status.Code(err), err)
+		})
+	}
+}
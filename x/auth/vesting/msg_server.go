@@ -2,6 +2,8 @@ package vesting
 
 import (
 	"context"
+	"errors"
+	"math"
 
 	"github.com/armon/go-metrics"
 
@@ -11,17 +13,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -183,6 +187,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	baseAccount := authtypes.NewBaseAccountWithAddress(to)
 	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
 	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	vestingAccount.FunderAddress = msg.FromAddress
 
 	ak.SetAccount(ctx, vestingAccount)
 
@@ -213,3 +218,324 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	)
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	// an empty schedule unlocks or vests all the coins at the start time
+	lockupPeriods, vestingPeriods := types.Periods(msg.LockupPeriods), types.Periods(msg.VestingPeriods)
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = types.Periods{{Length: 0, Amount: vestingPeriods.TotalAmount()}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = types.Periods{{Length: 0, Amount: lockupPeriods.TotalAmount()}}
+	}
+
+	totalCoins := vestingPeriods.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, msg.StartTime, lockupPeriods, vestingPeriods)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if !va.GetFunder().Equals(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	amount, err := s.clawback(ctx, va, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Amount: amount}, nil
+}
+
+func (s msgServer) AddVestingGrant(goCtx context.Context, msg *types.MsgAddVestingGrant) (*types.MsgAddVestingGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	acc := ak.GetAccount(ctx, to)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.ToAddress)
+	}
+	pva, ok := acc.(*types.PeriodicVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a periodic vesting account", msg.ToAddress)
+	}
+
+	// only the funder can add grants, so that others can't lock coins in the
+	// account or lengthen its schedule with dust grants
+	if pva.FunderAddress != from.String() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FromAddress, msg.ToAddress)
+	}
+
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	bondDenom := sk.BondDenom(ctx)
+	delegated := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, to).Add(sk.GetDelegatorUnbonding(ctx, to))))
+	pva.AddGrant(ctx.BlockTime(), msg.StartTime, msg.VestingPeriods, delegated)
+
+	// the account is written before the coins are sent, as the message fails
+	// and none of its state changes are committed if they can't be sent
+	ak.SetAccount(ctx, pva)
+
+	defer func() {
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "add_vesting_grant"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgAddVestingGrantResponse{}, nil
+}
+
+func (s msgServer) AssignVestingFunder(goCtx context.Context, msg *types.MsgAssignVestingFunder) (*types.MsgAssignVestingFunderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return nil, err
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	pva, ok := acc.(*types.PeriodicVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a periodic vesting account", msg.Address)
+	}
+
+	// the funder is only assigned once, so that the account can't revoke it
+	if pva.FunderAddress != "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already has funder %s", msg.Address, pva.FunderAddress)
+	}
+
+	pva.FunderAddress = msg.FunderAddress
+	ak.SetAccount(ctx, pva)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgAssignVestingFunderResponse{}, nil
+}
+
+// clawback claws back the coins of a clawback vesting account which are not
+// vested yet to dest, and returns them. The coins are taken from the balance of
+// the account first, then from its unbonding delegations, whose entries are
+// transferred to dest, and finally from its delegations, which are undelegated
+// to dest. Fewer coins are clawed back if some were slashed.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+	delegated := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr).Add(sk.GetDelegatorUnbonding(ctx, addr))))
+	toClawBack = va.UpdateDelegation(va.GetVestingCoins(ctx.BlockTime()), toClawBack, delegated, bk.GetAllBalances(ctx, addr))
+
+	// the account is written first so that the coins clawed back are no longer
+	// locked
+	ak.SetAccount(ctx, va)
+
+	clawedBack := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	want := toClawBack.AmountOf(bondDenom).Sub(clawedBack.AmountOf(bondDenom))
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, transferred))
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		tokens := sdk.MinInt(want, validator.TokensFromShares(delegation.Shares).TruncateInt())
+		if !tokens.IsPositive() {
+			continue
+		}
+		shares, err := validator.SharesFromTokens(tokens)
+		if err != nil {
+			return nil, err
+		}
+		if shares.GT(delegation.Shares) {
+			shares = delegation.Shares
+		}
+
+		_, undelegated, err := sk.UndelegateTo(ctx, addr, delegation.GetValidatorAddr(), shares, dest)
+		if errors.Is(err, stakingtypes.ErrMaxUnbondingDelegationEntries) {
+			// dest can't unbond more tokens from the validator for now
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, undelegated))
+		want = want.Sub(undelegated)
+	}
+
+	return clawedBack, nil
+}
@@ -0,0 +1,281 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	_ "github.com/cosmos/cosmos-sdk/x/params"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type fixture struct {
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
+	msgServer     types.MsgServer
+	queryServer   types.QueryServer
+}
+
+// setupFixture returns an app with the vesting module whose block time is
+// blockTime.
+func setupFixture(t *testing.T, blockTime time.Time) fixture {
+	var f fixture
+	app, err := simtestutil.Setup(
+		configurator.NewAppConfig(
+			configurator.AuthModule(),
+			configurator.BankModule(),
+			configurator.StakingModule(),
+			configurator.TxModule(),
+			configurator.ParamsModule(),
+			configurator.VestingModule()),
+		&f.accountKeeper, &f.bankKeeper, &f.stakingKeeper)
+	require.NoError(t, err)
+
+	f.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	f.msgServer = vesting.NewMsgServerImpl(f.accountKeeper, f.bankKeeper, f.stakingKeeper)
+	f.queryServer = vesting.NewQueryServerImpl(f.accountKeeper)
+	return f
+}
+
+func TestClawback(t *testing.T) {
+	start := time.Unix(1000, 0)
+	f := setupFixture(t, start)
+	ctx, accountKeeper, bankKeeper, stakingKeeper, msgServer := f.ctx, f.accountKeeper, f.bankKeeper, f.stakingKeeper, f.msgServer
+
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	funder, addr, dest, other := addrs[0], addrs[1], addrs[2], addrs[3]
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, funder, stake(2000)))
+
+	// the coins are unlocked at once and vest in two periods
+	_, err := msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, start.Unix(),
+		nil, []types.Period{{Length: 100, Amount: stake(400)}, {Length: 100, Amount: stake(600)}}))
+	require.NoError(t, err)
+	_, err = msgServer.CreatePermanentLockedAccount(ctx, types.NewMsgCreatePermanentLockedAccount(funder, other, stake(100)))
+	require.NoError(t, err)
+
+	// 800 coins are delegated once the first period vested
+	ctx = ctx.WithBlockTime(start.Add(150 * time.Second))
+	validator := stakingKeeper.GetAllValidators(ctx)[0]
+	_, err = stakingKeeper.Delegate(ctx, addr, sdk.NewInt(800), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	_, err = msgServer.Clawback(ctx, types.NewMsgClawback(dest, addr, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Clawback(ctx, types.NewMsgClawback(funder, other, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.Clawback(ctx, types.NewMsgClawback(funder, dest, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// the unvested coins are taken from the balance, then from the delegation
+	res, err := msgServer.Clawback(ctx, types.NewMsgClawback(funder, addr, dest))
+	require.NoError(t, err)
+	require.Equal(t, stake(600), res.Amount)
+	require.Equal(t, stake(200), bankKeeper.GetAllBalances(ctx, dest))
+	require.True(t, bankKeeper.GetAllBalances(ctx, addr).IsZero())
+	require.Equal(t, sdk.NewInt(400), stakingKeeper.GetDelegatorBonded(ctx, addr))
+	require.Equal(t, sdk.NewInt(400), stakingKeeper.GetDelegatorUnbonding(ctx, dest))
+
+	va := accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.Equal(t, stake(400), va.GetOriginalVesting())
+	require.True(t, va.GetVestingCoins(ctx.BlockTime()).IsZero())
+	require.Equal(t, stake(800), va.GetDelegatedFree())
+	require.True(t, va.GetDelegatedVesting().IsZero())
+	require.NoError(t, va.Validate())
+
+	// nothing is left to claw back
+	res, err = msgServer.Clawback(ctx, types.NewMsgClawback(funder, addr, dest))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	// the undelegation is tracked on the account once the unbonding completes
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(stakingKeeper.UnbondingTime(ctx)))
+	_, err = stakingKeeper.CompleteUnbonding(ctx, dest, validator.GetOperator())
+	require.NoError(t, err)
+	require.Equal(t, stake(600), bankKeeper.GetAllBalances(ctx, dest))
+	va = accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.Equal(t, stake(400), va.GetDelegatedFree())
+	require.True(t, va.GetDelegatedVesting().IsZero())
+}
+
+func TestClawbackToVestingAccount(t *testing.T) {
+	start := time.Unix(1000, 0)
+	f := setupFixture(t, start)
+	ctx, accountKeeper, bankKeeper, stakingKeeper, msgServer := f.ctx, f.accountKeeper, f.bankKeeper, f.stakingKeeper, f.msgServer
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	funder, addr, dest := addrs[0], addrs[1], addrs[2]
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, funder, stake(2000)))
+
+	_, err := msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, start.Unix(),
+		nil, []types.Period{{Length: 100, Amount: stake(400)}, {Length: 100, Amount: stake(600)}}))
+	require.NoError(t, err)
+	_, err = msgServer.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(funder, dest, stake(500), start.Unix()+100_000_000, true))
+	require.NoError(t, err)
+
+	// the recipient delegates 300 of its vesting coins, and the account
+	// delegates 800 coins and unbonds 100 of them once the first period vested
+	ctx = ctx.WithBlockTime(start.Add(150 * time.Second))
+	validator := stakingKeeper.GetAllValidators(ctx)[0]
+	_, err = stakingKeeper.Delegate(ctx, dest, sdk.NewInt(300), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator = stakingKeeper.GetAllValidators(ctx)[0]
+	shares, err := stakingKeeper.Delegate(ctx, addr, sdk.NewInt(800), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	_, err = stakingKeeper.Undelegate(ctx, addr, validator.GetOperator(), shares.QuoInt64(8))
+	require.NoError(t, err)
+
+	// 200 coins are clawed back from the balance, 100 from the unbonding
+	// delegation and 300 from the delegation
+	res, err := msgServer.Clawback(ctx, types.NewMsgClawback(funder, addr, dest))
+	require.NoError(t, err)
+	require.Equal(t, stake(600), res.Amount)
+	require.Equal(t, sdk.NewInt(400), stakingKeeper.GetDelegatorUnbonding(ctx, dest))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(stakingKeeper.UnbondingTime(ctx)))
+	_, err = stakingKeeper.CompleteUnbonding(ctx, dest, validator.GetOperator())
+	require.NoError(t, err)
+
+	// the undelegation is tracked on the account, not on the recipient
+	va := accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.Equal(t, stake(400), va.GetDelegatedFree())
+	require.True(t, va.GetDelegatedVesting().IsZero())
+	require.Equal(t, sdk.NewInt(400), stakingKeeper.GetDelegatorBonded(ctx, addr))
+
+	dva := accountKeeper.GetAccount(ctx, dest).(*types.DelayedVestingAccount)
+	require.Equal(t, stake(300), dva.GetDelegatedVesting())
+	require.True(t, dva.GetDelegatedFree().IsZero())
+	require.Equal(t, stake(800), bankKeeper.GetAllBalances(ctx, dest))
+	require.Equal(t, stake(600), bankKeeper.SpendableCoins(ctx, dest))
+}
+
+func TestAddVestingGrant(t *testing.T) {
+	start := time.Unix(1000, 0)
+	f := setupFixture(t, start)
+	ctx, accountKeeper, bankKeeper, stakingKeeper, msgServer := f.ctx, f.accountKeeper, f.bankKeeper, f.stakingKeeper, f.msgServer
+
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	funder, addr, other := addrs[0], addrs[1], addrs[2]
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, funder, stake(2000)))
+
+	periods := []types.Period{{Length: 100, Amount: stake(50)}, {Length: 100, Amount: stake(50)}}
+	_, err := msgServer.CreatePeriodicVestingAccount(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, start.Unix(), periods))
+	require.NoError(t, err)
+	_, err = msgServer.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(funder, other, stake(100), start.Unix()+100, true))
+	require.NoError(t, err)
+
+	// 80 coins are delegated once the first period vested
+	ctx = ctx.WithBlockTime(start.Add(120 * time.Second))
+	validator := stakingKeeper.GetAllValidators(ctx)[0]
+	_, err = stakingKeeper.Delegate(ctx, addr, sdk.NewInt(80), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	pva := accountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	require.Equal(t, funder.String(), pva.FunderAddress)
+	require.Equal(t, stake(50), pva.GetDelegatedVesting())
+	require.Equal(t, stake(30), pva.GetDelegatedFree())
+	require.Equal(t, stake(20), bankKeeper.SpendableCoins(ctx, addr))
+
+	grant := []types.Period{{Length: 100, Amount: stake(40)}}
+	_, err = msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(funder, other, ctx.BlockTime().Unix(), grant))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(funder, addrs[0], ctx.BlockTime().Unix(), grant))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// only the funder of the account can add grants
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addrs[3], stake(100)))
+	_, err = msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(addrs[3], addr, ctx.BlockTime().Unix(), grant))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(funder, addr, ctx.BlockTime().Unix(), grant))
+	require.NoError(t, err)
+	require.Equal(t, stake(60), bankKeeper.GetAllBalances(ctx, addr))
+
+	pva = accountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	require.NoError(t, pva.Validate())
+	require.Equal(t, stake(140), pva.GetOriginalVesting())
+	require.Equal(t, types.Periods{
+		{Length: 100, Amount: stake(50)},
+		{Length: 100, Amount: stake(50)},
+		{Length: 20, Amount: stake(40)},
+	}, pva.GetVestingPeriods())
+	require.Equal(t, stake(50), pva.GetDelegatedVesting())
+	require.Equal(t, stake(30), pva.GetDelegatedFree())
+
+	// the coins of the grant are locked, even though vested coins are
+	// delegated, and the vested ones remain spendable
+	require.Equal(t, stake(20), bankKeeper.SpendableCoins(ctx, addr))
+	ctx = ctx.WithBlockTime(start.Add(220 * time.Second))
+	require.Equal(t, stake(60), bankKeeper.SpendableCoins(ctx, addr))
+}
+
+func TestAssignVestingFunder(t *testing.T) {
+	start := time.Unix(1000, 0)
+	f := setupFixture(t, start)
+	ctx, accountKeeper, bankKeeper, stakingKeeper, msgServer := f.ctx, f.accountKeeper, f.bankKeeper, f.stakingKeeper, f.msgServer
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	funder, addr, other := addrs[0], addrs[1], addrs[2]
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	}
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, funder, stake(2000)))
+
+	// the account is created without funder, as in the genesis
+	periods := []types.Period{{Length: 100, Amount: stake(50)}, {Length: 100, Amount: stake(50)}}
+	baseAccount := accountKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	accountKeeper.SetAccount(ctx, types.NewPeriodicVestingAccount(baseAccount, stake(100), start.Unix(), periods))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addr, stake(100)))
+
+	// no grant can be added until a funder is assigned
+	grant := []types.Period{{Length: 100, Amount: stake(40)}}
+	_, err := msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(funder, addr, start.Unix(), grant))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.AssignVestingFunder(ctx, types.NewMsgAssignVestingFunder(funder, other))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.AssignVestingFunder(ctx, types.NewMsgAssignVestingFunder(addr, funder))
+	require.NoError(t, err)
+	require.Equal(t, funder.String(), accountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount).FunderAddress)
+
+	// the funder can't be changed once assigned
+	_, err = msgServer.AssignVestingFunder(ctx, types.NewMsgAssignVestingFunder(addr, other))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.AddVestingGrant(ctx, types.NewMsgAddVestingGrant(funder, addr, start.Unix(), grant))
+	require.NoError(t, err)
+	pva := accountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	require.NoError(t, pva.Validate())
+	require.Equal(t, stake(140), pva.GetOriginalVesting())
+	require.Equal(t, stake(140), bankKeeper.GetAllBalances(ctx, addr))
+}
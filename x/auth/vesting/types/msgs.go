@@ -16,12 +16,32 @@ const TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account
 // TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreateVestingAccount.
 const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
+// TypeMsgAddVestingGrant defines the type value for a MsgAddVestingGrant.
+const TypeMsgAddVestingGrant = "msg_add_vesting_grant"
+
+// TypeMsgAssignVestingFunder defines the type value for a MsgAssignVestingFunder.
+const TypeMsgAssignVestingFunder = "msg_assign_vesting_funder"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
+var _ sdk.Msg = &MsgAddVestingGrant{}
+
+var _ sdk.Msg = &MsgAssignVestingFunder{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //
 //nolint:interfacer
@@ -189,3 +209,226 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if err := validatePeriods(msg.LockupPeriods); err != nil {
+		return sdkerrors.Wrap(err, "invalid lockup periods")
+	}
+	if err := validatePeriods(msg.VestingPeriods); err != nil {
+		return sdkerrors.Wrap(err, "invalid vesting periods")
+	}
+
+	lockupCoins := Periods(msg.LockupPeriods).TotalAmount()
+	vestingCoins := Periods(msg.VestingPeriods).TotalAmount()
+	switch {
+	case len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lockup and vesting periods cannot both be empty")
+	case len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !lockupCoins.IsEqual(vestingCoins):
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "lockup coins %s and vesting coins %s differ", lockupCoins, vestingCoins)
+	}
+
+	return nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The coins are clawed
+// back to the funder if dest is empty.
+//
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destAddr string
+	if dest != nil {
+		destAddr = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destAddr,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgAddVestingGrant returns a reference to a new MsgAddVestingGrant.
+//
+//nolint:interfacer
+func NewMsgAddVestingGrant(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgAddVestingGrant {
+	return &MsgAddVestingGrant{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgAddVestingGrant.
+func (msg MsgAddVestingGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAddVestingGrant.
+func (msg MsgAddVestingGrant) Type() string { return TypeMsgAddVestingGrant }
+
+// GetSigners returns the expected signers for a MsgAddVestingGrant.
+func (msg MsgAddVestingGrant) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAddVestingGrant.
+func (msg MsgAddVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAddVestingGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting periods cannot be empty")
+	}
+	if err := validatePeriods(msg.VestingPeriods); err != nil {
+		return sdkerrors.Wrap(err, "invalid vesting periods")
+	}
+
+	return nil
+}
+
+// NewMsgAssignVestingFunder returns a reference to a new MsgAssignVestingFunder.
+//
+//nolint:interfacer
+func NewMsgAssignVestingFunder(addr, funder sdk.AccAddress) *MsgAssignVestingFunder {
+	return &MsgAssignVestingFunder{
+		Address:       addr.String(),
+		FunderAddress: funder.String(),
+	}
+}
+
+// Route returns the message route for a MsgAssignVestingFunder.
+func (msg MsgAssignVestingFunder) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAssignVestingFunder.
+func (msg MsgAssignVestingFunder) Type() string { return TypeMsgAssignVestingFunder }
+
+// GetSigners returns the expected signers for a MsgAssignVestingFunder.
+func (msg MsgAssignVestingFunder) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAssignVestingFunder.
+func (msg MsgAssignVestingFunder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAssignVestingFunder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	return nil
+}
+
+// validatePeriods checks that the periods of a schedule have a non-negative
+// length and a positive amount.
+func validatePeriods(periods []Period) error {
+	for i, period := range periods {
+		if period.Length < 0 {
+			return fmt.Errorf("invalid period length of %d in period %d, length must not be negative", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in period %d", period.Amount, i)
+		}
+	}
+
+	return nil
+}
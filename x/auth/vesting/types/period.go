@@ -58,3 +58,43 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// DisjunctPeriods returns the union of the schedules p and q, starting at
+// startP and startQ respectively: a schedule releasing the amounts of the
+// periods of both at the same times, along with its start and end times. The
+// amounts of periods ending at the same time are released by a single period.
+func DisjunctPeriods(startP, startQ int64, p, q []Period) (int64, int64, []Period) {
+	start := startP
+	if startQ < start {
+		start = startQ
+	}
+
+	var periods []Period
+	last := start
+	// release releases amount at time t, in the last period if it ends at t
+	release := func(t int64, amount sdk.Coins) {
+		if len(periods) > 0 && t == last {
+			periods[len(periods)-1].Amount = periods[len(periods)-1].Amount.Add(amount...)
+			return
+		}
+
+		periods = append(periods, Period{Length: t - last, Amount: amount})
+		last = t
+	}
+
+	i, j := 0, 0
+	endP, endQ := startP, startQ
+	for i < len(p) || j < len(q) {
+		if j == len(q) || (i < len(p) && endP+p[i].Length <= endQ+q[j].Length) {
+			endP += p[i].Length
+			release(endP, p[i].Amount)
+			i++
+		} else {
+			endQ += q[j].Length
+			release(endQ, q[j].Amount)
+			j++
+		}
+	}
+
+	return start, last, periods
+}
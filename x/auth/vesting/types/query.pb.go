@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method.
+type QueryProjectionRequest struct {
+	// address is the address of the vesting account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// interval is the number of seconds between the points of the projection,
+	// which starts at the current block time and ends at the end time of the
+	// account, and can't have more than 1000 points. If zero, the points are the
+	// current block time and the later times at which the schedule of the account
+	// changes, between which continuous vesting accounts vest linearly.
+	Interval int64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{0}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryProjectionRequest) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// ProjectionPoint defines the coins vested and locked by the schedule of a
+// vesting account at a point in time.
+type ProjectionPoint struct {
+	// time is the UNIX time of the point.
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// vested are the coins vested at the time. For clawback vesting accounts,
+	// these are the coins which can no longer be clawed back, whether unlocked or
+	// not.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// locked are the coins still locked by the schedule at the time, regardless
+	// of the coins delegated by the account.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+}
+
+func (m *ProjectionPoint) Reset()         { *m = ProjectionPoint{} }
+func (m *ProjectionPoint) String() string { return proto.CompactTextString(m) }
+func (*ProjectionPoint) ProtoMessage()    {}
+func (*ProjectionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{1}
+}
+func (m *ProjectionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectionPoint.Merge(m, src)
+}
+func (m *ProjectionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectionPoint proto.InternalMessageInfo
+
+func (m *ProjectionPoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *ProjectionPoint) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *ProjectionPoint) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method.
+type QueryProjectionResponse struct {
+	// points are the points of the projection, in ascending order of time.
+	Points []ProjectionPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{2}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetPoints() []ProjectionPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryProjectionRequest)(nil), "cosmos.vesting.v1beta1.QueryProjectionRequest")
+	proto.RegisterType((*ProjectionPoint)(nil), "cosmos.vesting.v1beta1.ProjectionPoint")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "cosmos.vesting.v1beta1.QueryProjectionResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/query.proto", fileDescriptor_94f6d251f3006c48)
+}
+
+var fileDescriptor_94f6d251f3006c48 = []byte{
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x6e, 0xd4, 0x30,
+	0x18, 0xc7, 0xcf, 0x77, 0xe5, 0x00, 0x33, 0x20, 0x59, 0x55, 0x49, 0x4f, 0x28, 0x3d, 0x65, 0x21,
+	0x03, 0xb5, 0xb9, 0x83, 0x17, 0x20, 0x88, 0x89, 0xa5, 0x84, 0x8d, 0x05, 0x9c, 0xc4, 0xca, 0x99,
+	0xde, 0xf9, 0x4b, 0x63, 0xe7, 0x44, 0x85, 0x58, 0x78, 0x02, 0x24, 0x1e, 0x81, 0x0d, 0x89, 0x8d,
+	0x87, 0xe8, 0x58, 0xc1, 0xc2, 0x04, 0xe8, 0x8e, 0x9d, 0x57, 0x40, 0x76, 0xdc, 0x54, 0x82, 0x16,
+	0xb1, 0x74, 0x8a, 0xad, 0xef, 0xff, 0xfd, 0x7f, 0xfe, 0xfe, 0xf9, 0x70, 0x94, 0x83, 0x5e, 0x80,
+	0x66, 0x4b, 0xa1, 0x8d, 0x54, 0x25, 0x5b, 0x4e, 0x32, 0x61, 0xf8, 0x84, 0x1d, 0x34, 0xa2, 0x3e,
+	0xa4, 0x55, 0x0d, 0x06, 0xc8, 0x56, 0xab, 0xa1, 0x5e, 0x43, 0xbd, 0x66, 0xb4, 0x59, 0x42, 0x09,
+	0x4e, 0xc2, 0xec, 0xa9, 0x55, 0x8f, 0x6e, 0x96, 0x00, 0xe5, 0x5c, 0x30, 0x5e, 0x49, 0xc6, 0x95,
+	0x02, 0xc3, 0x8d, 0x04, 0xa5, 0x7d, 0x35, 0xf4, 0xbc, 0x8c, 0x6b, 0xd1, 0xc1, 0x72, 0x90, 0xca,
+	0xd7, 0xb7, 0xdb, 0xfa, 0xb3, 0xd6, 0xd6, 0x83, 0xdd, 0x25, 0x9a, 0xe1, 0xad, 0xc7, 0xf6, 0x55,
+	0x7b, 0x35, 0xbc, 0x10, 0xb9, 0x35, 0x4d, 0xc5, 0x41, 0x23, 0xb4, 0x21, 0x53, 0x7c, 0x99, 0x17,
+	0x45, 0x2d, 0xb4, 0x0e, 0xd0, 0x18, 0xc5, 0x57, 0x93, 0xe0, 0xf3, 0xa7, 0xdd, 0x4d, 0xdf, 0x7c,
+	0xbf, 0xad, 0x3c, 0x31, 0xb5, 0x54, 0x65, 0x7a, 0x22, 0x24, 0x23, 0x7c, 0x45, 0x2a, 0x23, 0xea,
+	0x25, 0x9f, 0x07, 0xfd, 0x31, 0x8a, 0x07, 0x69, 0x77, 0x8f, 0x7e, 0x21, 0x7c, 0xfd, 0x94, 0xb2,
+	0x07, 0x52, 0x19, 0x42, 0xf0, 0x86, 0x91, 0x0b, 0xe1, 0x00, 0x83, 0xd4, 0x9d, 0x49, 0x8e, 0x87,
+	0x36, 0x13, 0x51, 0x04, 0xfd, 0xf1, 0x20, 0xbe, 0x36, 0xdd, 0xa6, 0x9e, 0x69, 0xa7, 0x3b, 0x89,
+	0x89, 0x3e, 0x00, 0xa9, 0x92, 0x3b, 0x47, 0xdf, 0x76, 0x7a, 0x1f, 0xbe, 0xef, 0xc4, 0xa5, 0x34,
+	0xb3, 0x26, 0xa3, 0x39, 0x2c, 0xfc, 0x74, 0xfe, 0xb3, 0xab, 0x8b, 0x7d, 0x66, 0x0e, 0x2b, 0xa1,
+	0x5d, 0x83, 0x4e, 0xbd, 0xb5, 0x85, 0xcc, 0x21, 0xdf, 0x17, 0x45, 0x30, 0xb8, 0x00, 0x48, 0x6b,
+	0x1d, 0x3d, 0xc7, 0x37, 0xfe, 0xca, 0x56, 0x57, 0xa0, 0xb4, 0x20, 0x0f, 0xf1, 0xb0, 0xb2, 0x09,
+	0xd8, 0x6c, 0x2d, 0xff, 0x16, 0x3d, 0x7b, 0x1d, 0xe8, 0x1f, 0x89, 0x25, 0x1b, 0xf6, 0x35, 0xa9,
+	0x6f, 0x9e, 0x7e, 0x44, 0xf8, 0x92, 0x43, 0x90, 0xf7, 0x08, 0xe3, 0x53, 0x2d, 0xa1, 0xe7, 0xf9,
+	0x9d, 0xfd, 0xb3, 0x47, 0xec, 0xbf, 0xf5, 0xed, 0x00, 0xd1, 0xbd, 0x37, 0x5f, 0x7e, 0xbe, 0xeb,
+	0x53, 0x72, 0x9b, 0x9d, 0xb3, 0xeb, 0x55, 0xd7, 0xc3, 0x5e, 0xf9, 0xf5, 0x78, 0x9d, 0x3c, 0x3a,
+	0x5a, 0x85, 0xe8, 0x78, 0x15, 0xa2, 0x1f, 0xab, 0x10, 0xbd, 0x5d, 0x87, 0xbd, 0xe3, 0x75, 0xd8,
+	0xfb, 0xba, 0x0e, 0x7b, 0x4f, 0x27, 0xff, 0x4c, 0xf7, 0x25, 0xe3, 0x8d, 0x99, 0x75, 0x0c, 0x17,
+	0x76, 0x36, 0x74, 0x1b, 0x7c, 0xf7, 0xf7, 0x00, 0xbb, 0x28, 0x49, 0x80, 0x6e, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Projection returns the coins vested and locked by the schedule of a
+	// vesting account over time.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Projection returns the coins vested and locked by the schedule of a
+	// vesting account over time.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/query.proto",
+}
+
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	return n
+}
+
+func (m *ProjectionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, ProjectionPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
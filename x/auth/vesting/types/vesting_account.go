@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -19,6 +20,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -165,6 +167,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -406,6 +410,28 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a grant of coins vesting by the given periods, starting at
+// grantStartTime, into the vesting schedule of the account. The delegated coins
+// are considered vesting first among the coins vesting before the grant, the
+// coins lost to slashing being considered still delegated while they are
+// vesting, so that delegated free coins are never reclassified as vesting,
+// which would unlock as many coins of the grant.
+//
+// CONTRACT: delegated are the coins currently delegated by the account, either
+// bonded or unbonding.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, grantStartTime int64, grantPeriods []Period, delegated sdk.Coins) {
+	vesting := pva.GetVestingCoins(blockTime)
+	oldDelegated := pva.DelegatedVesting.Add(pva.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	newDelegated := delegated.Add(slashed.Min(vesting)...)
+
+	pva.StartTime, pva.EndTime, pva.VestingPeriods = DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantPeriods)
+	pva.OriginalVesting = pva.OriginalVesting.Add(Periods(grantPeriods).TotalAmount()...)
+
+	pva.DelegatedVesting = sdk.NewCoins(newDelegated.Min(vesting)...)
+	pva.DelegatedFree = sdk.NewCoins(newDelegated.Sub(pva.DelegatedVesting...)...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -423,6 +449,11 @@ func (pva PeriodicVestingAccount) Validate() error {
 	if !originalVesting.IsEqual(pva.OriginalVesting) {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
+	if pva.FunderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(pva.FunderAddress); err != nil {
+			return fmt.Errorf("invalid funder address: %w", err)
+		}
+	}
 
 	return pva.BaseVestingAccount.Validate()
 }
@@ -450,6 +481,7 @@ func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
 		EndTime:          pva.EndTime,
 		StartTime:        pva.StartTime,
 		VestingPeriods:   pva.VestingPeriods,
+		FunderAddress:    pva.FunderAddress,
 	}
 	return marshalYaml(out)
 }
@@ -592,6 +624,225 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. The original
+// vesting coins are the coins of the vesting periods, which the lockup periods
+// must add up to as well.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  vestingPeriods.TotalAmount(),
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          startTime + maxInt64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule at the
+// given time, whether vested or not.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule at the given
+// time, whether unlocked or not.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// GetVestedCoins returns the total number of coins both unlocked and vested,
+// i.e. spendable. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vestedCoins := va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+	if vestedCoins.IsZero() {
+		return nil
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of coins either locked or vesting.
+// If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the funder of the account.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(va.FunderAddress)
+}
+
+// ComputeClawback truncates the vesting schedule of the account to the periods
+// vested at the given time, caps its lockup schedule to the coins vested, and
+// returns the coins of the periods removed, which are the coins to claw back.
+// The coins vested remain locked by the lockup schedule. The delegation
+// tracking of the account is left to UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	var vestingPeriods Periods
+	unvested := sdk.NewCoins()
+	periodEnd := va.StartTime
+	for _, period := range va.VestingPeriods {
+		periodEnd += period.Length
+		if periodEnd <= clawbackTime {
+			vestingPeriods = append(vestingPeriods, period)
+		} else {
+			unvested = unvested.Add(period.Amount...)
+		}
+	}
+
+	vested := vestingPeriods.TotalAmount()
+	var lockupPeriods Periods
+	remaining := vested
+	for _, period := range va.LockupPeriods {
+		if remaining.IsZero() {
+			break
+		}
+
+		amount := period.Amount.Min(remaining)
+		remaining = remaining.Sub(amount...)
+		lockupPeriods = append(lockupPeriods, Period{Length: period.Length, Amount: amount})
+	}
+
+	va.OriginalVesting = vested
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = vestingPeriods
+	va.EndTime = va.StartTime + maxInt64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength())
+
+	return unvested
+}
+
+// UpdateDelegation updates the delegation tracking of the account for the
+// clawback of the given coins, once its schedules are truncated by
+// ComputeClawback, and returns the coins that can be clawed back. The coins are
+// clawed back from the balance of the account first, then from its delegated
+// coins, either bonded or unbonding. The delegated coins clawed back remain
+// tracked until their unbonding completes, when their undelegation is tracked
+// on the account, and the coins lost to slashing are considered still delegated.
+//
+// CONTRACT: encumbered are the coins still vesting at the time of the clawback,
+// delegated are the coins currently delegated and balance is the balance of the
+// account.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, delegated, balance sdk.Coins) sdk.Coins {
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	total := delegated.Add(balance...)
+	toClawBack = toClawBack.Min(total)
+
+	newDelegated := delegated.Add(slashed...)
+	va.DelegatedVesting = sdk.NewCoins(encumbered.Min(newDelegated)...)
+	va.DelegatedFree = sdk.NewCoins(newDelegated.Sub(va.DelegatedVesting...)...)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if va.EndTime != va.StartTime+maxInt64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength()) {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// readSchedule returns the coins of the periods of a schedule starting at the
+// given start time which are over at the given time.
+func readSchedule(startTime int64, periods Periods, time int64) sdk.Coins {
+	coins := sdk.NewCoins()
+	periodEnd := startTime
+	for _, period := range periods {
+		periodEnd += period.Length
+		if periodEnd > time {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
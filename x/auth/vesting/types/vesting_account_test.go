@@ -570,6 +570,131 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestDisjunctPeriods(t *testing.T) {
+	coins := func(fee, stake int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, fee), sdk.NewInt64Coin(stakeDenom, stake))
+	}
+
+	testCases := map[string]struct {
+		startP, startQ int64
+		p, q           types.Periods
+		start, end     int64
+		periods        types.Periods
+	}{
+		"same start": {
+			startP: 100, p: types.Periods{{Length: 10, Amount: coins(1, 0)}, {Length: 10, Amount: coins(2, 0)}},
+			startQ: 100, q: types.Periods{{Length: 15, Amount: coins(0, 3)}},
+			start: 100, end: 120,
+			periods: types.Periods{{Length: 10, Amount: coins(1, 0)}, {Length: 5, Amount: coins(0, 3)}, {Length: 5, Amount: coins(2, 0)}},
+		},
+		"later start": {
+			startP: 100, p: types.Periods{{Length: 10, Amount: coins(1, 0)}},
+			startQ: 150, q: types.Periods{{Length: 0, Amount: coins(0, 3)}, {Length: 10, Amount: coins(0, 4)}},
+			start: 100, end: 160,
+			periods: types.Periods{{Length: 10, Amount: coins(1, 0)}, {Length: 40, Amount: coins(0, 3)}, {Length: 10, Amount: coins(0, 4)}},
+		},
+		"earlier start ending together": {
+			startP: 100, p: types.Periods{{Length: 10, Amount: coins(1, 0)}},
+			startQ: 50, q: types.Periods{{Length: 60, Amount: coins(2, 3)}},
+			start: 50, end: 110,
+			periods: types.Periods{{Length: 60, Amount: coins(3, 3)}},
+		},
+		"empty schedule": {
+			startP: 100, p: types.Periods{{Length: 10, Amount: coins(1, 0)}},
+			startQ: 200, q: nil,
+			start: 100, end: 110,
+			periods: types.Periods{{Length: 10, Amount: coins(1, 0)}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			start, end, periods := types.DisjunctPeriods(tc.startP, tc.startQ, tc.p, tc.q)
+			require.Equal(t, tc.start, start)
+			require.Equal(t, tc.end, end)
+			require.Equal(t, tc.periods, types.Periods(periods))
+
+			// the schedules are symmetric
+			start, end, periods = types.DisjunctPeriods(tc.startQ, tc.startP, tc.q, tc.p)
+			require.Equal(t, tc.start, start)
+			require.Equal(t, tc.end, end)
+			require.Equal(t, tc.periods, types.Periods(periods))
+		})
+	}
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, _ := initBaseAccount()
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, amount)) }
+
+	// 100 stake vesting by halves, 40 more added once the first half vested
+	periods := types.Periods{
+		types.Period{Length: 100, Amount: stake(50)},
+		types.Period{Length: 100, Amount: stake(50)},
+	}
+	grantTime := now.Add(120 * time.Second)
+	grantPeriods := []types.Period{{Length: 100, Amount: stake(40)}}
+
+	pva := types.NewPeriodicVestingAccount(bacc, stake(100), now.Unix(), periods)
+	pva.AddGrant(grantTime, grantTime.Unix(), grantPeriods, sdk.NewCoins())
+	require.NoError(t, pva.Validate())
+	require.Equal(t, stake(140), pva.OriginalVesting)
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(220*time.Second).Unix(), pva.EndTime)
+	require.Equal(t, types.Periods{
+		{Length: 100, Amount: stake(50)},
+		{Length: 100, Amount: stake(50)},
+		{Length: 20, Amount: stake(40)},
+	}, pva.GetVestingPeriods())
+	require.Equal(t, stake(90), pva.GetVestingCoins(grantTime))
+	require.Empty(t, pva.DelegatedVesting)
+	require.Empty(t, pva.DelegatedFree)
+
+	// the delegated coins are considered vesting first among the coins vesting
+	// before the grant, so that delegations never unlock coins of the grant
+	testCases := map[string]struct {
+		trackedAt                       time.Time
+		tracked, delegated              sdk.Coins
+		delegatedVesting, delegatedFree sdk.Coins
+	}{
+		"delegated vesting": {
+			trackedAt: now, tracked: stake(80), delegated: stake(80),
+			delegatedVesting: stake(50), delegatedFree: stake(30),
+		},
+		"delegated free": {
+			trackedAt: grantTime, tracked: stake(80), delegated: stake(80),
+			delegatedVesting: stake(50), delegatedFree: stake(30),
+		},
+		"delegated beyond vesting": {
+			trackedAt: grantTime, tracked: stake(100), delegated: stake(100),
+			delegatedVesting: stake(50), delegatedFree: stake(50),
+		},
+		"slashed": {
+			trackedAt: now, tracked: stake(80), delegated: stake(60),
+			delegatedVesting: stake(50), delegatedFree: stake(30),
+		},
+		"mostly slashed": {
+			trackedAt: grantTime, tracked: stake(80), delegated: stake(20),
+			delegatedVesting: stake(50), delegatedFree: stake(20),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pva := types.NewPeriodicVestingAccount(bacc, stake(100), now.Unix(), periods)
+			pva.TrackDelegation(tc.trackedAt, stake(100), tc.tracked)
+
+			pva.AddGrant(grantTime, grantTime.Unix(), grantPeriods, tc.delegated)
+			require.Equal(t, tc.delegatedVesting, pva.DelegatedVesting)
+			require.Equal(t, tc.delegatedFree, pva.DelegatedFree)
+
+			// the coins of the grant remain locked
+			require.Equal(t, stake(40), pva.LockedCoins(grantTime))
+		})
+	}
+}
+
 func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(1000 * 24 * time.Hour)
@@ -848,6 +973,161 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{types.Period{Length: 3600, Amount: coins}}
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, time.Now().Unix(), periods, periods)
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, origCoins := initBaseAccount()
+
+	// the coins vest by halves and all unlock after 18 hours
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: origCoins},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	va := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// require no coins vested before the first period
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require no coins vested while locked
+	require.Nil(t, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require the vested coins spendable once unlocked
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, va.GetVestingCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(24*time.Hour)))
+}
+
+func TestComputeClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, origCoins := initBaseAccount()
+
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: origCoins},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	// the coins of the periods not over are clawed back
+	va := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	clawback := va.ComputeClawback(now.Add(13 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	require.Equal(t, vestingPeriods[:1], types.Periods(va.VestingPeriods))
+	require.Equal(t, vestingPeriods[0].Amount, va.OriginalVesting)
+	require.NoError(t, va.Validate())
+
+	// the coins vested remain locked until unlocked
+	require.Equal(t, types.Periods{types.Period{Length: int64(18 * 60 * 60), Amount: vestingPeriods[0].Amount}}, types.Periods(va.LockupPeriods))
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.GetEndTime())
+	require.Equal(t, vestingPeriods[0].Amount, va.LockedCoins(now.Add(13*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(18*time.Hour)))
+
+	// nothing is clawed back once all the coins are vested
+	va = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.True(t, va.ComputeClawback(now.Add(24*time.Hour).Unix()).IsZero())
+	require.Equal(t, origCoins, va.OriginalVesting)
+
+	// all the coins are clawed back before the first period
+	va = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins, va.ComputeClawback(now.Unix()))
+	require.Empty(t, va.LockupPeriods)
+	require.Empty(t, va.VestingPeriods)
+	require.Equal(t, now.Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, _ := initBaseAccount()
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, amount)) }
+
+	// 100 stake vesting by halves
+	vestingPeriods := types.Periods{
+		types.Period{Length: 100, Amount: stake(50)},
+		types.Period{Length: 100, Amount: stake(50)},
+	}
+	newAccount := func() *types.ClawbackVestingAccount {
+		va := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), nil, vestingPeriods)
+		va.LockupPeriods = types.Periods{types.Period{Length: 0, Amount: stake(100)}}
+		return va
+	}
+
+	testCases := map[string]struct {
+		tracked            sdk.Coins
+		delegated, balance sdk.Coins
+		clawback           sdk.Coins
+		delegatedVesting   sdk.Coins
+		delegatedFree      sdk.Coins
+	}{
+		"balance only": {
+			tracked: sdk.NewCoins(), delegated: sdk.NewCoins(), balance: stake(100),
+			clawback: stake(50), delegatedVesting: sdk.NewCoins(), delegatedFree: sdk.NewCoins(),
+		},
+		"partially delegated": {
+			tracked: stake(80), delegated: stake(80), balance: stake(20),
+			clawback: stake(50), delegatedVesting: sdk.NewCoins(), delegatedFree: stake(80),
+		},
+		"slashed": {
+			tracked: stake(80), delegated: stake(60), balance: stake(20),
+			clawback: stake(50), delegatedVesting: sdk.NewCoins(), delegatedFree: stake(80),
+		},
+		"mostly slashed": {
+			tracked: stake(80), delegated: stake(10), balance: stake(20),
+			clawback: stake(30), delegatedVesting: sdk.NewCoins(), delegatedFree: stake(80),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			va := newAccount()
+			if !tc.tracked.IsZero() {
+				va.TrackDelegation(now, stake(100), tc.tracked)
+			}
+
+			toClawBack := va.ComputeClawback(now.Add(150 * time.Second).Unix())
+			clawback := va.UpdateDelegation(va.GetVestingCoins(now.Add(150*time.Second)), toClawBack, tc.delegated, tc.balance)
+			require.Equal(t, tc.clawback, clawback)
+			require.Equal(t, tc.delegatedVesting, va.DelegatedVesting)
+			require.Equal(t, tc.delegatedFree, va.DelegatedFree)
+		})
+	}
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
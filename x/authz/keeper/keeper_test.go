@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -346,6 +348,61 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+// grantReadingBankServer is a bank Msg server whose Send reads the grants of the
+// authz store, so that it is charged the gas configs of the MsgSend.
+type grantReadingBankServer struct {
+	*authztestutil.MockBankKeeper
+	authzKeeper *authzkeeper.Keeper
+}
+
+func (k grantReadingBankServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, err := k.authzKeeper.GetAuthorizations(ctx, sdk.MustAccAddressFromBech32(msg.ToAddress), sdk.MustAccAddressFromBech32(msg.FromAddress))
+	return &banktypes.MsgSendResponse{}, err
+}
+
+func (s *TestSuite) TestExecGasSchedule() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+
+	key := sdk.NewKVStoreKey(authzkeeper.StoreKey)
+	ctx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(s.encCfg.InterfaceRegistry)
+	authzKeeper := authzkeeper.NewKeeper(key, s.encCfg.Codec, router, s.accountKeeper)
+	banktypes.RegisterMsgServer(router, grantReadingBankServer{s.bankKeeper, &authzKeeper})
+	authz.RegisterMsgServer(router, authzKeeper)
+
+	expiration := ctx.BlockTime().AddDate(0, 1, 0)
+	require.NoError(authzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), &expiration))
+	require.NoError(authzKeeper.SaveGrant(ctx, granterAddr, s.addrs[2], banktypes.NewSendAuthorization(coins100, nil), &expiration))
+
+	// the MsgSend executed by the MsgExec is charged the gas configs of the MsgSend
+	sendConfig := storetypes.KVGasConfig()
+	sendConfig.IterNextCostFlat = 1_000_000
+	ctx = ctx.WithGasSchedule(storetypes.GasSchedule{
+		MsgConfigs: map[string]map[string]storetypes.GasConfig{
+			sdk.MsgTypeURL(&banktypes.MsgSend{}): {"": sendConfig},
+		},
+	})
+
+	msg := authz.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      coins10,
+			FromAddress: granterAddr.String(),
+			ToAddress:   s.addrs[2].String(),
+		},
+	})
+	handler := router.Handler(&msg)
+	require.NotNil(handler)
+
+	execCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := handler(execCtx, &msg)
+	require.NoError(err)
+	require.GreaterOrEqual(execCtx.GasMeter().GasConsumed(), uint64(1_000_000))
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,7 +15,9 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom       = "denom"
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -34,6 +37,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryBalanceAt(),
+		GetCmdQuerySupplyAt(),
+		GetCmdQuerySupplyHistory(),
 	)
 
 	return cmd
@@ -278,3 +284,149 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryBalanceAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-at [address] [denom] [height]",
+		Short: "Query the balance of an account for a denomination at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of an account for a denomination at the end of the block of a past height.
+The balance history must be enabled on the chain from the height.
+
+Example:
+  $ %s query %s balance-at [address] [denom] [height]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BalanceAt(cmd.Context(), &types.QueryBalanceAtRequest{
+				Address: args[0],
+				Denom:   args[1],
+				Height:  height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Balance)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySupplyAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-at [denom] [height]",
+		Short: "Query the supply of a denomination at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the supply of a denomination at the end of the block of a past height.
+The balance history must be enabled on the chain from the height.
+
+Example:
+  $ %s query %s supply-at [denom] [height]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyAt(cmd.Context(), &types.QuerySupplyAtRequest{Denom: args[0], Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amount)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySupplyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-history [denom]",
+		Short: "Query the changes of the supply of a denomination over a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the supply of a denomination at the start height, followed by the heights at which
+the supply changed up to the end height, the latest height by default.
+The balance history must be enabled on the chain from the start height.
+
+Example:
+  $ %s query %s supply-history [denom] --start-height=100 --end-height=200
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyHistory(cmd.Context(), &types.QuerySupplyHistoryRequest{
+				Denom:       args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "The height from which the supply is queried")
+	cmd.Flags().Int64(FlagEndHeight, 0, "The height up to which the supply is queried, the latest height if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supply checkpoints")
+
+	return cmd
+}
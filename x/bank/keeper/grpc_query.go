@@ -277,3 +277,102 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 	}
 	return resp, nil
 }
+
+// BalanceAt implements the Query/BalanceAt gRPC method
+func (k BaseKeeper) BalanceAt(ctx context.Context, req *types.QueryBalanceAtRequest) (*types.QueryBalanceAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	balance, err := k.GetBalanceAt(sdkCtx, address, req.Denom, req.Height)
+	if err != nil {
+		return nil, historyError(err)
+	}
+
+	return &types.QueryBalanceAtResponse{Balance: balance}, nil
+}
+
+// SupplyAt implements the Query/SupplyAt gRPC method
+func (k BaseKeeper) SupplyAt(c context.Context, req *types.QuerySupplyAtRequest) (*types.QuerySupplyAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supply, err := k.GetSupplyAt(ctx, req.Denom, req.Height)
+	if err != nil {
+		return nil, historyError(err)
+	}
+
+	return &types.QuerySupplyAtResponse{Amount: supply}, nil
+}
+
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+	if endHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is before start height %d", endHeight, req.StartHeight)
+	}
+
+	startSupply, err := k.GetSupplyAt(ctx, req.Denom, req.StartHeight)
+	if err != nil {
+		return nil, historyError(err)
+	}
+
+	var checkpoints []types.SupplyCheckpoint
+	pageRes, err := query.FilteredPaginate(k.supplyHistoryStore(ctx, req.Denom), req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		height := int64(sdk.BigEndianToUint64(key))
+		if height <= req.StartHeight || height > endHeight {
+			return false, nil
+		}
+
+		if accumulate {
+			checkpoints = append(checkpoints, types.SupplyCheckpoint{
+				Height: height,
+				Amount: sdk.NewCoin(req.Denom, unmarshalAmount(value)),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{StartSupply: startSupply, Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
+
+// historyError returns the gRPC status of an error of the balance history.
+func historyError(err error) error {
+	if types.ErrHistoryNotTracked.Is(err) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// WithBalanceHistory returns the keeper tracking the balances and the supplies
+// at every height, from the first change of a balance or supply. A checkpoint is
+// written whenever a balance or a supply changes, so that BalanceAt and SupplyAt
+// don't depend on the heights retained by the IAVL stores.
+//
+// The checkpoints being written to the store of the module, the history must be
+// tracked by all the nodes of a chain or by none.
+func (k BaseKeeper) WithBalanceHistory() BaseKeeper {
+	k.BaseSendKeeper.balanceHistory = true
+	return k
+}
+
+// GetHistoryStartHeight returns the height from which the balance history is
+// tracked, and false if the history is not tracked yet.
+func (k BaseSendKeeper) GetHistoryStartHeight(ctx sdk.Context) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HistoryStartHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// recordCheckpoint records the amount of a balance or supply at the current
+// height, the store holding a single checkpoint per height. On the first change
+// of the amount since the start of the history, the previous amount is recorded
+// as well at the start height, unless it is zero: the absence of checkpoint
+// before a height means a zero amount if checkpoints follow it, and the current
+// amount otherwise.
+func (k BaseSendKeeper) recordCheckpoint(ctx sdk.Context, historyPrefix []byte, amount math.Int, previous func() math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	height := ctx.BlockHeight()
+
+	start, ok := k.GetHistoryStartHeight(ctx)
+	if !ok {
+		start = height
+		store.Set(types.HistoryStartHeightKey, sdk.Uint64ToBigEndian(uint64(start)))
+	}
+
+	if start < height && !hasCheckpoint(store, historyPrefix) {
+		if prev := previous(); !prev.IsZero() {
+			setCheckpoint(store, historyPrefix, start, prev)
+		}
+	}
+
+	setCheckpoint(store, historyPrefix, height, amount)
+}
+
+// amountAt returns the amount of a balance or supply at the end of the block of
+// the given height, the current amount being returned by current.
+func (k BaseSendKeeper) amountAt(ctx sdk.Context, historyPrefix []byte, height int64, current func() math.Int) (math.Int, error) {
+	if !k.balanceHistory {
+		return math.Int{}, sdkerrors.Wrap(types.ErrHistoryNotTracked, "the balance history is not enabled")
+	}
+
+	start, ok := k.GetHistoryStartHeight(ctx)
+	switch {
+	case !ok || height < start:
+		return math.Int{}, sdkerrors.Wrapf(types.ErrHistoryNotTracked, "height %d is before the start of the balance history", height)
+	case height > ctx.BlockHeight():
+		return math.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height %d is after the latest height %d", height, ctx.BlockHeight())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	it := store.ReverseIterator(historyPrefix, types.CreateCheckpointKey(historyPrefix, height+1))
+	defer it.Close()
+
+	if it.Valid() {
+		return unmarshalAmount(it.Value()), nil
+	}
+	if hasCheckpoint(store, historyPrefix) {
+		return math.ZeroInt(), nil
+	}
+
+	return current(), nil
+}
+
+// GetBalanceAt returns the balance of an account for a denomination at the end
+// of the block of the given height. It fails unless the balance history is
+// tracked from the height.
+func (k BaseSendKeeper) GetBalanceAt(ctx sdk.Context, addr sdk.AccAddress, denom string, height int64) (sdk.Coin, error) {
+	amount, err := k.amountAt(ctx, types.CreateBalanceHistoryPrefix(addr, denom), height, func() math.Int {
+		return k.GetBalance(ctx, addr, denom).Amount
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
+// GetSupplyAt returns the supply of a denomination at the end of the block of
+// the given height. It fails unless the balance history is tracked from the
+// height.
+func (k BaseKeeper) GetSupplyAt(ctx sdk.Context, denom string, height int64) (sdk.Coin, error) {
+	amount, err := k.amountAt(ctx, types.CreateSupplyHistoryPrefix(denom), height, func() math.Int {
+		return k.GetSupply(ctx, denom).Amount
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
+// supplyHistoryStore returns the checkpoints of the supply of a denomination,
+// by height.
+func (k BaseKeeper) supplyHistoryStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplyHistoryPrefix(denom))
+}
+
+func hasCheckpoint(store storetypes.KVStore, historyPrefix []byte) bool {
+	it := storetypes.KVStorePrefixIterator(store, historyPrefix)
+	defer it.Close()
+
+	return it.Valid()
+}
+
+func setCheckpoint(store storetypes.KVStore, historyPrefix []byte, height int64, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.CreateCheckpointKey(historyPrefix, height), bz)
+}
+
+func unmarshalAmount(bz []byte) math.Int {
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
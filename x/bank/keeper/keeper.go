@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -40,12 +41,15 @@ type Keeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToRecipient(ctx sdk.Context, senderModule string, delegatorAddr, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	OverrideState(ctx sdk.Context, overrides *tx.StateOverrides) error
+
 	types.QueryServer
 }
 
@@ -138,6 +142,9 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 // vesting and vested coins. The coins are then transferred from the delegator
 // address to a ModuleAccount address. If any of the delegation amounts are negative,
 // an error is returned.
+//
+// NOTE: The coins are moved without the send restriction and the hooks, which
+// apply to transfers only, so that they can't redirect or block a delegation.
 func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
@@ -186,6 +193,9 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 // vesting and vested coins. The coins are then transferred from a ModuleAccount
 // address to the delegator address. If any of the undelegation amounts are
 // negative, an error is returned.
+//
+// NOTE: The coins are moved without the send restriction and the hooks, which
+// apply to transfers only, so that they can't redirect or block an undelegation.
 func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
@@ -394,6 +404,34 @@ func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
 	return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
 }
 
+// UndelegateCoinsFromModuleToRecipient undelegates the unbonding coins of a
+// delegator and transfers them from a module account to a recipient account.
+// The undelegation is tracked on the delegator, while the coins are sent to the
+// recipient with a plain transfer. It will panic if the module account does not
+// exist or is unauthorized.
+func (k BaseKeeper) UndelegateCoinsFromModuleToRecipient(
+	ctx sdk.Context, senderModule string, delegatorAddr, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	acc := k.ak.GetModuleAccount(ctx, senderModule)
+	if acc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if !acc.HasPermission(authtypes.Staking) {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to undelegate coins", senderModule))
+	}
+
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if err := k.trackUndelegation(ctx, delegatorAddr, amt); err != nil {
+		return sdkerrors.Wrap(err, "failed to track undelegation")
+	}
+
+	return k.SendCoins(ctx, acc.GetAddress(), recipientAddr, amt)
+}
+
 // MintCoins creates new coins from thin air and adds it to the module account.
 // It will panic if the module account does not exist or is unauthorized.
 func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
@@ -467,6 +505,36 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 	return nil
 }
 
+// OverrideState replaces the balances of the accounts overridden in the state
+// a simulation runs against, the supply following the balances. It is set as
+// a state overrider of the BaseApp.
+func (k BaseKeeper) OverrideState(ctx sdk.Context, overrides *tx.StateOverrides) error {
+	for _, override := range overrides.Balances {
+		addr, err := sdk.AccAddressFromBech32(override.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid overridden address %s: %s", override.Address, err)
+		}
+		if err := override.Balance.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid overridden balance of %s: %s", override.Address, err)
+		}
+
+		for _, coin := range k.GetAllBalances(ctx, addr) {
+			if err := k.setBalance(ctx, addr, sdk.NewCoin(coin.Denom, math.ZeroInt())); err != nil {
+				return err
+			}
+			k.setSupply(ctx, k.GetSupply(ctx, coin.Denom).Sub(coin))
+		}
+		for _, coin := range override.Balance {
+			if err := k.setBalance(ctx, addr, coin); err != nil {
+				return err
+			}
+			k.setSupply(ctx, k.GetSupply(ctx, coin.Denom).Add(coin))
+		}
+	}
+
+	return nil
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	intBytes, err := coin.Amount.Marshal()
@@ -474,6 +542,12 @@ func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 		panic(fmt.Errorf("unable to marshal amount value %v", err))
 	}
 
+	if k.balanceHistory {
+		k.recordCheckpoint(ctx, types.CreateSupplyHistoryPrefix(coin.Denom), coin.Amount, func() math.Int {
+			return k.GetSupply(ctx, coin.Denom).Amount
+		})
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	supplyStore := prefix.NewStore(store, types.SupplyKey)
 
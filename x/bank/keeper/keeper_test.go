@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,12 +15,16 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -541,6 +546,178 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+// recordingHooks records the transfers of coins, and fails them with err if set.
+type recordingHooks struct {
+	before, after []string
+	err           error
+}
+
+func (h *recordingHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.before = append(h.before, fmt.Sprintf("%s %s %s", fromAddr, toAddr, amt))
+	return h.err
+}
+
+func (h *recordingHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, fmt.Sprintf("%s %s %s", fromAddr, toAddr, amt))
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestSendRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// the restrictions registered apply to the copies of the keeper
+	bankKeeper := suite.bankKeeper
+	var calls []string
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "freeze")
+		if amt.AmountOf(barDenom).IsPositive() {
+			return nil, fmt.Errorf("%s is frozen", barDenom)
+		}
+		return toAddr, nil
+	})
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	})
+	suite.bankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "first")
+		return toAddr, nil
+	})
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Equal([]string{"first", "freeze", "redirect"}, calls)
+	require.True(bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// a transfer vetoed by a restriction is not applied, nor restricted further
+	calls = nil
+	err := bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newBarCoin(10)))
+	require.EqualError(err, "bar is frozen")
+	require.Equal([]string{"first", "freeze"}, calls)
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the outputs of a multi-send are restricted before any coins are moved
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(10))}}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newBarCoin(10))},
+	}
+	require.EqualError(bankKeeper.InputOutputCoins(ctx, inputs, outputs), "bar is frozen")
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, []sdk.AccAddress{accAddrs[1], accAddrs[3]})
+	require.NoError(bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newBarCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	// the outputs of a multi-send are restricted once per input
+	var senders []string
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		senders = append(senders, fromAddr.String())
+		if fromAddr.Equals(accAddrs[1]) {
+			return nil, fmt.Errorf("%s is frozen", fromAddr)
+		}
+		return toAddr, nil
+	})
+	inputs = []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []banktypes.Output{{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	require.EqualError(bankKeeper.InputOutputCoins(ctx, inputs, outputs), fmt.Sprintf("%s is frozen", accAddrs[1]))
+	require.Equal([]string{accAddrs[0].String(), accAddrs[1].String()}, senders)
+	require.Equal(sdk.NewCoins(newFooCoin(80), newBarCoin(40)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	suite.bankKeeper.ClearSendRestriction()
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0, acc1}, []sdk.AccAddress{accAddrs[3]})
+	require.NoError(bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(40)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+}
+
+func (suite *KeeperTestSuite) TestSendRestrictionBlockedAddr() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// accAddrs[4] is blocked, the transfers redirected to it fail
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[4], nil
+		}
+		return toAddr, nil
+	})
+
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	err = suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []banktypes.Output{{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	require.Equal(sdk.NewCoins(newFooCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[4]).IsZero())
+
+	// the transfers which are not redirected are not checked again
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestSendHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	hooks := &recordingHooks{}
+	suite.bankKeeper.SetHooks(banktypes.NewMultiBankHooks(hooks))
+	require.Panics(func() { suite.bankKeeper.SetHooks(hooks) })
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	transfer := fmt.Sprintf("%s %s 10%s", accAddrs[0], accAddrs[1], fooDenom)
+	require.Equal([]string{transfer}, hooks.before)
+	require.Equal([]string{transfer}, hooks.after)
+
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, accAddrs[1:3])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	second := fmt.Sprintf("%s %s 10%s", accAddrs[0], accAddrs[2], fooDenom)
+	require.Equal([]string{transfer, transfer, second}, hooks.before)
+	require.Equal([]string{transfer, transfer, second}, hooks.after)
+
+	// a hook vetoes a transfer
+	hooks.err = errors.New("vetoed")
+	require.EqualError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), "vetoed")
+	require.Len(hooks.after, 3)
+	require.Equal(sdk.NewCoins(newFooCoin(70)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
 func (suite *KeeperTestSuite) TestValidateBalance() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1712,3 +1889,117 @@ func (suite *KeeperTestSuite) TestSetParams() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestOverrideState() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	overrides := &tx.StateOverrides{
+		Balances: []*tx.BalanceOverride{
+			{Address: accAddrs[0].String(), Balance: sdk.NewCoins(newBarCoin(10))},
+			{Address: accAddrs[1].String(), Balance: sdk.NewCoins(newFooCoin(30))},
+		},
+	}
+	require.NoError(suite.bankKeeper.OverrideState(ctx, overrides))
+
+	require.Equal(sdk.NewCoins(newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(newFooCoin(30), suite.bankKeeper.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(10), suite.bankKeeper.GetSupply(ctx, barDenom))
+
+	// the denoms of the overridden balances are indexed
+	res, err := suite.queryClient.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Len(res.DenomOwners, 1)
+	require.Equal(accAddrs[1].String(), res.DenomOwners[0].Address)
+
+	invalidOverrides := []*tx.StateOverrides{
+		{Balances: []*tx.BalanceOverride{{Address: "invalid"}}},
+		{Balances: []*tx.BalanceOverride{{Address: accAddrs[0].String(), Balance: sdk.Coins{newFooCoin(0)}}}},
+	}
+	for _, overrides := range invalidOverrides {
+		require.Error(suite.bankKeeper.OverrideState(ctx, overrides))
+	}
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	// the history is not tracked unless enabled
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	_, err := suite.bankKeeper.GetBalanceAt(suite.ctx, accAddrs[0], fooDenom, 1)
+	require.ErrorIs(err, banktypes.ErrHistoryNotTracked)
+
+	// the history starts at the first change following its activation
+	suite.bankKeeper = suite.bankKeeper.WithBalanceHistory()
+	suite.ctx = suite.ctx.WithBlockHeight(3)
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	start, ok := suite.bankKeeper.GetHistoryStartHeight(suite.ctx)
+	require.True(ok)
+	require.Equal(int64(3), start)
+
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(50))))
+
+	suite.ctx = suite.ctx.WithBlockHeight(7)
+	suite.mockSendCoins(suite.ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+
+	suite.ctx = suite.ctx.WithBlockHeight(8)
+	balances := []struct {
+		addr   sdk.AccAddress
+		height int64
+		amount int64
+	}{
+		{accAddrs[0], 3, 100},
+		{accAddrs[0], 4, 100},
+		{accAddrs[0], 5, 150},
+		{accAddrs[0], 8, 130},
+		{accAddrs[1], 5, 0},
+		{accAddrs[1], 7, 20},
+		{accAddrs[2], 4, 0},
+	}
+	for _, b := range balances {
+		balance, err := suite.bankKeeper.GetBalanceAt(suite.ctx, b.addr, fooDenom, b.height)
+		require.NoError(err)
+		require.Equal(newFooCoin(b.amount), balance, "balance of %s at height %d", b.addr, b.height)
+	}
+
+	_, err = suite.bankKeeper.GetBalanceAt(suite.ctx, accAddrs[0], fooDenom, 2)
+	require.ErrorIs(err, banktypes.ErrHistoryNotTracked)
+	_, err = suite.bankKeeper.GetBalanceAt(suite.ctx, accAddrs[0], fooDenom, 9)
+	require.ErrorIs(err, sdkerrors.ErrInvalidHeight)
+
+	supply, err := suite.bankKeeper.GetSupplyAt(suite.ctx, fooDenom, 4)
+	require.NoError(err)
+	require.Equal(newFooCoin(100), supply)
+	supply, err = suite.bankKeeper.GetSupplyAt(suite.ctx, barDenom, 3)
+	require.NoError(err)
+	require.Equal(newBarCoin(10), supply)
+
+	queryClient := suite.mockQueryClient(suite.ctx)
+	res, err := queryClient.BalanceAt(sdk.WrapSDKContext(suite.ctx), &banktypes.QueryBalanceAtRequest{Address: accAddrs[1].String(), Denom: barDenom, Height: 3})
+	require.NoError(err)
+	require.Equal(newBarCoin(10), res.Balance)
+	_, err = queryClient.BalanceAt(sdk.WrapSDKContext(suite.ctx), &banktypes.QueryBalanceAtRequest{Address: accAddrs[1].String(), Denom: barDenom, Height: 2})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	historyRes, err := queryClient.SupplyHistory(sdk.WrapSDKContext(suite.ctx), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, StartHeight: 3})
+	require.NoError(err)
+	require.Equal(newFooCoin(100), historyRes.StartSupply)
+	require.Equal([]banktypes.SupplyCheckpoint{{Height: 5, Amount: newFooCoin(150)}}, historyRes.Checkpoints)
+
+	historyRes, err = queryClient.SupplyHistory(sdk.WrapSDKContext(suite.ctx), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, StartHeight: 5, EndHeight: 8})
+	require.NoError(err)
+	require.Equal(newFooCoin(150), historyRes.StartSupply)
+	require.Empty(historyRes.Checkpoints)
+}
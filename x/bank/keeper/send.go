@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -39,6 +41,11 @@ type SendKeeper interface {
 	GetBlockedAddresses() map[string]bool
 
 	GetAuthority() string
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(hooks types.BankHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -58,6 +65,34 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// the send restriction and hooks are shared by the copies of the keeper, so
+	// that they can be registered after the keeper is passed to other modules
+	sendRestriction *sendRestriction
+	hooks           *sendHooks
+
+	// balanceHistory is set if the balances and the supplies are tracked at
+	// every height
+	balanceHistory bool
+}
+
+// sendRestriction holds the restriction of the transfers of a keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// apply returns the address the coins of a transfer are sent to, as given by the
+// restriction if any.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r.fn == nil {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
+
+// sendHooks holds the hooks of the transfers of a keeper.
+type sendHooks struct {
+	hooks types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -72,15 +107,73 @@ func NewBaseSendKeeper(
 	}
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		blockedAddrs:   blockedAddrs,
-		authority:      authority,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: &sendRestriction{},
+		hooks:           &sendHooks{},
 	}
 }
 
+// AppendSendRestriction adds a restriction of the transfers of coins, applied
+// after the restrictions already registered to the address they return.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = k.sendRestriction.fn.Then(restriction)
+}
+
+// PrependSendRestriction adds a restriction of the transfers of coins, applied
+// before the restrictions already registered.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = restriction.Then(k.sendRestriction.fn)
+}
+
+// ClearSendRestriction removes the restrictions of the transfers of coins.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.fn = nil
+}
+
+// applySendRestriction returns the address the coins of a transfer are sent to,
+// as given by the send restriction. The callers only check the original
+// recipient against the blocked addresses, so a transfer redirected to a blocked
+// address is not allowed.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+	return newToAddr, nil
+}
+
+// SetHooks sets the hooks of the transfers of coins, which can be combined with
+// types.NewMultiBankHooks.
+func (k BaseSendKeeper) SetHooks(hooks types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = hooks
+}
+
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+	return k.hooks.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+}
+
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+	return k.hooks.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+}
+
 // GetAuthority returns the x/bank module's authority.
 func (k BaseSendKeeper) GetAuthority() string {
 	return k.authority
@@ -121,7 +214,12 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't line up or if any single transfer of tokens fails.
+// inputs and outputs don't line up, if a transfer to an output is not allowed by
+// the send restriction or the hooks, or if any single transfer of tokens fails.
+//
+// As the outputs are not attributed to the inputs, the send restriction and the
+// hooks are applied to the transfer of each output from every input, in the
+// order of the inputs, with the coins of the output.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -129,16 +227,44 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		inAddresses[i] = inAddress
+	}
+
+	// the transfers to the outputs are restricted before any coins are moved
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		for _, inAddress := range inAddresses {
+			outAddress, err = k.applySendRestriction(ctx, inAddress, outAddress, out.Coins)
+			if err != nil {
+				return err
+			}
+		}
+		outAddresses[i] = outAddress
+	}
+	for _, inAddress := range inAddresses {
+		for i, out := range outputs {
+			if err := k.beforeSend(ctx, inAddress, outAddresses[i], out.Coins); err != nil {
+				return err
+			}
+		}
+	}
 
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
+	for i, in := range inputs {
+		err := k.subUnlockedCoins(ctx, inAddresses[i], in.Coins)
 		if err != nil {
 			return err
 		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				sdk.EventTypeMessage,
@@ -147,12 +273,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
+		err := k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -160,7 +283,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -176,13 +299,31 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	for _, inAddress := range inAddresses {
+		for i, out := range outputs {
+			if err := k.afterSend(ctx, inAddress, outAddresses[i], out.Coins); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
-// SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// SendCoins transfers amt coins from a sending account to a receiving account,
+// or to the account the transfer is redirected to by the send restriction. An
+// error is returned upon failure, or if the transfer is not allowed by the send
+// restriction or the hooks.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -217,7 +358,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
@@ -324,6 +465,12 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if k.balanceHistory {
+		k.recordCheckpoint(ctx, types.CreateBalanceHistoryPrefix(addr, balance.Denom), balance.Amount, func() math.Int {
+			return k.GetBalance(ctx, addr, balance.Denom).Amount
+		})
+	}
+
 	accountStore := k.getAccountStore(ctx, addr)
 	denomPrefixStore := k.getDenomAddressPrefixStore(ctx, balance.Denom)
 
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -221,8 +222,9 @@ type bankInputs struct {
 type bankOutputs struct {
 	depinject.Out
 
-	BankKeeper keeper.BaseKeeper
-	Module     runtime.AppModuleWrapper
+	BankKeeper    keeper.BaseKeeper
+	Module        runtime.AppModuleWrapper
+	BaseAppOption runtime.BaseAppOption
 }
 
 func provideModule(in bankInputs) bankOutputs {
@@ -254,7 +256,15 @@ func provideModule(in bankInputs) bankOutputs {
 		blockedAddresses,
 		authority.String(),
 	)
+	if in.Config.EnableBalanceHistory {
+		bankKeeper = bankKeeper.WithBalanceHistory()
+	}
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper, in.LegacySubspace)
 
-	return bankOutputs{BankKeeper: bankKeeper, Module: runtime.WrapAppModule(m)}
+	// the balances can be overridden in the state of simulations
+	baseAppOption := func(app *baseapp.BaseApp) {
+		app.AddStateOverrider(bankKeeper.OverrideState)
+	}
+
+	return bankOutputs{BankKeeper: bankKeeper, Module: runtime.WrapAppModule(m), BaseAppOption: baseAppOption}
 }
@@ -27,3 +27,10 @@ type AccountKeeper interface {
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 	GetModulePermissions() map[string]types.PermissionsForAddress
 }
+
+// BankHooks event hooks for the transfers of coins between accounts, which other
+// modules can subscribe to. An error returned by a hook aborts the transfer.
+type BankHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are transferred
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error  // Must be called after coins are transferred
+}
@@ -35,6 +35,18 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = []byte{0x05}
+
+	// BalanceHistoryPrefix is the prefix for the checkpoints of the balances, by
+	// address, denomination and height.
+	BalanceHistoryPrefix = []byte{0x06}
+
+	// SupplyHistoryPrefix is the prefix for the checkpoints of the supplies, by
+	// denomination and height.
+	SupplyHistoryPrefix = []byte{0x07}
+
+	// HistoryStartHeightKey is the key of the height from which the balance
+	// history is tracked.
+	HistoryStartHeightKey = []byte{0x08}
 )
 
 const (
@@ -95,6 +107,26 @@ func CreateSendEnabledKey(denom string) []byte {
 	return key
 }
 
+// CreateBalanceHistoryPrefix creates the prefix for the checkpoints of the
+// balance of an account for a denomination.
+func CreateBalanceHistoryPrefix(addr []byte, denom string) []byte {
+	key := append([]byte{}, BalanceHistoryPrefix...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// CreateSupplyHistoryPrefix creates the prefix for the checkpoints of the supply
+// of a denomination.
+func CreateSupplyHistoryPrefix(denom string) []byte {
+	return append(append([]byte{}, SupplyHistoryPrefix...), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// CreateCheckpointKey creates the key of the checkpoint of a height, given the
+// prefix of a balance or supply history.
+func CreateCheckpointKey(historyPrefix []byte, height int64) []byte {
+	return append(append([]byte{}, historyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // IsTrueB returns true if the provided byte slice has exactly one byte, and it is equal to TrueB.
 func IsTrueB(bz []byte) bool {
 	return len(bz) == 1 && bz[0] == TrueB
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn is a restriction of the transfers of coins between accounts. It
+// returns the address the coins are sent to, which is toAddr unless the transfer is
+// redirected, or an error if the transfer is not allowed.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// Then returns a restriction applying r, then second to the address returned by r.
+// A nil restriction is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions returns a restriction applying the restrictions in
+// order, each to the address returned by the previous one. The nil restrictions
+// are skipped, and nil is returned if there is no other restriction.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composed SendRestrictionFn
+	for _, r := range restrictions {
+		composed = composed.Then(r)
+	}
+
+	return composed
+}
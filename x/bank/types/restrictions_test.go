@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	otherAddr := sdk.AccAddress("other_______________")
+
+	var calls []string
+	redirect := func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect "+string(to[:2]))
+		return otherAddr, nil
+	}
+	check := func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "check "+string(to[:2]))
+		if to.Equals(toAddr) {
+			return nil, errors.New("not allowed")
+		}
+		return to, nil
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	// each restriction is applied to the address returned by the previous one
+	addr, err := types.ComposeSendRestrictions(nil, redirect, check)(sdk.Context{}, fromAddr, toAddr, nil)
+	require.NoError(t, err)
+	require.Equal(t, otherAddr, addr)
+	require.Equal(t, []string{"redirect to", "check ot"}, calls)
+
+	// the restrictions following an error are skipped
+	calls = nil
+	_, err = types.SendRestrictionFn(check).Then(redirect)(sdk.Context{}, fromAddr, toAddr, nil)
+	require.EqualError(t, err, "not allowed")
+	require.Equal(t, []string{"check to"}, calls)
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+// InitGenesis new gasschedule genesis
+func (keeper Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx))
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const sendMsgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	gasScheduleKeeper keeper.Keeper
+	ctx               sdk.Context
+	msgServer         types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(gasschedule.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	s.gasScheduleKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.msgServer = keeper.NewMsgServerImpl(s.gasScheduleKeeper)
+}
+
+// readGasConfig returns the default KVStore gas config with the given flat read
+// cost.
+func readGasConfig(readCostFlat uint64) types.GasConfig {
+	config := types.NewGasConfig(storetypes.KVGasConfig())
+	config.ReadCostFlat = readCostFlat
+	return config
+}
+
+func testParams() types.Params {
+	return types.NewParams(
+		[]types.StoreGasConfig{
+			{StoreKey: "bank", Config: readGasConfig(100)},
+		},
+		[]types.MsgGasConfig{
+			{MsgTypeUrl: sendMsgTypeURL, Config: readGasConfig(200)},
+			{MsgTypeUrl: sendMsgTypeURL, StoreKey: "bank", Config: readGasConfig(300)},
+		},
+	)
+}
+
+func (s *IntegrationTestSuite) TestParams() {
+	// the params are empty until set
+	s.Require().Equal(types.Params{}, s.gasScheduleKeeper.GetParams(s.ctx))
+
+	invalid := types.NewParams([]types.StoreGasConfig{{StoreKey: "bank", Config: readGasConfig(100)}, {StoreKey: "bank", Config: readGasConfig(100)}}, nil)
+	s.Require().Error(s.gasScheduleKeeper.SetParams(s.ctx, invalid))
+	s.Require().Equal(types.Params{}, s.gasScheduleKeeper.GetParams(s.ctx))
+
+	s.Require().NoError(s.gasScheduleKeeper.SetParams(s.ctx, testParams()))
+	s.Require().Equal(testParams(), s.gasScheduleKeeper.GetParams(s.ctx))
+
+	res, err := s.gasScheduleKeeper.Params(sdk.WrapSDKContext(s.ctx), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(testParams(), res.Params)
+}
+
+func (s *IntegrationTestSuite) TestGasSchedule() {
+	bankKey, stakingKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("staking")
+
+	// the default configs are charged until the params are set
+	schedule := s.gasScheduleKeeper.GasSchedule(s.ctx)
+	s.Require().Equal(storetypes.KVGasConfig(), schedule.KVGasConfig(bankKey))
+
+	s.Require().NoError(s.gasScheduleKeeper.SetParams(s.ctx, testParams()))
+	schedule = s.gasScheduleKeeper.GasSchedule(s.ctx)
+	s.Require().Equal(uint64(100), schedule.KVGasConfig(bankKey).ReadCostFlat)
+	s.Require().Equal(storetypes.KVGasConfig(), schedule.KVGasConfig(stakingKey))
+	s.Require().Equal(uint64(300), schedule.ForMsg(sendMsgTypeURL).KVGasConfig(bankKey).ReadCostFlat)
+	s.Require().Equal(uint64(200), schedule.ForMsg(sendMsgTypeURL).KVGasConfig(stakingKey).ReadCostFlat)
+	s.Require().Equal(uint64(100), schedule.ForMsg("/cosmos.gov.v1.MsgVote").KVGasConfig(bankKey).ReadCostFlat)
+}
+
+func (s *IntegrationTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+				Params:    testParams(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.gasScheduleKeeper.GetAuthority(),
+				Params: types.NewParams(nil, []types.MsgGasConfig{
+					{MsgTypeUrl: sendMsgTypeURL, Config: readGasConfig(100)},
+					{MsgTypeUrl: sendMsgTypeURL, Config: readGasConfig(100)},
+				}),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set zero costs",
+			request: &types.MsgUpdateParams{
+				Authority: s.gasScheduleKeeper.GetAuthority(),
+				Params:    types.NewParams([]types.StoreGasConfig{{StoreKey: "bank"}}, nil),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.gasScheduleKeeper.GetAuthority(),
+				Params:    testParams(),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Params, s.gasScheduleKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGenesis() {
+	s.gasScheduleKeeper.InitGenesis(s.ctx, types.NewGenesisState(testParams()))
+	s.Require().Equal(types.NewGenesisState(testParams()), s.gasScheduleKeeper.ExportGenesis(s.ctx))
+}
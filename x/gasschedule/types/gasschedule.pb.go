@@ -0,0 +1,1284 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/gasschedule/v1/gasschedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the gasschedule module, which is the gas
+// schedule of the KVStores. The stores without a config are charged the default
+// config of their store type.
+type Params struct {
+	// store_configs are the gas configs of the stores.
+	StoreConfigs []StoreGasConfig `protobuf:"bytes,1,rep,name=store_configs,json=storeConfigs,proto3" json:"store_configs"`
+	// msg_configs are the gas configs of the stores accessed while executing a
+	// message, which take precedence over the store configs.
+	MsgConfigs []MsgGasConfig `protobuf:"bytes,2,rep,name=msg_configs,json=msgConfigs,proto3" json:"msg_configs"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34f0fc8f566fad, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetStoreConfigs() []StoreGasConfig {
+	if m != nil {
+		return m.StoreConfigs
+	}
+	return nil
+}
+
+func (m *Params) GetMsgConfigs() []MsgGasConfig {
+	if m != nil {
+		return m.MsgConfigs
+	}
+	return nil
+}
+
+// StoreGasConfig defines the gas config of a store.
+type StoreGasConfig struct {
+	// store_key is the name of the store key.
+	StoreKey string    `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Config   GasConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *StoreGasConfig) Reset()         { *m = StoreGasConfig{} }
+func (m *StoreGasConfig) String() string { return proto.CompactTextString(m) }
+func (*StoreGasConfig) ProtoMessage()    {}
+func (*StoreGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34f0fc8f566fad, []int{1}
+}
+func (m *StoreGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasConfig.Merge(m, src)
+}
+func (m *StoreGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasConfig proto.InternalMessageInfo
+
+func (m *StoreGasConfig) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreGasConfig) GetConfig() GasConfig {
+	if m != nil {
+		return m.Config
+	}
+	return GasConfig{}
+}
+
+// MsgGasConfig defines the gas config of the stores accessed while executing a
+// message.
+type MsgGasConfig struct {
+	// msg_type_url is the type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// store_key is the name of the store key, the config applying to all the
+	// stores if it is empty.
+	StoreKey string    `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Config   GasConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgGasConfig) Reset()         { *m = MsgGasConfig{} }
+func (m *MsgGasConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgGasConfig) ProtoMessage()    {}
+func (*MsgGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34f0fc8f566fad, []int{2}
+}
+func (m *MsgGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasConfig.Merge(m, src)
+}
+func (m *MsgGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasConfig proto.InternalMessageInfo
+
+func (m *MsgGasConfig) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGasConfig) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *MsgGasConfig) GetConfig() GasConfig {
+	if m != nil {
+		return m.Config
+	}
+	return GasConfig{}
+}
+
+// GasConfig defines the gas costs of the operations on a store.
+type GasConfig struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (m *GasConfig) Reset()         { *m = GasConfig{} }
+func (m *GasConfig) String() string { return proto.CompactTextString(m) }
+func (*GasConfig) ProtoMessage()    {}
+func (*GasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34f0fc8f566fad, []int{3}
+}
+func (m *GasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConfig.Merge(m, src)
+}
+func (m *GasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConfig proto.InternalMessageInfo
+
+func (m *GasConfig) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.gasschedule.v1.Params")
+	proto.RegisterType((*StoreGasConfig)(nil), "cosmos.gasschedule.v1.StoreGasConfig")
+	proto.RegisterType((*MsgGasConfig)(nil), "cosmos.gasschedule.v1.MsgGasConfig")
+	proto.RegisterType((*GasConfig)(nil), "cosmos.gasschedule.v1.GasConfig")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/gasschedule/v1/gasschedule.proto", fileDescriptor_5c34f0fc8f566fad)
+}
+
+var fileDescriptor_5c34f0fc8f566fad = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x6e, 0x48, 0x9b, 0x49, 0xda, 0xa2, 0x2d, 0x48, 0x01, 0x24, 0xd7, 0x0a, 0xff,
+	0x22, 0xa1, 0xd8, 0xb4, 0xdc, 0x39, 0x04, 0x09, 0xa4, 0x22, 0x50, 0x14, 0xe0, 0xc2, 0xc5, 0x72,
+	0x9c, 0xa9, 0x13, 0xd5, 0xee, 0x46, 0x3b, 0x9b, 0x12, 0x3f, 0x04, 0x12, 0x8f, 0xc0, 0x89, 0x67,
+	0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x2f, 0x82, 0x76, 0x37, 0x49, 0x9d, 0x8a, 0x5c, 0x7a, 0xb2,
+	0x3d, 0xdf, 0xcf, 0xbf, 0xf9, 0x2c, 0x6b, 0xe1, 0x79, 0x2c, 0x28, 0x13, 0x14, 0x24, 0x11, 0x51,
+	0x3c, 0xc4, 0xc1, 0x24, 0xc5, 0xe0, 0xe2, 0xa8, 0xf8, 0xe8, 0x8f, 0xa5, 0x50, 0x82, 0xdf, 0xb7,
+	0xa0, 0x5f, 0x4c, 0x2e, 0x8e, 0x1e, 0xde, 0x4b, 0x44, 0x22, 0x0c, 0x11, 0xe8, 0x3b, 0x0b, 0x37,
+	0x7f, 0x31, 0xa8, 0x74, 0x23, 0x19, 0x65, 0xc4, 0xbb, 0xb0, 0x4b, 0x4a, 0x48, 0x0c, 0x63, 0x71,
+	0x7e, 0x3a, 0x4a, 0xa8, 0xc1, 0xbc, 0xad, 0x56, 0xed, 0xf8, 0xa9, 0xff, 0x5f, 0x9f, 0xff, 0x49,
+	0xb3, 0xef, 0x22, 0x7a, 0x63, 0xe8, 0x4e, 0xf9, 0xf2, 0xcf, 0x61, 0xa9, 0x57, 0x37, 0x06, 0x3b,
+	0x22, 0x7e, 0x02, 0xb5, 0x8c, 0x92, 0x95, 0xcf, 0x31, 0xbe, 0xc7, 0x1b, 0x7c, 0x1f, 0x28, 0xb9,
+	0x69, 0x83, 0x8c, 0x92, 0x85, 0xab, 0x99, 0xc1, 0xde, 0xfa, 0x46, 0xfe, 0x08, 0xaa, 0xb6, 0xef,
+	0x19, 0xe6, 0x0d, 0xe6, 0xb1, 0x56, 0xb5, 0xb7, 0x63, 0x06, 0xef, 0x31, 0xe7, 0xaf, 0xa1, 0x62,
+	0xd7, 0x36, 0x1c, 0x8f, 0xb5, 0x6a, 0xc7, 0xde, 0x86, 0xad, 0x37, 0x57, 0x2e, 0xde, 0x6a, 0x7e,
+	0x67, 0x50, 0x2f, 0x36, 0xe2, 0x1e, 0xd4, 0xf5, 0xb7, 0xa8, 0x7c, 0x8c, 0xe1, 0x44, 0xa6, 0x8b,
+	0x85, 0xba, 0xe1, 0xe7, 0x7c, 0x8c, 0x5f, 0x64, 0xba, 0xde, 0xc7, 0xd9, 0xd8, 0x67, 0xeb, 0x56,
+	0x7d, 0x7e, 0x3a, 0x50, 0xbd, 0x2e, 0xf3, 0x00, 0x76, 0x86, 0x11, 0x85, 0xb1, 0x20, 0x65, 0x8a,
+	0x94, 0x7b, 0xdb, 0x43, 0x1d, 0x92, 0xe2, 0x87, 0x50, 0x1b, 0x60, 0x8a, 0x0a, 0x6d, 0xea, 0x98,
+	0x14, 0xec, 0xc8, 0x00, 0x4f, 0x60, 0x4f, 0x62, 0x34, 0x30, 0x71, 0x78, 0x9a, 0x46, 0xca, 0x34,
+	0x2a, 0xf7, 0xea, 0x7a, 0xaa, 0x89, 0xb7, 0x69, 0xa4, 0xf8, 0x0b, 0xe0, 0xd7, 0xd4, 0x18, 0x65,
+	0xd8, 0xcf, 0x15, 0x36, 0xca, 0x86, 0xdc, 0x5f, 0x92, 0x5d, 0x94, 0x9d, 0x5c, 0x21, 0x7f, 0x06,
+	0xfb, 0xdf, 0xe4, 0x48, 0x61, 0xc1, 0x79, 0xc7, 0x90, 0xbb, 0x66, 0xbc, 0x92, 0xb6, 0xe1, 0xa0,
+	0xc0, 0xad, 0xac, 0x15, 0xc3, 0xde, 0x5d, 0xb1, 0x4b, 0x6d, 0x1b, 0x0e, 0x46, 0x0a, 0x65, 0x78,
+	0x8e, 0x53, 0x55, 0x50, 0x6f, 0x5b, 0x5c, 0x47, 0x1f, 0x71, 0xaa, 0x96, 0xf6, 0xce, 0xc9, 0xe5,
+	0xcc, 0x65, 0x57, 0x33, 0x97, 0xfd, 0x9d, 0xb9, 0xec, 0xc7, 0xdc, 0x2d, 0x5d, 0xcd, 0xdd, 0xd2,
+	0xef, 0xb9, 0x5b, 0xfa, 0xfa, 0x32, 0x19, 0xa9, 0xe1, 0xa4, 0xef, 0xc7, 0x22, 0x0b, 0x16, 0xa7,
+	0xc8, 0x5e, 0xda, 0x34, 0x38, 0x0b, 0xa6, 0x6b, 0x47, 0x4a, 0xff, 0x60, 0xea, 0x57, 0xcc, 0xe9,
+	0x78, 0xf5, 0x6f, 0x00, 0xb0, 0x3f, 0xc7, 0x57, 0x75, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgConfigs) > 0 {
+		for iNdEx := len(m.MsgConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StoreConfigs) > 0 {
+		for iNdEx := len(m.StoreConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGasschedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintGasschedule(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGasschedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintGasschedule(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGasschedule(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasschedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasschedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreConfigs) > 0 {
+		for _, e := range m.StoreConfigs {
+			l = e.Size()
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	if len(m.MsgConfigs) > 0 {
+		for _, e := range m.MsgConfigs {
+			l = e.Size()
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovGasschedule(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovGasschedule(uint64(l))
+	return n
+}
+
+func (m *MsgGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGasschedule(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovGasschedule(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovGasschedule(uint64(l))
+	return n
+}
+
+func (m *GasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovGasschedule(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovGasschedule(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovGasschedule(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovGasschedule(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func sovGasschedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasschedule(x uint64) (n int) {
+	return sovGasschedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreConfigs = append(m.StoreConfigs, StoreGasConfig{})
+			if err := m.StoreConfigs[len(m.StoreConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgConfigs = append(m.MsgConfigs, MsgGasConfig{})
+			if err := m.MsgConfigs[len(m.MsgConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGasschedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGasschedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGasschedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGasschedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGasschedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGasschedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGasschedule = fmt.Errorf("proto: unexpected end of group")
+)
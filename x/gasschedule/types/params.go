@@ -0,0 +1,128 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(storeConfigs []StoreGasConfig, msgConfigs []MsgGasConfig) Params {
+	return Params{
+		StoreConfigs: storeConfigs,
+		MsgConfigs:   msgConfigs,
+	}
+}
+
+// DefaultParams returns default x/gasschedule module parameters, which charge
+// the default configs of the store types.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate validates the params, the stores and the messages having at most one
+// valid config each.
+func (p Params) Validate() error {
+	storeKeys := make(map[string]bool, len(p.StoreConfigs))
+	for _, sc := range p.StoreConfigs {
+		if strings.TrimSpace(sc.StoreKey) == "" {
+			return errors.New("store key of a store gas config cannot be blank")
+		}
+		if storeKeys[sc.StoreKey] {
+			return fmt.Errorf("duplicate gas config for store %s", sc.StoreKey)
+		}
+		if err := sc.Config.Validate(); err != nil {
+			return fmt.Errorf("invalid gas config for store %s: %w", sc.StoreKey, err)
+		}
+		storeKeys[sc.StoreKey] = true
+	}
+
+	msgStoreKeys := make(map[string]map[string]bool, len(p.MsgConfigs))
+	for _, mc := range p.MsgConfigs {
+		if !strings.HasPrefix(mc.MsgTypeUrl, "/") || len(mc.MsgTypeUrl) == 1 {
+			return fmt.Errorf("invalid msg type url of a msg gas config: %q", mc.MsgTypeUrl)
+		}
+		if msgStoreKeys[mc.MsgTypeUrl] == nil {
+			msgStoreKeys[mc.MsgTypeUrl] = make(map[string]bool)
+		}
+		if msgStoreKeys[mc.MsgTypeUrl][mc.StoreKey] {
+			return fmt.Errorf("duplicate gas config for msg %s and store %q", mc.MsgTypeUrl, mc.StoreKey)
+		}
+		if err := mc.Config.Validate(); err != nil {
+			return fmt.Errorf("invalid gas config for msg %s and store %q: %w", mc.MsgTypeUrl, mc.StoreKey, err)
+		}
+		msgStoreKeys[mc.MsgTypeUrl][mc.StoreKey] = true
+	}
+
+	return nil
+}
+
+// GasSchedule returns the gas schedule of the KVStores defined by the params.
+func (p Params) GasSchedule() storetypes.GasSchedule {
+	var schedule storetypes.GasSchedule
+	if len(p.StoreConfigs) > 0 {
+		schedule.StoreConfigs = make(map[string]storetypes.GasConfig, len(p.StoreConfigs))
+		for _, sc := range p.StoreConfigs {
+			schedule.StoreConfigs[sc.StoreKey] = sc.Config.StoreGasConfig()
+		}
+	}
+	if len(p.MsgConfigs) > 0 {
+		schedule.MsgConfigs = make(map[string]map[string]storetypes.GasConfig)
+		for _, mc := range p.MsgConfigs {
+			if schedule.MsgConfigs[mc.MsgTypeUrl] == nil {
+				schedule.MsgConfigs[mc.MsgTypeUrl] = make(map[string]storetypes.GasConfig)
+			}
+			schedule.MsgConfigs[mc.MsgTypeUrl][mc.StoreKey] = mc.Config.StoreGasConfig()
+		}
+	}
+	return schedule
+}
+
+// NewGasConfig creates a GasConfig from the gas config of a store.
+func NewGasConfig(config storetypes.GasConfig) GasConfig {
+	return GasConfig{
+		HasCost:          config.HasCost,
+		DeleteCost:       config.DeleteCost,
+		ReadCostFlat:     config.ReadCostFlat,
+		ReadCostPerByte:  config.ReadCostPerByte,
+		WriteCostFlat:    config.WriteCostFlat,
+		WriteCostPerByte: config.WriteCostPerByte,
+		IterNextCostFlat: config.IterNextCostFlat,
+	}
+}
+
+// Validate validates the GasConfig, whose flat costs must not be zero so that
+// every store operation is charged, whatever the size of the keys and values.
+func (c GasConfig) Validate() error {
+	for _, cost := range []struct {
+		name  string
+		value uint64
+	}{
+		{"has cost", c.HasCost},
+		{"delete cost", c.DeleteCost},
+		{"read flat cost", c.ReadCostFlat},
+		{"write flat cost", c.WriteCostFlat},
+		{"iterator next flat cost", c.IterNextCostFlat},
+	} {
+		if cost.value == 0 {
+			return fmt.Errorf("%s cannot be zero", cost.name)
+		}
+	}
+
+	return nil
+}
+
+// StoreGasConfig returns the gas config of a store defined by the GasConfig.
+func (c GasConfig) StoreGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          c.HasCost,
+		DeleteCost:       c.DeleteCost,
+		ReadCostFlat:     c.ReadCostFlat,
+		ReadCostPerByte:  c.ReadCostPerByte,
+		WriteCostFlat:    c.WriteCostFlat,
+		WriteCostPerByte: c.WriteCostPerByte,
+		IterNextCostFlat: c.IterNextCostFlat,
+	}
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	config := types.NewGasConfig(storetypes.KVGasConfig())
+	freeReads := config
+	freeReads.ReadCostFlat = 0
+	freeIteration := types.NewGasConfig(storetypes.TransientGasConfig())
+	freeIteration.IterNextCostFlat = 0
+
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{
+			"valid",
+			types.NewParams(
+				[]types.StoreGasConfig{{StoreKey: "bank", Config: config}, {StoreKey: "staking", Config: config}},
+				[]types.MsgGasConfig{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Config: config},
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", StoreKey: "bank", Config: config},
+					{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", StoreKey: "bank", Config: config},
+				},
+			),
+			false,
+		},
+		{"blank store key", types.NewParams([]types.StoreGasConfig{{StoreKey: " ", Config: config}}, nil), true},
+		{"duplicate store", types.NewParams([]types.StoreGasConfig{{StoreKey: "bank", Config: config}, {StoreKey: "bank", Config: config}}, nil), true},
+		{"empty store config", types.NewParams([]types.StoreGasConfig{{StoreKey: "bank"}}, nil), true},
+		{"free store reads", types.NewParams([]types.StoreGasConfig{{StoreKey: "bank", Config: freeReads}}, nil), true},
+		{"blank msg type url", types.NewParams(nil, []types.MsgGasConfig{{StoreKey: "bank", Config: config}}), true},
+		{"invalid msg type url", types.NewParams(nil, []types.MsgGasConfig{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Config: config}}), true},
+		{
+			"duplicate msg and store",
+			types.NewParams(nil, []types.MsgGasConfig{
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", StoreKey: "bank", Config: config},
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", StoreKey: "bank", Config: config},
+			}),
+			true,
+		},
+		{"empty msg config", types.NewParams(nil, []types.MsgGasConfig{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}), true},
+		{"free msg iteration", types.NewParams(nil, []types.MsgGasConfig{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Config: freeIteration}}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
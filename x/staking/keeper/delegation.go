@@ -799,18 +799,31 @@ func (k Keeper) getBeginInfo(
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, error) {
+	completionTime, _, err := k.UndelegateTo(ctx, delAddr, valAddr, sharesAmount, delAddr)
+	return completionTime, err
+}
+
+// UndelegateTo unbonds an amount of delegator shares from a given validator
+// like Undelegate, except that the unbonding delegation is created for the
+// recipient: the unbonding tokens are slashable like the ones of the delegator
+// and are sent to the recipient once mature, while their undelegation is
+// tracked on the delegator. It returns the completion time and the amount of
+// unbonding tokens.
+func (k Keeper) UndelegateTo(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec, recipient sdk.AccAddress,
+) (time.Time, math.Int, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress
+		return time.Time{}, math.Int{}, types.ErrNoDelegatorForAddress
 	}
 
-	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+	if k.HasMaxUnbondingDelegationEntries(ctx, recipient, valAddr) {
+		return time.Time{}, math.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, math.Int{}, err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -819,10 +832,94 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd := k.setRecipientUnbondingEntry(ctx, delAddr, recipient, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return completionTime, returnAmount, nil
+}
+
+// TransferUnbonding transfers up to the given amount of the tokens unbonding
+// from a validator by a delegator to a recipient, by moving the balances of the
+// entries of its unbonding delegation, in order, to entries of the same creation
+// height and completion time of the unbonding delegation of the recipient. It
+// returns the amount transferred, which is lower than the given amount if the
+// delegator unbonds less tokens or if the unbonding delegation of the recipient
+// reaches the maximum number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, delAddr, recipient sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int,
+) math.Int {
+	transferred := math.ZeroInt()
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	for i := 0; i < len(ubd.Entries) && transferred.LT(amount); i++ {
+		entry := ubd.Entries[i]
+		if !entry.Balance.IsPositive() {
+			continue
+		}
+		if k.HasMaxUnbondingDelegationEntries(ctx, recipient, valAddr) {
+			break
+		}
+
+		// the tokens remain the ones of the delegator which unbonded them first
+		delegator := delAddr
+		if entry.OriginalDelegator != "" {
+			delegator = sdk.MustAccAddressFromBech32(entry.OriginalDelegator)
+		}
+
+		balance := sdk.MinInt(entry.Balance, amount.Sub(transferred))
+		recipientUBD := k.setRecipientUnbondingEntry(ctx, delegator, recipient, valAddr, entry.CreationHeight, entry.CompletionTime, balance)
+		k.InsertUBDQueue(ctx, recipientUBD, entry.CompletionTime)
+		transferred = transferred.Add(balance)
+
+		if balance.Equal(entry.Balance) {
+			ubd.RemoveEntry(int64(i))
+			i--
+			continue
+		}
+
+		// the initial balance is reduced as much as the balance, so that the
+		// slashes of the entries add up to the slash of the former entry
+		ubd.Entries[i].Balance = entry.Balance.Sub(balance)
+		ubd.Entries[i].InitialBalance = entry.InitialBalance.Sub(balance)
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred
+}
+
+// setRecipientUnbondingEntry adds an entry for the tokens unbonding from a
+// validator by a delegator to the unbonding delegation of a recipient, and
+// records the delegator on the entry if it is not the recipient.
+func (k Keeper) setRecipientUnbondingEntry(
+	ctx sdk.Context, delAddr, recipient sdk.AccAddress, valAddr sdk.ValAddress,
+	creationHeight int64, minTime time.Time, balance math.Int,
+) types.UnbondingDelegation {
+	entry := types.NewUnbondingDelegationEntry(creationHeight, minTime, balance)
+	if !delAddr.Equals(recipient) {
+		entry.OriginalDelegator = delAddr.String()
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, recipient, valAddr)
+	if !found {
+		ubd = types.UnbondingDelegation{
+			DelegatorAddress: recipient.String(),
+			ValidatorAddress: valAddr.String(),
+		}
+	}
+	ubd.Entries = append(ubd.Entries, entry)
+	k.SetUnbondingDelegation(ctx, ubd)
+
+	return ubd
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
@@ -853,9 +950,24 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
 				amt := sdk.NewCoin(bondDenom, entry.Balance)
-				if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
-					ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(amt),
-				); err != nil {
+				if entry.OriginalDelegator == "" {
+					err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(
+						ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(amt),
+					)
+				} else {
+					// the undelegation is tracked on the delegator which
+					// unbonded the tokens, and the tokens are sent to the
+					// delegator of the unbonding delegation
+					var originalDelegator sdk.AccAddress
+					originalDelegator, err = sdk.AccAddressFromBech32(entry.OriginalDelegator)
+					if err != nil {
+						return nil, err
+					}
+					err = k.bankKeeper.UndelegateCoinsFromModuleToRecipient(
+						ctx, types.NotBondedPoolName, originalDelegator, delegatorAddress, sdk.NewCoins(amt),
+					)
+				}
+				if err != nil {
 					return nil, err
 				}
 
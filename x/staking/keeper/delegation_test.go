@@ -317,6 +317,105 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	require.True(math.IntEq(t, newNotBonded, oldNotBonded.AddRaw(1)))
 }
 
+func TestUndelegateTo(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	addrVals := simtestutil.ConvertAddrsToValAddrs(addrDels)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	unbondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	completionTime, amount, err := app.StakingKeeper.UndelegateTo(ctx, addrDels[0], addrVals[0], sdk.NewDecFromInt(unbondTokens), addrDels[1])
+	require.NoError(t, err)
+	require.Equal(t, unbondTokens, amount)
+
+	// the unbonding delegation is the one of the recipient
+	_, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens, ubd.Entries[0].Balance)
+	require.Equal(t, addrDels[0].String(), ubd.Entries[0].OriginalDelegator)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(unbondTokens), delegation.Shares.RoundInt())
+
+	// the recipient receives the tokens once mature
+	oldBalance := app.BankKeeper.GetBalance(ctx, addrDels[1], bondDenom).Amount
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = app.StakingKeeper.CompleteUnbonding(ctx, addrDels[1], addrVals[0])
+	require.NoError(t, err)
+	require.Equal(t, oldBalance.Add(unbondTokens), app.BankKeeper.GetBalance(ctx, addrDels[1], bondDenom).Amount)
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	addrVals := simtestutil.ConvertAddrsToValAddrs(addrDels)
+
+	// no tokens are transferred without unbonding delegation
+	require.True(t, app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(10)).IsZero())
+
+	time1 := time.Unix(100, 0).UTC()
+	time2 := time.Unix(200, 0).UTC()
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], addrVals[0], 1, time1, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], addrVals[0], 2, time2, sdk.NewInt(20))
+
+	// the first entry is transferred entirely and the second partially
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(15))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, []types.UnbondingDelegationEntry{types.NewUnbondingDelegationEntry(2, time2, sdk.NewInt(15))}, ubd.Entries)
+
+	// the entries of the recipient record the delegator which unbonded the tokens
+	transferredEntry := func(creationHeight int64, completionTime time.Time, balance math.Int) types.UnbondingDelegationEntry {
+		entry := types.NewUnbondingDelegationEntry(creationHeight, completionTime, balance)
+		entry.OriginalDelegator = addrDels[0].String()
+		return entry
+	}
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, []types.UnbondingDelegationEntry{
+		transferredEntry(1, time1, sdk.NewInt(10)),
+		transferredEntry(2, time2, sdk.NewInt(5)),
+	}, ubd.Entries)
+	require.Equal(t, []types.DVPair{
+		{DelegatorAddress: addrDels[1].String(), ValidatorAddress: addrVals[0].String()},
+	}, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, time2))
+
+	// the unbonding delegation is removed once all its tokens are transferred
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(15), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	// the tokens transferred back to the delegator which unbonded them are its own
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[1], addrDels[0], addrVals[0], sdk.NewInt(10))
+	require.Equal(t, sdk.NewInt(10), transferred)
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, []types.UnbondingDelegationEntry{types.NewUnbondingDelegationEntry(1, time1, sdk.NewInt(10))}, ubd.Entries)
+}
+
 // // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // // shift it from the bonded to unbonding state and jailed
 func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
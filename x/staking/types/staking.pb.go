@@ -683,6 +683,10 @@ type UnbondingDelegationEntry struct {
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance"`
 	// balance defines the tokens to receive at completion.
 	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// original_delegator is the bech32-encoded address of the delegator whose
+	// tokens are unbonding when they were undelegated or transferred to the
+	// delegator of the unbonding delegation, and is empty otherwise.
+	OriginalDelegator string `protobuf:"bytes,5,opt,name=original_delegator,json=originalDelegator,proto3" json:"original_delegator,omitempty"`
 }
 
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
@@ -731,6 +735,13 @@ func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *UnbondingDelegationEntry) GetOriginalDelegator() string {
+	if m != nil {
+		return m.OriginalDelegator
+	}
+	return ""
+}
+
 // RedelegationEntry defines a redelegation object with relevant metadata.
 type RedelegationEntry struct {
 	// creation_height  defines the height which the redelegation took place.
@@ -1142,112 +1153,113 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
+	// 1688 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
+	0x15, 0xe6, 0x52, 0x34, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
+	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x13, 0xab, 0x4e, 0x5c,
 	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
+	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1c, 0x73, 0x0c,
+	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x28, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
+	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x44, 0xfd, 0x58, 0x2a, 0x58, 0x20, 0x80, 0x2f, 0x36, 0x67,
+	0xe6, 0xbd, 0x6f, 0xde, 0xfb, 0xde, 0x8f, 0xde, 0x2c, 0xbc, 0xe4, 0x04, 0xdc, 0x0f, 0xf8, 0x3a,
+	0x17, 0xf8, 0x80, 0xb2, 0xe1, 0xfa, 0xfd, 0x9b, 0x03, 0x22, 0xf0, 0xcd, 0x68, 0xdd, 0x1c, 0x85,
+	0x81, 0x08, 0xd0, 0x8b, 0x5a, 0xaa, 0x19, 0xed, 0x1a, 0xa9, 0xca, 0xda, 0x30, 0x18, 0x06, 0x4a,
+	0x64, 0x5d, 0xfe, 0xd2, 0xd2, 0x95, 0x6b, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x5d, 0xad, 0x06, 0xe3,
+	0xbd, 0x75, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
 	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x15, 0x84,
+	0xb9, 0x24, 0xf4, 0x29, 0x13, 0xeb, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb3,
+	0x60, 0xf9, 0x36, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x6d, 0xb6, 0x17, 0xa0, 0xd7, 0x21, 0xbf,
+	0x4f, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x17, 0x37, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
+	0x6f, 0xab, 0xf3, 0x56, 0xee, 0x93, 0xa3, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0xf7, 0x21, 0x7f, 0x1f,
+	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0x3e, 0x77, 0xbd, 0xb8, 0xf1, 0xf5, 0xe6, 0xe9, 0xf4, 0x35, 0x77,
+	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0x3e, 0xca, 0x42, 0x69, 0x2b, 0xf0, 0x7d,
+	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd0,
+	0xfa, 0x9e, 0x94, 0xff, 0xcb, 0x51, 0xed, 0xe5, 0x21, 0x15, 0xfb, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
+	0x43, 0x86, 0xf9, 0xef, 0x06, 0x77, 0x0f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xf6, 0xf1, 0x0d, 0x30,
+	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x47, 0x50, 0xf0, 0xf1, 0xa1, 0xad, 0x50, 0xb3, 0x33,
+	0x40, 0x9d, 0xf7, 0xf1, 0xa1, 0xb4, 0x15, 0xb9, 0x50, 0x92, 0xc0, 0xce, 0x3e, 0x66, 0x43, 0xa2,
+	0xf1, 0xe7, 0x66, 0x80, 0xbf, 0xe4, 0xe3, 0xc3, 0x2d, 0x85, 0x29, 0x6f, 0xd9, 0x2c, 0xbc, 0xff,
+	0xa8, 0x96, 0xf9, 0xc7, 0xa3, 0x9a, 0xd5, 0xf8, 0x83, 0x05, 0x90, 0xd0, 0x85, 0x7e, 0x06, 0x2b,
+	0x4e, 0xbc, 0x52, 0xd7, 0x73, 0x13, 0xc0, 0x57, 0xce, 0x0a, 0xc4, 0x14, 0xd9, 0xad, 0x82, 0x34,
+	0xf4, 0xf1, 0x51, 0xcd, 0xea, 0x95, 0x9c, 0xa9, 0x38, 0x74, 0xa0, 0x38, 0x1e, 0xb9, 0x58, 0x10,
+	0x5b, 0xa6, 0xa6, 0x22, 0xae, 0xb8, 0x51, 0x69, 0xea, 0xbc, 0x6d, 0x46, 0x79, 0xdb, 0xdc, 0x89,
+	0xf2, 0x56, 0x63, 0xbd, 0xf7, 0xb7, 0x9a, 0xd5, 0x03, 0xad, 0x28, 0x8f, 0x52, 0xd6, 0x7f, 0x64,
+	0x41, 0xb1, 0x4d, 0xb8, 0x13, 0xd2, 0x91, 0x2c, 0x04, 0x54, 0x86, 0x79, 0x3f, 0x60, 0xf4, 0xc0,
+	0xa4, 0xdd, 0x42, 0x2f, 0x5a, 0xa2, 0x0a, 0x14, 0xa8, 0x4b, 0x98, 0xa0, 0x62, 0xa2, 0x03, 0xd6,
+	0x8b, 0xd7, 0x52, 0xeb, 0x97, 0x64, 0xc0, 0x69, 0xc4, 0x75, 0x2f, 0x5a, 0xa2, 0x57, 0x61, 0x85,
+	0x13, 0x67, 0x1c, 0x52, 0x31, 0xb1, 0x9d, 0x80, 0x09, 0xec, 0x88, 0x72, 0x4e, 0x89, 0x94, 0xa2,
+	0xfd, 0x2d, 0xbd, 0x2d, 0x41, 0x5c, 0x22, 0x30, 0xf5, 0x78, 0xf9, 0x8a, 0x06, 0x31, 0xcb, 0x94,
+	0xb9, 0x7f, 0xca, 0xc3, 0x42, 0x9c, 0xb7, 0x68, 0x0b, 0x56, 0x82, 0x11, 0x09, 0xe5, 0x6f, 0x1b,
+	0xbb, 0x6e, 0x48, 0x38, 0x37, 0x19, 0x5a, 0xfe, 0xec, 0xe3, 0x1b, 0x6b, 0x86, 0xee, 0x5b, 0xfa,
+	0xa4, 0x2f, 0x42, 0xca, 0x86, 0xbd, 0x52, 0xa4, 0x61, 0xb6, 0xd1, 0x8f, 0x65, 0xc0, 0x18, 0x27,
+	0x8c, 0x8f, 0xb9, 0x3d, 0x1a, 0x0f, 0x0e, 0xc8, 0xc4, 0xf0, 0xba, 0x76, 0x82, 0xd7, 0x5b, 0x6c,
+	0xd2, 0x2a, 0x7f, 0x9a, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x43, 0x26,
+	0xbd, 0x52, 0x8c, 0xd3, 0x55, 0x30, 0xe8, 0x45, 0xc8, 0xff, 0x02, 0x53, 0x8f, 0xb8, 0x8a, 0x95,
+	0x42, 0xcf, 0xac, 0xd0, 0x26, 0xe4, 0xb9, 0xc0, 0x62, 0xcc, 0x15, 0x15, 0xcb, 0x1b, 0x8d, 0xb3,
+	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x40, 0x5e, 0x04, 0x07, 0x84,
+	0x19, 0x92, 0x2e, 0x95, 0xd5, 0xdb, 0x4c, 0xa4, 0xb2, 0x7a, 0x9b, 0x89, 0x9e, 0xc1, 0x42, 0x43,
+	0x58, 0x71, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0xbe, 0x8f, 0x43, 0xc2, 0xcb, 0xf9, 0x19, 0x54, 0x4d,
+	0x29, 0x46, 0xed, 0x2b, 0x50, 0x74, 0x07, 0x8a, 0x6e, 0x92, 0x6e, 0xe5, 0x79, 0x45, 0xf4, 0x37,
+	0xce, 0xf2, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
+	0x65, 0x43, 0x7b, 0x9f, 0xd0, 0xe1, 0xbe, 0x28, 0x17, 0xea, 0xd6, 0xf5, 0xb9, 0x5e, 0x29, 0xde,
+	0xbf, 0xad, 0xb6, 0xd1, 0x1d, 0x58, 0x4e, 0x44, 0x55, 0xed, 0x2c, 0x5c, 0xa2, 0x76, 0x96, 0x62,
+	0x5d, 0x79, 0x8a, 0x6e, 0x03, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0x78, 0x76, 0x75, 0x1b, 0x17,
+	0x52, 0xba, 0xc8, 0x83, 0xab, 0x3e, 0x65, 0x36, 0x27, 0xde, 0x9e, 0x6d, 0xa8, 0x92, 0x90, 0xc5,
+	0x19, 0x84, 0x76, 0xd5, 0xa7, 0xac, 0x4f, 0xbc, 0xbd, 0x76, 0x0c, 0xbb, 0xb9, 0xf8, 0xee, 0xa3,
+	0x5a, 0xc6, 0xd4, 0x52, 0xa6, 0xd1, 0x85, 0xc5, 0x5d, 0xec, 0x99, 0x32, 0x20, 0x1c, 0xbd, 0x0e,
+	0x0b, 0x38, 0x5a, 0x94, 0xad, 0xfa, 0xdc, 0xb9, 0x65, 0x94, 0x88, 0xea, 0xea, 0xfc, 0xcd, 0x5f,
+	0xeb, 0x56, 0xe3, 0x43, 0x0b, 0xf2, 0xed, 0xdd, 0x2e, 0xa6, 0x21, 0xea, 0xc0, 0x6a, 0x92, 0x50,
+	0x17, 0xad, 0xcd, 0x24, 0x07, 0xa3, 0xe2, 0xec, 0xc0, 0xea, 0xfd, 0xa8, 0xdc, 0x63, 0x98, 0xec,
+	0xb3, 0x60, 0x62, 0x15, 0xb3, 0x3f, 0xe5, 0x78, 0x07, 0xe6, 0xb5, 0x95, 0x1c, 0x6d, 0xc2, 0x95,
+	0x91, 0xfc, 0xa1, 0xfc, 0x2d, 0x6e, 0x54, 0xcf, 0x4c, 0x44, 0x25, 0x6f, 0x02, 0xa8, 0x55, 0x1a,
+	0xff, 0xb6, 0x00, 0xda, 0xbb, 0xbb, 0x3b, 0x21, 0x1d, 0x79, 0x44, 0xcc, 0xca, 0xe3, 0xb7, 0xe0,
+	0x85, 0xc4, 0x63, 0x1e, 0x3a, 0x17, 0xf6, 0xfa, 0x6a, 0xac, 0xd6, 0x0f, 0x9d, 0x53, 0xd1, 0x5c,
+	0x2e, 0x62, 0xb4, 0xb9, 0x0b, 0xa3, 0xb5, 0xb9, 0x38, 0x9d, 0xc6, 0x3e, 0x14, 0x13, 0xf7, 0x39,
+	0x6a, 0x43, 0x41, 0x98, 0xdf, 0x86, 0xcd, 0xc6, 0xd9, 0x6c, 0x46, 0x6a, 0x86, 0xd1, 0x58, 0xb3,
+	0xf1, 0x1f, 0x49, 0x6a, 0x9c, 0xb1, 0x5f, 0xae, 0x34, 0x92, 0xbd, 0xd7, 0xf4, 0xc6, 0x59, 0x4c,
+	0x14, 0x06, 0x6b, 0x8a, 0xd5, 0xdf, 0x66, 0xe1, 0xea, 0xbd, 0xa8, 0xdb, 0x7c, 0x69, 0x99, 0xe8,
+	0xc2, 0x3c, 0x61, 0x22, 0xa4, 0x8a, 0x0a, 0x19, 0xeb, 0x6f, 0x9f, 0x15, 0xeb, 0x53, 0x7c, 0xe9,
+	0x30, 0x11, 0x4e, 0x4c, 0xe4, 0x23, 0x98, 0x29, 0x16, 0x3e, 0x9c, 0x83, 0xf2, 0x59, 0x9a, 0xe8,
+	0x15, 0x28, 0x39, 0x21, 0x51, 0x1b, 0x51, 0xd7, 0xb7, 0x54, 0xd7, 0x5f, 0x8e, 0xb6, 0x4d, 0xd3,
+	0x7f, 0x1b, 0xe4, 0x00, 0x25, 0x13, 0x4b, 0x8a, 0x5e, 0x7a, 0x62, 0x5a, 0x4e, 0x94, 0xe5, 0x31,
+	0x22, 0x50, 0xa2, 0x8c, 0x0a, 0x8a, 0x3d, 0x7b, 0x80, 0x3d, 0xcc, 0x9c, 0xff, 0x65, 0xb2, 0x3c,
+	0xd9, 0xa8, 0x97, 0x0d, 0x68, 0x4b, 0x63, 0xa2, 0x5d, 0x98, 0x8f, 0xe0, 0x73, 0x33, 0x80, 0x8f,
+	0xc0, 0xd0, 0x9b, 0x80, 0x82, 0x90, 0x0e, 0x29, 0xc3, 0x9e, 0x1d, 0xe7, 0x45, 0xf9, 0xca, 0x33,
+	0x62, 0xbf, 0x1a, 0xe9, 0xb4, 0x23, 0x95, 0xd4, 0x38, 0xf6, 0x79, 0x16, 0x56, 0x7b, 0xc4, 0x7d,
+	0xbe, 0xe2, 0xf3, 0x53, 0x00, 0x5d, 0xb9, 0xb2, 0xa1, 0x96, 0x73, 0x33, 0xe8, 0x04, 0x0b, 0x1a,
+	0xaf, 0xcd, 0x45, 0x8a, 0xdb, 0x4f, 0xb3, 0xb0, 0x98, 0xe6, 0xf6, 0x39, 0xf8, 0x03, 0x83, 0xb6,
+	0x93, 0xb6, 0x92, 0x53, 0x6d, 0xe5, 0xd5, 0xb3, 0xda, 0xca, 0x89, 0xac, 0x3b, 0xbf, 0x9f, 0x7c,
+	0x91, 0x85, 0x7c, 0x17, 0x87, 0xd8, 0xe7, 0xe8, 0x07, 0x27, 0x26, 0x41, 0xfd, 0x3c, 0xbb, 0x76,
+	0x22, 0xe7, 0xda, 0xe6, 0xeb, 0x80, 0x4e, 0xb9, 0xf7, 0x4f, 0x19, 0x04, 0xbf, 0x09, 0xcb, 0xf2,
+	0xad, 0x19, 0xbb, 0xa2, 0x49, 0x5c, 0x52, 0x8f, 0xc5, 0xf8, 0x99, 0xc2, 0x51, 0x0d, 0x8a, 0x52,
+	0x2c, 0xe9, 0x98, 0x52, 0x06, 0x7c, 0x7c, 0xd8, 0xd1, 0x3b, 0xe8, 0x06, 0xa0, 0xfd, 0xf8, 0xf5,
+	0x6f, 0x27, 0x14, 0x48, 0xb9, 0xd5, 0xe4, 0x24, 0x12, 0xff, 0x1a, 0x80, 0xb4, 0xc2, 0x76, 0x09,
+	0x0b, 0x7c, 0xf3, 0x58, 0x5a, 0x90, 0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa5, 0x87, 0xca, 0xa9, 0x67,
+	0xa8, 0x99, 0xe7, 0xdf, 0xba, 0x5c, 0xa6, 0x7e, 0x71, 0x54, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x36,
+	0x4e, 0x81, 0x6c, 0xa8, 0x21, 0xf3, 0xf8, 0xf3, 0x35, 0x95, 0xc1, 0x1f, 0x58, 0x80, 0x92, 0xde,
+	0xdd, 0x23, 0x7c, 0x14, 0x30, 0xae, 0xa6, 0xe7, 0xd4, 0xa8, 0x6b, 0x9d, 0x3f, 0x3d, 0x27, 0xfa,
+	0xd1, 0xf4, 0x9c, 0xaa, 0x88, 0xef, 0x26, 0x9d, 0x32, 0x6b, 0x62, 0x68, 0x60, 0xe4, 0x57, 0x98,
+	0xd4, 0x04, 0x4e, 0x23, 0xed, 0x48, 0x3e, 0xb6, 0x32, 0xd3, 0xf8, 0xdc, 0x82, 0x6b, 0x27, 0xb2,
+	0x29, 0x36, 0xf6, 0xe7, 0x80, 0xc2, 0xd4, 0xa1, 0x8a, 0xcd, 0xc4, 0x18, 0x7d, 0xe9, 0xe4, 0x5c,
+	0x0d, 0xa7, 0x0f, 0xfe, 0x5f, 0xcd, 0x7e, 0x33, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0xd6, 0xd2, 0xc6,
+	0xc4, 0x6e, 0xdd, 0x85, 0xc5, 0xb4, 0x2d, 0xc6, 0xa1, 0x97, 0x2e, 0xe2, 0x90, 0xf1, 0xe5, 0x98,
+	0x3e, 0x7a, 0x27, 0x29, 0x5c, 0xfd, 0xd5, 0xe9, 0xe6, 0x85, 0xb9, 0x89, 0x6c, 0x9a, 0x2e, 0xe0,
+	0x5c, 0x34, 0x0e, 0xe5, 0xba, 0x41, 0xe0, 0xa1, 0x5f, 0xc3, 0x2a, 0x0b, 0x84, 0x2d, 0xb3, 0x9c,
+	0xb8, 0xb6, 0x79, 0x02, 0xeb, 0xee, 0xf7, 0xce, 0xe5, 0x28, 0xfb, 0xe7, 0x51, 0xed, 0x24, 0xd4,
+	0x14, 0x8f, 0x25, 0x16, 0x88, 0x96, 0x3a, 0xdf, 0x51, 0xc7, 0x28, 0x84, 0xa5, 0xe3, 0x57, 0xeb,
+	0x6e, 0xf9, 0xf6, 0xa5, 0xaf, 0x5e, 0x3a, 0xef, 0xda, 0xc5, 0x41, 0xea, 0xce, 0xcd, 0x82, 0x8c,
+	0xe1, 0xbf, 0x1e, 0xd5, 0xac, 0x6f, 0xfd, 0xde, 0x02, 0x48, 0xbe, 0x05, 0xa0, 0xd7, 0xe0, 0x2b,
+	0xad, 0x1f, 0xde, 0x6d, 0xdb, 0xfd, 0x9d, 0x5b, 0x3b, 0xf7, 0xfa, 0xf6, 0xbd, 0xbb, 0xfd, 0x6e,
+	0x67, 0x6b, 0xfb, 0x8d, 0xed, 0x4e, 0x7b, 0x25, 0x53, 0x29, 0x3d, 0x78, 0x58, 0x2f, 0xde, 0x63,
+	0x7c, 0x44, 0x1c, 0xba, 0x47, 0x89, 0x8b, 0x5e, 0x86, 0xb5, 0xe3, 0xd2, 0x72, 0xd5, 0x69, 0xaf,
+	0x58, 0x95, 0xc5, 0x07, 0x0f, 0xeb, 0x05, 0x3d, 0x66, 0x11, 0x17, 0x5d, 0x87, 0x17, 0x4e, 0xca,
+	0x6d, 0xdf, 0x7d, 0x73, 0x25, 0x5b, 0x59, 0x7a, 0xf0, 0xb0, 0xbe, 0x10, 0xcf, 0x63, 0xa8, 0x01,
+	0x28, 0x2d, 0x69, 0xf0, 0xe6, 0x2a, 0xf0, 0xe0, 0x61, 0x3d, 0xaf, 0x69, 0xab, 0xe4, 0xde, 0xfd,
+	0xa0, 0x9a, 0x69, 0xbd, 0xf1, 0xc9, 0x93, 0xaa, 0xf5, 0xf8, 0x49, 0xd5, 0xfa, 0xfb, 0x93, 0xaa,
+	0xf5, 0xde, 0xd3, 0x6a, 0xe6, 0xf1, 0xd3, 0x6a, 0xe6, 0xcf, 0x4f, 0xab, 0x99, 0x9f, 0xbc, 0x76,
+	0x2e, 0x63, 0x87, 0xf1, 0x27, 0x61, 0xc5, 0xdd, 0x20, 0xaf, 0x9a, 0xf2, 0x77, 0xfe, 0x3b, 0x00,
+	0xaf, 0x3e, 0x1b, 0x13, 0x31, 0x16, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,476 +1268,476 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7499 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbc, 0x6b, 0x70, 0x24, 0xd7,
-		0x75, 0x1f, 0x8e, 0x79, 0x60, 0x30, 0x73, 0x66, 0x30, 0x68, 0x5c, 0x60, 0x77, 0x67, 0x41, 0x12,
-		0x00, 0x87, 0x8f, 0x5d, 0xbe, 0xb0, 0xe4, 0x92, 0xbb, 0xcb, 0x9d, 0xb5, 0xc4, 0xff, 0xbc, 0x16,
-		0xc4, 0x2e, 0x1e, 0xc3, 0x1e, 0x60, 0xf9, 0xf0, 0xdf, 0xe9, 0x6a, 0xf4, 0x5c, 0x0c, 0x9a, 0xe8,
-		0xe9, 0x6e, 0x77, 0xf7, 0xec, 0x2e, 0x58, 0x4e, 0x8a, 0x2e, 0xe5, 0x21, 0x6d, 0x2a, 0x8e, 0x1c,
-		0xa7, 0x62, 0x59, 0xd6, 0x2a, 0x94, 0xe5, 0x44, 0x8e, 0xa2, 0x3c, 0x6c, 0x29, 0x4a, 0x1c, 0x57,
-		0x12, 0x25, 0xa9, 0x24, 0xb2, 0x3e, 0xa4, 0x64, 0x7f, 0x88, 0xed, 0x3c, 0x18, 0x87, 0x52, 0x25,
-		0x8c, 0xa2, 0xc4, 0x8e, 0xc2, 0x54, 0x25, 0xa5, 0x52, 0x2a, 0x75, 0x5f, 0xfd, 0x98, 0x07, 0x66,
-		0xb0, 0x59, 0xca, 0xae, 0xf2, 0x27, 0x4c, 0x9f, 0x7b, 0x7e, 0xbf, 0x3e, 0xf7, 0xdc, 0x73, 0xcf,
-		0x3d, 0xf7, 0x76, 0x37, 0xe0, 0x9f, 0x5e, 0x81, 0xe5, 0xb6, 0x65, 0xb5, 0x0d, 0x7c, 0xce, 0x76,
-		0x2c, 0xcf, 0xda, 0xed, 0xee, 0x9d, 0x6b, 0x61, 0x57, 0x73, 0x74, 0xdb, 0xb3, 0x9c, 0x15, 0x2a,
-		0x43, 0x33, 0x4c, 0x63, 0x45, 0x68, 0x14, 0x37, 0x60, 0xf6, 0xaa, 0x6e, 0xe0, 0x9a, 0xaf, 0xd8,
-		0xc4, 0x1e, 0x7a, 0x11, 0x92, 0x7b, 0xba, 0x81, 0x0b, 0xb1, 0xe5, 0xc4, 0xd9, 0xec, 0xf9, 0x47,
-		0x57, 0x7a, 0x40, 0x2b, 0x51, 0x44, 0x83, 0x88, 0x65, 0x8a, 0x28, 0x7e, 0x3b, 0x09, 0x73, 0x03,
-		0x5a, 0x11, 0x82, 0xa4, 0xa9, 0x76, 0x08, 0x63, 0xec, 0x6c, 0x46, 0xa6, 0xbf, 0x51, 0x01, 0xa6,
-		0x6c, 0x55, 0x3b, 0x50, 0xdb, 0xb8, 0x10, 0xa7, 0x62, 0x71, 0x89, 0x16, 0x01, 0x5a, 0xd8, 0xc6,
-		0x66, 0x0b, 0x9b, 0xda, 0x61, 0x21, 0xb1, 0x9c, 0x38, 0x9b, 0x91, 0x43, 0x12, 0xf4, 0x14, 0xcc,
-		0xda, 0xdd, 0x5d, 0x43, 0xd7, 0x94, 0x90, 0x1a, 0x2c, 0x27, 0xce, 0x4e, 0xca, 0x12, 0x6b, 0xa8,
-		0x05, 0xca, 0x67, 0x60, 0xe6, 0x16, 0x56, 0x0f, 0xc2, 0xaa, 0x59, 0xaa, 0x9a, 0x27, 0xe2, 0x90,
-		0x62, 0x15, 0x72, 0x1d, 0xec, 0xba, 0x6a, 0x1b, 0x2b, 0xde, 0xa1, 0x8d, 0x0b, 0x49, 0xda, 0xfb,
-		0xe5, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xcb, 0x51, 0xdb, 0x87, 0x36, 0x46, 0x65, 0xc8, 0x60, 0xb3,
-		0xdb, 0x61, 0x0c, 0x93, 0x43, 0xfc, 0x57, 0x37, 0xbb, 0x9d, 0x5e, 0x96, 0x34, 0x81, 0x71, 0x8a,
-		0x29, 0x17, 0x3b, 0x37, 0x75, 0x0d, 0x17, 0x52, 0x94, 0xe0, 0x4c, 0x1f, 0x41, 0x93, 0xb5, 0xf7,
-		0x72, 0x08, 0x1c, 0xaa, 0x42, 0x06, 0xdf, 0xf6, 0xb0, 0xe9, 0xea, 0x96, 0x59, 0x98, 0xa2, 0x24,
-		0x8f, 0x0d, 0x18, 0x45, 0x6c, 0xb4, 0x7a, 0x29, 0x02, 0x1c, 0xba, 0x08, 0x53, 0x96, 0xed, 0xe9,
-		0x96, 0xe9, 0x16, 0xd2, 0xcb, 0xb1, 0xb3, 0xd9, 0xf3, 0x0f, 0x0e, 0x0c, 0x84, 0x2d, 0xa6, 0x23,
-		0x0b, 0x65, 0xb4, 0x06, 0x92, 0x6b, 0x75, 0x1d, 0x0d, 0x2b, 0x9a, 0xd5, 0xc2, 0x8a, 0x6e, 0xee,
-		0x59, 0x85, 0x0c, 0x25, 0x58, 0xea, 0xef, 0x08, 0x55, 0xac, 0x5a, 0x2d, 0xbc, 0x66, 0xee, 0x59,
-		0x72, 0xde, 0x8d, 0x5c, 0xa3, 0x93, 0x90, 0x72, 0x0f, 0x4d, 0x4f, 0xbd, 0x5d, 0xc8, 0xd1, 0x08,
-		0xe1, 0x57, 0xc5, 0x5f, 0x4d, 0xc1, 0xcc, 0x38, 0x21, 0x76, 0x05, 0x26, 0xf7, 0x48, 0x2f, 0x0b,
-		0xf1, 0xe3, 0xf8, 0x80, 0x61, 0xa2, 0x4e, 0x4c, 0xdd, 0xa3, 0x13, 0xcb, 0x90, 0x35, 0xb1, 0xeb,
-		0xe1, 0x16, 0x8b, 0x88, 0xc4, 0x98, 0x31, 0x05, 0x0c, 0xd4, 0x1f, 0x52, 0xc9, 0x7b, 0x0a, 0xa9,
-		0xd7, 0x60, 0xc6, 0x37, 0x49, 0x71, 0x54, 0xb3, 0x2d, 0x62, 0xf3, 0xdc, 0x28, 0x4b, 0x56, 0xea,
-		0x02, 0x27, 0x13, 0x98, 0x9c, 0xc7, 0x91, 0x6b, 0x54, 0x03, 0xb0, 0x4c, 0x6c, 0xed, 0x29, 0x2d,
-		0xac, 0x19, 0x85, 0xf4, 0x10, 0x2f, 0x6d, 0x11, 0x95, 0x3e, 0x2f, 0x59, 0x4c, 0xaa, 0x19, 0xe8,
-		0x72, 0x10, 0x6a, 0x53, 0x43, 0x22, 0x65, 0x83, 0x4d, 0xb2, 0xbe, 0x68, 0xdb, 0x81, 0xbc, 0x83,
-		0x49, 0xdc, 0xe3, 0x16, 0xef, 0x59, 0x86, 0x1a, 0xb1, 0x32, 0xb2, 0x67, 0x32, 0x87, 0xb1, 0x8e,
-		0x4d, 0x3b, 0xe1, 0x4b, 0xf4, 0x08, 0xf8, 0x02, 0x85, 0x86, 0x15, 0xd0, 0x2c, 0x94, 0x13, 0xc2,
-		0x4d, 0xb5, 0x83, 0x17, 0xde, 0x82, 0x7c, 0xd4, 0x3d, 0x68, 0x1e, 0x26, 0x5d, 0x4f, 0x75, 0x3c,
-		0x1a, 0x85, 0x93, 0x32, 0xbb, 0x40, 0x12, 0x24, 0xb0, 0xd9, 0xa2, 0x59, 0x6e, 0x52, 0x26, 0x3f,
-		0xd1, 0xff, 0x17, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd,
-		0x70, 0x09, 0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x13, 0x03, 0xa9, 0xd1, 0x6b,
-		0x30, 0xdf, 0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x34,
-		0x35, 0x24, 0xe6, 0x76, 0xc2, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf,
-		0x9f, 0x92, 0xde, 0x7e, 0xfb, 0xed, 0xb7, 0xe3, 0xc5, 0x7f, 0x9c, 0x82, 0xf9, 0x41, 0x73, 0x66,
-		0xe0, 0xf4, 0x3d, 0x09, 0x29, 0xb3, 0xdb, 0xd9, 0xc5, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50,
-		0x19, 0x26, 0x0d, 0x75, 0x17, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x6c, 0xfe, 0xfc, 0x53, 0x63, 0xcd,
-		0xca, 0x95, 0x75, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7,
-		0x63, 0x20, 0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73,
-		0x9a, 0x08, 0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49,
-		0x60, 0xb5, 0xf0, 0x9e, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c,
-		0xe3, 0xc2, 0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d,
-		0x27, 0x65, 0x36, 0xd1, 0xd6, 0x88, 0x84, 0xdc, 0xfe, 0x4d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b,
-		0x10, 0x01, 0xbd, 0xfd, 0xa5, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x33, 0x30,
-		0x43, 0x35, 0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xd9, 0xb4, 0x9c, 0x67, 0xe2,
-		0x2d, 0x2e, 0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xfd, 0x7a, 0xa3, 0xae,
-		0xd4, 0xb6, 0x76, 0x2a, 0xeb, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x75, 0x7d, 0xab, 0xbc,
-		0x2d, 0xc5, 0xfd, 0xeb, 0xb5, 0xcd, 0xed, 0x8b, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xc3, 0x04, 0xc9,
-		0xb0, 0xc2, 0xf3, 0xe7, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbd, 0x56, 0xaf, 0x5d, 0x7c,
-		0x41, 0x4a, 0x45, 0x25, 0xcf, 0x9f, 0x97, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb5, 0xb5,
-		0x2e, 0xa5, 0x7d, 0xce, 0xe6, 0xb6, 0xbc, 0xb6, 0xb9, 0x2a, 0x65, 0x7c, 0xce, 0x55, 0x79, 0x6b,
-		0xa7, 0x21, 0x81, 0xcf, 0xb0, 0x51, 0x6f, 0x36, 0xcb, 0xab, 0x75, 0x29, 0xeb, 0x6b, 0x54, 0x5e,
-		0xdf, 0xae, 0x37, 0xa5, 0x5c, 0xc4, 0xac, 0xe7, 0xcf, 0x4b, 0xd3, 0xfe, 0x2d, 0xea, 0x9b, 0x3b,
-		0x1b, 0x52, 0x1e, 0xcd, 0xc2, 0x34, 0xbb, 0x85, 0x30, 0x62, 0xa6, 0x47, 0x74, 0xf1, 0x05, 0x49,
-		0x0a, 0x0c, 0x61, 0x2c, 0xb3, 0x11, 0xc1, 0xc5, 0x17, 0x24, 0x54, 0xac, 0xc2, 0x24, 0x0d, 0x43,
-		0x84, 0x20, 0xbf, 0x5e, 0xae, 0xd4, 0xd7, 0x95, 0xad, 0xc6, 0xf6, 0xda, 0xd6, 0x66, 0x79, 0x5d,
-		0x8a, 0x05, 0x32, 0xb9, 0xfe, 0xca, 0xce, 0x9a, 0x5c, 0xaf, 0x49, 0xf1, 0xb0, 0xac, 0x51, 0x2f,
-		0x6f, 0xd7, 0x6b, 0x52, 0xa2, 0xa8, 0xc1, 0xfc, 0xa0, 0x84, 0x3a, 0x70, 0x0a, 0x85, 0x62, 0x21,
-		0x3e, 0x24, 0x16, 0x28, 0x57, 0x6f, 0x2c, 0x14, 0xbf, 0x15, 0x87, 0xb9, 0x01, 0x8b, 0xca, 0xc0,
-		0x9b, 0xbc, 0x04, 0x93, 0x2c, 0x96, 0xd9, 0x32, 0xfb, 0xc4, 0xc0, 0xd5, 0x89, 0x46, 0x76, 0xdf,
-		0x52, 0x4b, 0x71, 0xe1, 0x52, 0x23, 0x31, 0xa4, 0xd4, 0x20, 0x14, 0x7d, 0x01, 0xfb, 0x63, 0x7d,
-		0xc9, 0x9f, 0xad, 0x8f, 0x17, 0xc7, 0x59, 0x1f, 0xa9, 0xec, 0x78, 0x8b, 0xc0, 0xe4, 0x80, 0x45,
-		0xe0, 0x0a, 0xcc, 0xf6, 0x11, 0x8d, 0x9d, 0x8c, 0x3f, 0x16, 0x83, 0xc2, 0x30, 0xe7, 0x8c, 0x48,
-		0x89, 0xf1, 0x48, 0x4a, 0xbc, 0xd2, 0xeb, 0xc1, 0x87, 0x87, 0x0f, 0x42, 0xdf, 0x58, 0x7f, 0x21,
-		0x06, 0x27, 0x07, 0x97, 0x94, 0x03, 0x6d, 0xf8, 0x28, 0xa4, 0x3a, 0xd8, 0xdb, 0xb7, 0x44, 0x59,
-		0xf5, 0xf8, 0x80, 0xc5, 0x9a, 0x34, 0xf7, 0x0e, 0x36, 0x47, 0x85, 0x57, 0xfb, 0xc4, 0xb0, 0xba,
-		0x90, 0x59, 0xd3, 0x67, 0xe9, 0x27, 0xe2, 0x70, 0x62, 0x20, 0xf9, 0x40, 0x43, 0x1f, 0x02, 0xd0,
-		0x4d, 0xbb, 0xeb, 0xb1, 0xd2, 0x89, 0x65, 0xe2, 0x0c, 0x95, 0xd0, 0xe4, 0x45, 0xb2, 0x6c, 0xd7,
-		0xf3, 0xdb, 0x13, 0xb4, 0x1d, 0x98, 0x88, 0x2a, 0xbc, 0x18, 0x18, 0x9a, 0xa4, 0x86, 0x2e, 0x0e,
-		0xe9, 0x69, 0x5f, 0x60, 0x3e, 0x0b, 0x92, 0x66, 0xe8, 0xd8, 0xf4, 0x14, 0xd7, 0x73, 0xb0, 0xda,
-		0xd1, 0xcd, 0x36, 0x5d, 0x6a, 0xd2, 0xa5, 0xc9, 0x3d, 0xd5, 0x70, 0xb1, 0x3c, 0xc3, 0x9a, 0x9b,
-		0xa2, 0x95, 0x20, 0x68, 0x00, 0x39, 0x21, 0x44, 0x2a, 0x82, 0x60, 0xcd, 0x3e, 0xa2, 0xf8, 0xd3,
-		0x19, 0xc8, 0x86, 0x0a, 0x70, 0xf4, 0x30, 0xe4, 0xde, 0x54, 0x6f, 0xaa, 0x8a, 0xd8, 0x54, 0x31,
-		0x4f, 0x64, 0x89, 0xac, 0xc1, 0x37, 0x56, 0xcf, 0xc2, 0x3c, 0x55, 0xb1, 0xba, 0x1e, 0x76, 0x14,
-		0xcd, 0x50, 0x5d, 0x97, 0x3a, 0x2d, 0x4d, 0x55, 0x11, 0x69, 0xdb, 0x22, 0x4d, 0x55, 0xd1, 0x82,
-		0x2e, 0xc0, 0x1c, 0x45, 0x74, 0xba, 0x86, 0xa7, 0xdb, 0x06, 0x56, 0xc8, 0x36, 0xcf, 0xa5, 0x4b,
-		0x8e, 0x6f, 0xd9, 0x2c, 0xd1, 0xd8, 0xe0, 0x0a, 0xc4, 0x22, 0x17, 0xd5, 0xe0, 0x21, 0x0a, 0x6b,
-		0x63, 0x13, 0x3b, 0xaa, 0x87, 0x15, 0xfc, 0xe3, 0x5d, 0xd5, 0x70, 0x15, 0xd5, 0x6c, 0x29, 0xfb,
-		0xaa, 0xbb, 0x5f, 0x98, 0x27, 0x04, 0x95, 0x78, 0x21, 0x26, 0x9f, 0x26, 0x8a, 0xab, 0x5c, 0xaf,
-		0x4e, 0xd5, 0xca, 0x66, 0xeb, 0x65, 0xd5, 0xdd, 0x47, 0x25, 0x38, 0x49, 0x59, 0x5c, 0xcf, 0xd1,
-		0xcd, 0xb6, 0xa2, 0xed, 0x63, 0xed, 0x40, 0xe9, 0x7a, 0x7b, 0x2f, 0x16, 0x1e, 0x08, 0xdf, 0x9f,
-		0x5a, 0xd8, 0xa4, 0x3a, 0x55, 0xa2, 0xb2, 0xe3, 0xed, 0xbd, 0x88, 0x9a, 0x90, 0x23, 0x83, 0xd1,
-		0xd1, 0xdf, 0xc2, 0xca, 0x9e, 0xe5, 0xd0, 0x35, 0x34, 0x3f, 0x20, 0x35, 0x85, 0x3c, 0xb8, 0xb2,
-		0xc5, 0x01, 0x1b, 0x56, 0x0b, 0x97, 0x26, 0x9b, 0x8d, 0x7a, 0xbd, 0x26, 0x67, 0x05, 0xcb, 0x55,
-		0xcb, 0x21, 0x01, 0xd5, 0xb6, 0x7c, 0x07, 0x67, 0x59, 0x40, 0xb5, 0x2d, 0xe1, 0xde, 0x0b, 0x30,
-		0xa7, 0x69, 0xac, 0xcf, 0xba, 0xa6, 0xf0, 0xcd, 0x98, 0x5b, 0x90, 0x22, 0xce, 0xd2, 0xb4, 0x55,
-		0xa6, 0xc0, 0x63, 0xdc, 0x45, 0x97, 0xe1, 0x44, 0xe0, 0xac, 0x30, 0x70, 0xb6, 0xaf, 0x97, 0xbd,
-		0xd0, 0x0b, 0x30, 0x67, 0x1f, 0xf6, 0x03, 0x51, 0xe4, 0x8e, 0xf6, 0x61, 0x2f, 0xec, 0x12, 0xcc,
-		0xdb, 0xfb, 0x76, 0x3f, 0xee, 0xc9, 0x30, 0x0e, 0xd9, 0xfb, 0x76, 0x2f, 0xf0, 0x31, 0xba, 0x33,
-		0x77, 0xb0, 0xa6, 0x7a, 0xb8, 0x55, 0x38, 0x15, 0x56, 0x0f, 0x35, 0xa0, 0x15, 0x90, 0x34, 0x4d,
-		0xc1, 0xa6, 0xba, 0x6b, 0x60, 0x45, 0x75, 0xb0, 0xa9, 0xba, 0x85, 0x25, 0xaa, 0x9c, 0xf4, 0x9c,
-		0x2e, 0x96, 0xf3, 0x9a, 0x56, 0xa7, 0x8d, 0x65, 0xda, 0x86, 0x9e, 0x84, 0x59, 0x6b, 0xf7, 0x4d,
-		0x8d, 0x45, 0xa4, 0x62, 0x3b, 0x78, 0x4f, 0xbf, 0x5d, 0x78, 0x94, 0xba, 0x77, 0x86, 0x34, 0xd0,
-		0x78, 0x6c, 0x50, 0x31, 0x7a, 0x02, 0x24, 0xcd, 0xdd, 0x57, 0x1d, 0x9b, 0xa6, 0x64, 0xd7, 0x56,
-		0x35, 0x5c, 0x78, 0x8c, 0xa9, 0x32, 0xf9, 0xa6, 0x10, 0x93, 0x19, 0xe1, 0xde, 0xd2, 0xf7, 0x3c,
-		0xc1, 0x78, 0x86, 0xcd, 0x08, 0x2a, 0xe3, 0x6c, 0x67, 0x41, 0x22, 0x9e, 0x88, 0xdc, 0xf8, 0x2c,
-		0x55, 0xcb, 0xdb, 0xfb, 0x76, 0xf8, 0xbe, 0x8f, 0xc0, 0x34, 0xd1, 0x0c, 0x6e, 0xfa, 0x04, 0x2b,
-		0xdc, 0xec, 0xfd, 0xd0, 0x1d, 0x5f, 0x80, 0x93, 0x44, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a, 0x6a,
-		0x48, 0xfb, 0x69, 0xaa, 0x4d, 0xdc, 0xbe, 0xc1, 0x1b, 0x23, 0x76, 0x3a, 0xdd, 0xdd, 0x43, 0x3f,
-		0xb0, 0x9e, 0x61, 0x76, 0x12, 0x99, 0x08, 0xad, 0x0f, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17, 0x8e,
-		0x7b, 0x94, 0x01, 0x16, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xad, 0x1a, 0x29, 0x5f, 0xde, 0xa8,
-		0x4b, 0x71, 0x52, 0x46, 0xad, 0xaf, 0x6d, 0xd7, 0x15, 0x79, 0x67, 0x73, 0x7b, 0x6d, 0xa3, 0x2e,
-		0x25, 0x42, 0x85, 0xfd, 0xb5, 0x64, 0xfa, 0x71, 0xe9, 0x0c, 0xa9, 0x1a, 0xf2, 0xd1, 0x9d, 0x1a,
-		0xfa, 0x11, 0x38, 0x25, 0x8e, 0x55, 0x5c, 0xec, 0x29, 0xb7, 0x74, 0x87, 0x4e, 0xc8, 0x8e, 0xca,
-		0x16, 0x47, 0x3f, 0x7e, 0xe6, 0xb9, 0x56, 0x13, 0x7b, 0xaf, 0xea, 0x0e, 0x99, 0x6e, 0x1d, 0xd5,
-		0x43, 0xeb, 0xb0, 0x64, 0x5a, 0x8a, 0xeb, 0xa9, 0x66, 0x4b, 0x75, 0x5a, 0x4a, 0x70, 0xa0, 0xa5,
-		0xa8, 0x9a, 0x86, 0x5d, 0xd7, 0x62, 0x0b, 0xa1, 0xcf, 0xf2, 0xa0, 0x69, 0x35, 0xb9, 0x72, 0xb0,
-		0x42, 0x94, 0xb9, 0x6a, 0x4f, 0xf8, 0x26, 0x86, 0x85, 0xef, 0x03, 0x90, 0xe9, 0xa8, 0xb6, 0x82,
-		0x4d, 0xcf, 0x39, 0xa4, 0xf5, 0x79, 0x5a, 0x4e, 0x77, 0x54, 0xbb, 0x4e, 0xae, 0x7f, 0x28, 0xdb,
-		0xa4, 0x6b, 0xc9, 0x74, 0x52, 0x9a, 0xbc, 0x96, 0x4c, 0x4f, 0x4a, 0xa9, 0x6b, 0xc9, 0x74, 0x4a,
-		0x9a, 0xba, 0x96, 0x4c, 0xa7, 0xa5, 0xcc, 0xb5, 0x64, 0x3a, 0x23, 0x41, 0xf1, 0xbd, 0x04, 0xe4,
-		0xc2, 0x15, 0x3c, 0xd9, 0x10, 0x69, 0x74, 0x0d, 0x8b, 0xd1, 0x2c, 0xf7, 0xc8, 0x91, 0xf5, 0xfe,
-		0x4a, 0x95, 0x2c, 0x6e, 0xa5, 0x14, 0x2b, 0x97, 0x65, 0x86, 0x24, 0x85, 0x05, 0x09, 0x3f, 0xcc,
-		0xca, 0x93, 0xb4, 0xcc, 0xaf, 0xd0, 0x2a, 0xa4, 0xde, 0x74, 0x29, 0x77, 0x8a, 0x72, 0x3f, 0x7a,
-		0x34, 0xf7, 0xb5, 0x26, 0x25, 0xcf, 0x5c, 0x6b, 0x2a, 0x9b, 0x5b, 0xf2, 0x46, 0x79, 0x5d, 0xe6,
-		0x70, 0x74, 0x1a, 0x92, 0x86, 0xfa, 0xd6, 0x61, 0x74, 0x19, 0xa4, 0xa2, 0x71, 0x87, 0xe5, 0x34,
-		0x24, 0x6f, 0x61, 0xf5, 0x20, 0xba, 0xf8, 0x50, 0xd1, 0x87, 0x38, 0x3d, 0xce, 0xc1, 0x24, 0xf5,
-		0x17, 0x02, 0xe0, 0x1e, 0x93, 0x26, 0x50, 0x1a, 0x92, 0xd5, 0x2d, 0x99, 0x4c, 0x11, 0x09, 0x72,
-		0x4c, 0xaa, 0x34, 0xd6, 0xea, 0xd5, 0xba, 0x14, 0x2f, 0x5e, 0x80, 0x14, 0x73, 0x02, 0x99, 0x3e,
-		0xbe, 0x1b, 0xa4, 0x09, 0x7e, 0xc9, 0x39, 0x62, 0xa2, 0x75, 0x67, 0xa3, 0x52, 0x97, 0xa5, 0x78,
-		0xdf, 0xe0, 0x17, 0x5d, 0xc8, 0x85, 0x2b, 0xf3, 0x1f, 0xce, 0xf6, 0xfc, 0x6b, 0x31, 0xc8, 0x86,
-		0x2a, 0x6d, 0x52, 0x22, 0xa9, 0x86, 0x61, 0xdd, 0x52, 0x54, 0x43, 0x57, 0x5d, 0x1e, 0x1a, 0x40,
-		0x45, 0x65, 0x22, 0x19, 0x77, 0xe8, 0x7e, 0x48, 0x93, 0x66, 0x52, 0x4a, 0x15, 0x3f, 0x1b, 0x03,
-		0xa9, 0xb7, 0xd4, 0xed, 0x31, 0x33, 0xf6, 0x07, 0x69, 0x66, 0xf1, 0x33, 0x31, 0xc8, 0x47, 0xeb,
-		0xdb, 0x1e, 0xf3, 0x1e, 0xfe, 0x03, 0x35, 0xef, 0x77, 0xe3, 0x30, 0x1d, 0xa9, 0x6a, 0xc7, 0xb5,
-		0xee, 0xc7, 0x61, 0x56, 0x6f, 0xe1, 0x8e, 0x6d, 0x79, 0xd8, 0xd4, 0x0e, 0x15, 0x03, 0xdf, 0xc4,
-		0x46, 0xa1, 0x48, 0x93, 0xc6, 0xb9, 0xa3, 0xeb, 0xe6, 0x95, 0xb5, 0x00, 0xb7, 0x4e, 0x60, 0xa5,
-		0xb9, 0xb5, 0x5a, 0x7d, 0xa3, 0xb1, 0xb5, 0x5d, 0xdf, 0xac, 0xbe, 0xae, 0xec, 0x6c, 0x5e, 0xdf,
-		0xdc, 0x7a, 0x75, 0x53, 0x96, 0xf4, 0x1e, 0xb5, 0x0f, 0x71, 0xda, 0x37, 0x40, 0xea, 0x35, 0x0a,
-		0x9d, 0x82, 0x41, 0x66, 0x49, 0x13, 0x68, 0x0e, 0x66, 0x36, 0xb7, 0x94, 0xe6, 0x5a, 0xad, 0xae,
-		0xd4, 0xaf, 0x5e, 0xad, 0x57, 0xb7, 0x9b, 0xec, 0x24, 0xc4, 0xd7, 0xde, 0x8e, 0x4c, 0xf0, 0xe2,
-		0xa7, 0x13, 0x30, 0x37, 0xc0, 0x12, 0x54, 0xe6, 0x7b, 0x18, 0xb6, 0xad, 0x7a, 0x66, 0x1c, 0xeb,
-		0x57, 0x48, 0x15, 0xd1, 0x50, 0x1d, 0x8f, 0x6f, 0x79, 0x9e, 0x00, 0xe2, 0x25, 0xd3, 0xd3, 0xf7,
-		0x74, 0xec, 0xf0, 0x13, 0x26, 0xb6, 0xb1, 0x99, 0x09, 0xe4, 0xec, 0x90, 0xe9, 0x69, 0x40, 0xb6,
-		0xe5, 0xea, 0x9e, 0x7e, 0x13, 0x2b, 0xba, 0x29, 0x8e, 0xa3, 0xc8, 0x46, 0x27, 0x29, 0x4b, 0xa2,
-		0x65, 0xcd, 0xf4, 0x7c, 0x6d, 0x13, 0xb7, 0xd5, 0x1e, 0x6d, 0x92, 0xcc, 0x13, 0xb2, 0x24, 0x5a,
-		0x7c, 0xed, 0x87, 0x21, 0xd7, 0xb2, 0xba, 0xa4, 0xfa, 0x63, 0x7a, 0x64, 0xed, 0x88, 0xc9, 0x59,
-		0x26, 0xf3, 0x55, 0x78, 0x5d, 0x1f, 0x9c, 0x83, 0xe5, 0xe4, 0x2c, 0x93, 0x31, 0x95, 0x33, 0x30,
-		0xa3, 0xb6, 0xdb, 0x0e, 0x21, 0x17, 0x44, 0x6c, 0xa7, 0x92, 0xf7, 0xc5, 0x54, 0x71, 0xe1, 0x1a,
-		0xa4, 0x85, 0x1f, 0xc8, 0xe2, 0x4d, 0x3c, 0xa1, 0xd8, 0x6c, 0xfb, 0x1d, 0x3f, 0x9b, 0x91, 0xd3,
-		0xa6, 0x68, 0x7c, 0x18, 0x72, 0xba, 0xab, 0x04, 0xc7, 0xfa, 0xf1, 0xe5, 0xf8, 0xd9, 0xb4, 0x9c,
-		0xd5, 0x5d, 0xff, 0x48, 0xb4, 0xf8, 0x85, 0x38, 0xe4, 0xa3, 0x8f, 0x25, 0x50, 0x0d, 0xd2, 0x86,
-		0xa5, 0xa9, 0x34, 0xb4, 0xd8, 0x33, 0xb1, 0xb3, 0x23, 0x9e, 0x64, 0xac, 0xac, 0x73, 0x7d, 0xd9,
-		0x47, 0x2e, 0xfc, 0xcb, 0x18, 0xa4, 0x85, 0x18, 0x9d, 0x84, 0xa4, 0xad, 0x7a, 0xfb, 0x94, 0x6e,
-		0xb2, 0x12, 0x97, 0x62, 0x32, 0xbd, 0x26, 0x72, 0xd7, 0x56, 0x4d, 0x1a, 0x02, 0x5c, 0x4e, 0xae,
-		0xc9, 0xb8, 0x1a, 0x58, 0x6d, 0xd1, 0x6d, 0x90, 0xd5, 0xe9, 0x60, 0xd3, 0x73, 0xc5, 0xb8, 0x72,
-		0x79, 0x95, 0x8b, 0xd1, 0x53, 0x30, 0xeb, 0x39, 0xaa, 0x6e, 0x44, 0x74, 0x93, 0x54, 0x57, 0x12,
-		0x0d, 0xbe, 0x72, 0x09, 0x4e, 0x0b, 0xde, 0x16, 0xf6, 0x54, 0x6d, 0x1f, 0xb7, 0x02, 0x50, 0x8a,
-		0x1e, 0x77, 0x9c, 0xe2, 0x0a, 0x35, 0xde, 0x2e, 0xb0, 0xc5, 0xdf, 0x88, 0xc1, 0xac, 0xd8, 0xb8,
-		0xb5, 0x7c, 0x67, 0x6d, 0x00, 0xa8, 0xa6, 0x69, 0x79, 0x61, 0x77, 0xf5, 0x87, 0x72, 0x1f, 0x6e,
-		0xa5, 0xec, 0x83, 0xe4, 0x10, 0xc1, 0x42, 0x07, 0x20, 0x68, 0x19, 0xea, 0xb6, 0x25, 0xc8, 0xf2,
-		0x67, 0x4e, 0xf4, 0xc1, 0x25, 0xdb, 0xea, 0x03, 0x13, 0x91, 0x1d, 0x1e, 0x9a, 0x87, 0xc9, 0x5d,
-		0xdc, 0xd6, 0x4d, 0x7e, 0x92, 0xcc, 0x2e, 0xc4, 0x81, 0x4c, 0xd2, 0x3f, 0x90, 0xa9, 0xfc, 0x09,
-		0x98, 0xd3, 0xac, 0x4e, 0xaf, 0xb9, 0x15, 0xa9, 0xe7, 0xb8, 0xc1, 0x7d, 0x39, 0xf6, 0xc6, 0x33,
-		0x5c, 0xa9, 0x6d, 0x19, 0xaa, 0xd9, 0x5e, 0xb1, 0x9c, 0x76, 0xf0, 0xe0, 0x95, 0x54, 0x3c, 0x6e,
-		0xe8, 0xf1, 0xab, 0xbd, 0xfb, 0xbf, 0x62, 0xb1, 0x5f, 0x88, 0x27, 0x56, 0x1b, 0x95, 0x2f, 0xc6,
-		0x17, 0x56, 0x19, 0xb0, 0x21, 0x9c, 0x21, 0xe3, 0x3d, 0x03, 0x6b, 0xa4, 0x83, 0xf0, 0x9d, 0xa7,
-		0x60, 0xbe, 0x6d, 0xb5, 0x2d, 0xca, 0x74, 0x8e, 0xfc, 0xe2, 0x4f, 0x6e, 0x33, 0xbe, 0x74, 0x61,
-		0xe4, 0x63, 0xde, 0xd2, 0x26, 0xcc, 0x71, 0x65, 0x85, 0x3e, 0x3a, 0x62, 0x1b, 0x1b, 0x74, 0xe4,
-		0xa9, 0x5a, 0xe1, 0x97, 0xbf, 0x4d, 0x97, 0x6f, 0x79, 0x96, 0x43, 0x49, 0x1b, 0xdb, 0xfb, 0x94,
-		0x64, 0x38, 0x11, 0xe1, 0x63, 0x93, 0x14, 0x3b, 0x23, 0x18, 0xff, 0x19, 0x67, 0x9c, 0x0b, 0x31,
-		0x36, 0x39, 0xb4, 0x54, 0x85, 0xe9, 0xe3, 0x70, 0xfd, 0x73, 0xce, 0x95, 0xc3, 0x61, 0x92, 0x55,
-		0x98, 0xa1, 0x24, 0x5a, 0xd7, 0xf5, 0xac, 0x0e, 0xcd, 0x80, 0x47, 0xd3, 0xfc, 0x8b, 0x6f, 0xb3,
-		0x59, 0x93, 0x27, 0xb0, 0xaa, 0x8f, 0x2a, 0x95, 0x80, 0x3e, 0x2d, 0x6b, 0x61, 0xcd, 0x18, 0xc1,
-		0xf0, 0x75, 0x6e, 0x88, 0xaf, 0x5f, 0xba, 0x01, 0xf3, 0xe4, 0x37, 0x4d, 0x50, 0x61, 0x4b, 0x46,
-		0x1f, 0xc1, 0x15, 0x7e, 0xe3, 0x63, 0x6c, 0x62, 0xce, 0xf9, 0x04, 0x21, 0x9b, 0x42, 0xa3, 0xd8,
-		0xc6, 0x9e, 0x87, 0x1d, 0x57, 0x51, 0x8d, 0x41, 0xe6, 0x85, 0xce, 0x30, 0x0a, 0x3f, 0xf7, 0xdd,
-		0xe8, 0x28, 0xae, 0x32, 0x64, 0xd9, 0x30, 0x4a, 0x3b, 0x70, 0x6a, 0x40, 0x54, 0x8c, 0xc1, 0xf9,
-		0x69, 0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f,
-		0xcf, 0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2d, 0x97,
-		0x9f, 0x1b, 0x8d, 0x41, 0xf7, 0x19, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x86,
-		0xf4, 0x9e, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5,
-		0x2d, 0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x96, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a,
-		0x64, 0x01, 0x1b, 0x4d, 0xf1, 0x97, 0x05, 0x85, 0xc0, 0x70, 0x8a, 0x63, 0xb8, 0xf5, 0x1d, 0x41,
-		0xe1, 0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb4, 0xcc, 0x71, 0x8c, 0xf8, 0x1c, 0x67,
-		0x00, 0x0e, 0x21, 0x04, 0x57, 0x20, 0x33, 0xee, 0x40, 0xfc, 0x95, 0xef, 0x8a, 0xe9, 0x21, 0x46,
-		0x60, 0x15, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xca, 0x29, 0xf2, 0x21, 0x18,
-		0xef, 0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee,
-		0x62, 0x53, 0xdb, 0x1f, 0x8f, 0xe1, 0x97, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3,
-		0x3a, 0xee, 0xbe, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x8d, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7,
-		0x3c, 0x0e, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x87,
-		0xed, 0xaf, 0x8b, 0xa9, 0xc7, 0xb0, 0x1b, 0x61, 0xc6, 0x2b, 0x90, 0x71, 0xf5, 0xb7, 0xc6, 0xa2,
-		0xf9, 0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe1, 0xf4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf,
-		0xc1, 0xc9, 0x4e, 0x0e, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2e, 0xe5, 0xdf, 0x14, 0x29, 0x01, 0xf7,
-		0x70, 0x35, 0xc8, 0xae, 0xc1, 0x55, 0xf7, 0x8e, 0xe7, 0xb5, 0xbf, 0x25, 0xbc, 0xc6, 0xb0, 0x11,
-		0xaf, 0x6d, 0xc3, 0x49, 0xce, 0x78, 0xbc, 0x71, 0xfd, 0xdb, 0x22, 0xb1, 0x32, 0xf4, 0x4e, 0x74,
-		0x74, 0x7f, 0x14, 0x16, 0x7c, 0x77, 0x8a, 0xf2, 0xd4, 0x55, 0x3a, 0xaa, 0x3d, 0x06, 0xf3, 0x2f,
-		0x73, 0x66, 0x91, 0xf1, 0xfd, 0xfa, 0xd6, 0xdd, 0x50, 0x6d, 0x42, 0xfe, 0x1a, 0x14, 0x04, 0x79,
-		0xd7, 0x74, 0xb0, 0x66, 0xb5, 0x4d, 0xfd, 0x2d, 0xdc, 0x1a, 0x83, 0xfa, 0x57, 0x7a, 0x86, 0x6a,
-		0x27, 0x04, 0x27, 0xcc, 0x6b, 0x20, 0xf9, 0xb5, 0x8a, 0xa2, 0x77, 0x6c, 0xcb, 0xf1, 0x46, 0x30,
-		0x7e, 0x59, 0x8c, 0x94, 0x8f, 0x5b, 0xa3, 0xb0, 0x52, 0x1d, 0xd8, 0x93, 0xe7, 0x71, 0x43, 0xf2,
-		0x2b, 0x9c, 0x68, 0x3a, 0x40, 0xf1, 0xc4, 0xa1, 0x59, 0x1d, 0x5b, 0x75, 0xc6, 0xc9, 0x7f, 0x7f,
-		0x47, 0x24, 0x0e, 0x0e, 0xe1, 0x89, 0x83, 0x54, 0x74, 0x64, 0xb5, 0x1f, 0x83, 0xe1, 0xab, 0x22,
-		0x71, 0x08, 0x0c, 0xa7, 0x10, 0x05, 0xc3, 0x18, 0x14, 0x7f, 0x57, 0x50, 0x08, 0x0c, 0xa1, 0x78,
-		0x25, 0x58, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xd1, 0x54, 0x7f, 0xef, 0xbb,
-		0xd1, 0x22, 0x4c, 0x0e, 0x41, 0x49, 0x26, 0xe2, 0xc7, 0xae, 0x74, 0xcf, 0x34, 0xda, 0xb0, 0x5f,
-		0x15, 0x99, 0x28, 0x04, 0x23, 0xb6, 0x85, 0x2a, 0x44, 0xe2, 0x76, 0x8d, 0xec, 0x14, 0xc6, 0xa0,
-		0xfb, 0xfb, 0x3d, 0xc6, 0x35, 0x05, 0x96, 0x70, 0x86, 0xea, 0x9f, 0xae, 0x79, 0x80, 0x0f, 0xc7,
-		0x8a, 0xce, 0x5f, 0xeb, 0xa9, 0x7f, 0x76, 0x18, 0x92, 0xe5, 0x90, 0x99, 0x9e, 0x7a, 0x0a, 0x8d,
-		0x7a, 0xcf, 0xa8, 0xf0, 0x93, 0x1f, 0xf0, 0xfe, 0x46, 0xcb, 0xa9, 0xd2, 0x3a, 0x09, 0xf2, 0x68,
-		0xd1, 0x33, 0x9a, 0xec, 0x63, 0x1f, 0xf8, 0x71, 0x1e, 0xa9, 0x79, 0x4a, 0x57, 0x61, 0x3a, 0x52,
-		0xf0, 0x8c, 0xa6, 0xfa, 0x93, 0x9c, 0x2a, 0x17, 0xae, 0x77, 0x4a, 0x17, 0x20, 0x49, 0x8a, 0x97,
-		0xd1, 0xf0, 0x3f, 0xc5, 0xe1, 0x54, 0xbd, 0xf4, 0x11, 0x48, 0x8b, 0xa2, 0x65, 0x34, 0xf4, 0x4f,
-		0x73, 0xa8, 0x0f, 0x21, 0x70, 0x51, 0xb0, 0x8c, 0x86, 0xff, 0x19, 0x01, 0x17, 0x10, 0x02, 0x1f,
-		0xdf, 0x85, 0x5f, 0xfb, 0xb3, 0x49, 0xbe, 0xe8, 0x08, 0xdf, 0x5d, 0x81, 0x29, 0x5e, 0xa9, 0x8c,
-		0x46, 0x7f, 0x82, 0xdf, 0x5c, 0x20, 0x4a, 0x97, 0x60, 0x72, 0x4c, 0x87, 0xff, 0x39, 0x0e, 0x65,
-		0xfa, 0xa5, 0x2a, 0x64, 0x43, 0xd5, 0xc9, 0x68, 0xf8, 0x4f, 0x71, 0x78, 0x18, 0x45, 0x4c, 0xe7,
-		0xd5, 0xc9, 0x68, 0x82, 0x3f, 0x2f, 0x4c, 0xe7, 0x08, 0xe2, 0x36, 0x51, 0x98, 0x8c, 0x46, 0x7f,
-		0x52, 0x78, 0x5d, 0x40, 0x4a, 0x2f, 0x41, 0xc6, 0x5f, 0x6c, 0x46, 0xe3, 0x7f, 0x9a, 0xe3, 0x03,
-		0x0c, 0xf1, 0x40, 0x68, 0xb1, 0x1b, 0x4d, 0xf1, 0x17, 0x84, 0x07, 0x42, 0x28, 0x32, 0x8d, 0x7a,
-		0x0b, 0x98, 0xd1, 0x4c, 0x3f, 0x23, 0xa6, 0x51, 0x4f, 0xfd, 0x42, 0x46, 0x93, 0xe6, 0xfc, 0xd1,
-		0x14, 0x7f, 0x51, 0x8c, 0x26, 0xd5, 0x27, 0x66, 0xf4, 0x56, 0x04, 0xa3, 0x39, 0x7e, 0x56, 0x98,
-		0xd1, 0x53, 0x10, 0x94, 0x1a, 0x80, 0xfa, 0xab, 0x81, 0xd1, 0x7c, 0x9f, 0xe2, 0x7c, 0xb3, 0x7d,
-		0xc5, 0x40, 0xe9, 0x55, 0x38, 0x39, 0xb8, 0x12, 0x18, 0xcd, 0xfa, 0x73, 0x1f, 0xf4, 0xec, 0xdd,
-		0xc2, 0x85, 0x40, 0x69, 0x3b, 0x58, 0x52, 0xc2, 0x55, 0xc0, 0x68, 0xda, 0x4f, 0x7f, 0x10, 0x4d,
-		0xdc, 0xe1, 0x22, 0xa0, 0x54, 0x06, 0x08, 0x16, 0xe0, 0xd1, 0x5c, 0x9f, 0xe1, 0x5c, 0x21, 0x10,
-		0x99, 0x1a, 0x7c, 0xfd, 0x1d, 0x8d, 0xbf, 0x2b, 0xa6, 0x06, 0x47, 0x90, 0xa9, 0x21, 0x96, 0xde,
-		0xd1, 0xe8, 0xcf, 0x8a, 0xa9, 0x21, 0x20, 0x24, 0xb2, 0x43, 0xab, 0xdb, 0x68, 0x86, 0xcf, 0x89,
-		0xc8, 0x0e, 0xa1, 0x4a, 0x9b, 0x30, 0xdb, 0xb7, 0x20, 0x8e, 0xa6, 0xfa, 0x05, 0x4e, 0x25, 0xf5,
-		0xae, 0x87, 0xe1, 0xc5, 0x8b, 0x2f, 0x86, 0xa3, 0xd9, 0x3e, 0xdf, 0xb3, 0x78, 0xf1, 0xb5, 0xb0,
-		0x74, 0x05, 0xd2, 0x66, 0xd7, 0x30, 0xc8, 0xe4, 0x41, 0x47, 0xbf, 0x1b, 0x58, 0xf8, 0xcf, 0xdf,
-		0xe7, 0xde, 0x11, 0x80, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x5d, 0xdc, 0x1a, 0x85, 0xfc, 0xce, 0xf7,
-		0x45, 0xc2, 0x24, 0xda, 0xa5, 0x97, 0x00, 0xd8, 0xd1, 0x08, 0x7d, 0x18, 0x38, 0x02, 0xfb, 0x5f,
-		0xbe, 0xcf, 0x5f, 0xc6, 0x09, 0x20, 0x01, 0x01, 0x7b, 0xb5, 0xe7, 0x68, 0x82, 0xef, 0x46, 0x09,
-		0xe8, 0x88, 0x5c, 0x86, 0xa9, 0x37, 0x5d, 0xcb, 0xf4, 0xd4, 0xf6, 0x28, 0xf4, 0x7f, 0xe5, 0x68,
-		0xa1, 0x4f, 0x1c, 0xd6, 0xb1, 0x1c, 0xec, 0xa9, 0x6d, 0x77, 0x14, 0xf6, 0xbf, 0x71, 0xac, 0x0f,
-		0x20, 0x60, 0x4d, 0x75, 0xbd, 0x71, 0xfa, 0xfd, 0x7b, 0x02, 0x2c, 0x00, 0xc4, 0x68, 0xf2, 0xfb,
-		0x00, 0x1f, 0x8e, 0xc2, 0xfe, 0xbe, 0x30, 0x9a, 0xeb, 0x97, 0x3e, 0x02, 0x19, 0xf2, 0x93, 0xbd,
-		0x61, 0x37, 0x02, 0xfc, 0xdf, 0x39, 0x38, 0x40, 0x90, 0x3b, 0xbb, 0x5e, 0xcb, 0xd3, 0x47, 0x3b,
-		0xfb, 0x7b, 0x7c, 0xa4, 0x85, 0x7e, 0xa9, 0x0c, 0x59, 0xd7, 0x6b, 0xb5, 0xba, 0xbc, 0x3e, 0x1d,
-		0x01, 0xff, 0x1f, 0xdf, 0xf7, 0x8f, 0x2c, 0x7c, 0x0c, 0x19, 0xed, 0x5b, 0x07, 0x9e, 0x6d, 0xd1,
-		0x07, 0x1e, 0xa3, 0x18, 0x3e, 0xe0, 0x0c, 0x21, 0x48, 0xa9, 0x0a, 0x39, 0xd2, 0x17, 0x07, 0xdb,
-		0x98, 0x3e, 0x9d, 0x1a, 0x41, 0xf1, 0x3f, 0xb9, 0x03, 0x22, 0xa0, 0xca, 0x8f, 0x7d, 0xfd, 0xbd,
-		0xc5, 0xd8, 0x37, 0xdf, 0x5b, 0x8c, 0xfd, 0xee, 0x7b, 0x8b, 0xb1, 0x4f, 0x7e, 0x6b, 0x71, 0xe2,
-		0x9b, 0xdf, 0x5a, 0x9c, 0xf8, 0xed, 0x6f, 0x2d, 0x4e, 0x0c, 0x3e, 0x25, 0x86, 0x55, 0x6b, 0xd5,
-		0x62, 0xe7, 0xc3, 0x6f, 0x14, 0xdb, 0xba, 0xb7, 0xdf, 0xdd, 0x5d, 0xd1, 0xac, 0x0e, 0x3d, 0xc6,
-		0x0d, 0x4e, 0x6b, 0xfd, 0x4d, 0x0e, 0xfc, 0x20, 0x46, 0x36, 0xcc, 0xd1, 0xb3, 0x5c, 0xd5, 0x3c,
-		0x1c, 0xf6, 0xad, 0xce, 0x45, 0x48, 0x94, 0xcd, 0x43, 0x74, 0x9a, 0x65, 0x37, 0xa5, 0xeb, 0x18,
-		0xfc, 0x1d, 0xaf, 0x29, 0x72, 0xbd, 0xe3, 0x18, 0x68, 0x3e, 0x78, 0x11, 0x33, 0x76, 0x36, 0xc7,
-		0xdf, 0xae, 0xac, 0xfc, 0x54, 0xec, 0x78, 0xdd, 0x48, 0x97, 0xcd, 0x43, 0xda, 0x8b, 0x46, 0xec,
-		0x8d, 0xa7, 0x47, 0x1e, 0x72, 0x1f, 0x98, 0xd6, 0x2d, 0x93, 0x98, 0x6d, 0xef, 0x8a, 0x03, 0xee,
-		0xc5, 0xde, 0x03, 0xee, 0x57, 0xb1, 0x61, 0x5c, 0x27, 0x7a, 0xdb, 0x04, 0xb2, 0x9b, 0x62, 0xaf,
-		0x13, 0xc3, 0xcf, 0xc4, 0x61, 0xb1, 0xef, 0x2c, 0x9b, 0x47, 0xc0, 0x30, 0x27, 0x94, 0x20, 0x5d,
-		0x13, 0x81, 0x55, 0x80, 0x29, 0x17, 0x6b, 0x96, 0xd9, 0x72, 0xa9, 0x23, 0x12, 0xb2, 0xb8, 0x24,
-		0x8e, 0x30, 0x55, 0xd3, 0x72, 0xf9, 0x5b, 0x92, 0xec, 0xa2, 0xf2, 0xf3, 0xc7, 0x74, 0xc4, 0xb4,
-		0xb8, 0x93, 0xf0, 0xc6, 0x73, 0x63, 0x7a, 0x43, 0x74, 0x22, 0x72, 0xec, 0x3f, 0xae, 0x57, 0x7e,
-		0x36, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0xd3, 0xca, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x57, 0x20,
-		0xb3, 0x2d, 0x74, 0x8e, 0xed, 0x97, 0xbb, 0xc7, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xf9,
-		0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c, 0xf3, 0xbf, 0x53, 0x70, 0x5a, 0xb3, 0xdc, 0x8e, 0xe5,
-		0x2a, 0xec, 0xf9, 0x08, 0xbb, 0xe0, 0x3e, 0xc9, 0x85, 0x9b, 0x46, 0x3f, 0x24, 0x29, 0x5e, 0x87,
-		0xb9, 0x35, 0x92, 0x2a, 0xc8, 0x16, 0x28, 0x78, 0xbc, 0x33, 0xf0, 0x45, 0xd2, 0xe5, 0x48, 0xb5,
-		0xcf, 0x1f, 0x2f, 0x85, 0x45, 0xc5, 0x9f, 0x8c, 0x81, 0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x5f,
-		0xa9, 0xd0, 0x25, 0x00, 0xfa, 0x01, 0x52, 0xf0, 0xc5, 0x50, 0xfe, 0x7c, 0x61, 0x25, 0xdc, 0xb9,
-		0x15, 0x76, 0x27, 0xfa, 0x39, 0x42, 0x86, 0xea, 0x92, 0x9f, 0x4f, 0xbe, 0x06, 0x10, 0x34, 0xa0,
-		0x07, 0xe0, 0x54, 0xb3, 0x5a, 0x5e, 0x2f, 0xcb, 0x0a, 0x7b, 0xb3, 0x7d, 0xb3, 0xd9, 0xa8, 0x57,
-		0xd7, 0xae, 0xae, 0xd5, 0x6b, 0xd2, 0x04, 0x3a, 0x09, 0x28, 0xdc, 0xe8, 0xbf, 0x94, 0x72, 0x02,
-		0x66, 0xc3, 0x72, 0xf6, 0x7a, 0x7c, 0x9c, 0x94, 0x89, 0x7a, 0xc7, 0x36, 0x30, 0x7d, 0xee, 0xa7,
-		0xe8, 0xc2, 0x6b, 0xa3, 0x2b, 0x90, 0x5f, 0xff, 0x57, 0xec, 0x95, 0xe9, 0xb9, 0x00, 0xee, 0xfb,
-		0xbc, 0xb4, 0x0e, 0xb3, 0xaa, 0xa6, 0x61, 0x3b, 0x42, 0x39, 0x22, 0x4f, 0x13, 0x42, 0xfa, 0x24,
-		0x93, 0x23, 0x03, 0xb6, 0x4b, 0x90, 0x72, 0x69, 0xef, 0x47, 0x51, 0x7c, 0x83, 0x53, 0x70, 0xf5,
-		0x92, 0x09, 0xb3, 0xa4, 0xec, 0x53, 0x1d, 0x1c, 0x32, 0xe3, 0xe8, 0x43, 0x86, 0x7f, 0xf0, 0xe5,
-		0x67, 0xe9, 0x73, 0xcd, 0x87, 0xa3, 0xc3, 0x32, 0x20, 0x9c, 0x64, 0x89, 0x73, 0x07, 0x86, 0x62,
-		0xc8, 0x8b, 0xfb, 0x71, 0x83, 0x8f, 0xbe, 0xd9, 0x3f, 0xe4, 0x37, 0x5b, 0x1c, 0x14, 0x03, 0xa1,
-		0x3b, 0x4d, 0x73, 0x56, 0xd6, 0x50, 0xa9, 0x0f, 0x9b, 0xd3, 0x6f, 0x3c, 0x15, 0x5a, 0x9a, 0x18,
-		0x25, 0xff, 0xf3, 0x0c, 0x65, 0xbe, 0x12, 0xbe, 0x8d, 0x3f, 0xf7, 0x7e, 0x2b, 0x01, 0x8b, 0x5c,
-		0x79, 0x57, 0x75, 0xf1, 0xb9, 0x9b, 0xcf, 0xed, 0x62, 0x4f, 0x7d, 0xee, 0x9c, 0x66, 0xe9, 0x22,
-		0x57, 0xcf, 0xf1, 0xe9, 0x48, 0xda, 0x57, 0x78, 0xfb, 0xc2, 0xc0, 0xa7, 0x99, 0x0b, 0xc3, 0xa7,
-		0x71, 0x71, 0x07, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa, 0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3,
-		0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4, 0xd8, 0xcc, 0xa9, 0x9c, 0xfe, 0xfa, 0xbb,
-		0x4b, 0x13, 0xff, 0xfa, 0xdd, 0xa5, 0xc4, 0x9a, 0xe9, 0xfd, 0xe6, 0x57, 0x9e, 0x01, 0x4e, 0xb5,
-		0x66, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x67, 0x29, 0x56, 0x7c, 0x0d, 0xa6, 0x6a, 0x58,
-		0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac, 0xf5, 0x30, 0x5f, 0x82, 0xf4, 0x9a, 0xe9,
-		0xb1, 0xb7, 0xd0, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x17, 0x1b, 0x8f, 0xb4, 0x8d, 0x68, 0x11, 0x60,
-		0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff, 0xad, 0x89, 0x56, 0xa5, 0xf6, 0xdb, 0xff,
-		0x61, 0x71, 0xe2, 0xed, 0xf7, 0x16, 0x27, 0x86, 0x0e, 0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x03,
-		0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2, 0x1f, 0x27, 0x39, 0x1d, 0xdd, 0xf4, 0xce,
-		0x69, 0xce, 0xa1, 0xed, 0xd1, 0x72, 0xc5, 0xda, 0xe3, 0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6,
-		0xc1, 0xc3, 0x5a, 0xdc, 0x83, 0xc9, 0x06, 0xc1, 0x11, 0x17, 0x7b, 0x96, 0xa7, 0x1a, 0x7c, 0xfd,
-		0x61, 0x17, 0x44, 0xca, 0x3e, 0x68, 0x8a, 0x33, 0xa9, 0x2e, 0xbe, 0x65, 0x32, 0xb0, 0xba, 0xc7,
-		0xde, 0x0b, 0x4f, 0xd0, 0xc2, 0x25, 0x4d, 0x04, 0xf4, 0x15, 0xf0, 0x79, 0x98, 0x54, 0xbb, 0xec,
-		0x05, 0x86, 0x04, 0xa9, 0x68, 0xe8, 0x45, 0xf1, 0x3a, 0x4c, 0xf1, 0xc7, 0xa8, 0x48, 0x82, 0xc4,
-		0x01, 0x3e, 0xa4, 0xf7, 0xc9, 0xc9, 0xe4, 0x27, 0x5a, 0x81, 0x49, 0x6a, 0x3c, 0xff, 0xe0, 0xa5,
-		0xb0, 0xd2, 0x67, 0xfd, 0x0a, 0x35, 0x52, 0x66, 0x6a, 0xc5, 0x6b, 0x90, 0xae, 0x59, 0x1d, 0xdd,
-		0xb4, 0xa2, 0x6c, 0x19, 0xc6, 0x46, 0x6d, 0xb6, 0xbb, 0x3c, 0x2a, 0x64, 0x76, 0x81, 0x4e, 0x42,
-		0x8a, 0x7d, 0x27, 0xc0, 0x5f, 0xc2, 0xe0, 0x57, 0xc5, 0x2a, 0x4c, 0x51, 0xee, 0x2d, 0x9b, 0x24,
-		0x7f, 0xff, 0x95, 0xcc, 0x0c, 0xff, 0x6a, 0x8c, 0xd3, 0xc7, 0x03, 0x63, 0x11, 0x24, 0x5b, 0xaa,
-		0xa7, 0xf2, 0x7e, 0xd3, 0xdf, 0xc5, 0x8f, 0x42, 0x9a, 0x93, 0xb8, 0xe8, 0x3c, 0x24, 0x2c, 0xdb,
-		0xe5, 0xaf, 0x51, 0x2c, 0x0c, 0xeb, 0xca, 0x96, 0x5d, 0x49, 0x92, 0x98, 0x91, 0x89, 0x72, 0x45,
-		0x1e, 0x1a, 0x16, 0x2f, 0x86, 0xc2, 0x22, 0x34, 0xe4, 0xa1, 0x9f, 0x6c, 0x48, 0xfb, 0xc2, 0xc1,
-		0x0f, 0x96, 0xcf, 0xc5, 0x61, 0x31, 0xd4, 0x7a, 0x13, 0x3b, 0xae, 0x6e, 0x99, 0x2c, 0xa2, 0x78,
-		0xb4, 0xa0, 0x90, 0x91, 0xbc, 0x7d, 0x48, 0xb8, 0x7c, 0x04, 0x12, 0x65, 0xdb, 0x46, 0x0b, 0x90,
-		0xa6, 0xd7, 0x9a, 0xc5, 0xe2, 0x25, 0x29, 0xfb, 0xd7, 0xa4, 0xcd, 0xb5, 0xf6, 0xbc, 0x5b, 0xaa,
-		0xe3, 0x7f, 0x4a, 0x27, 0xae, 0x8b, 0x97, 0x21, 0x53, 0xb5, 0x4c, 0x17, 0x9b, 0x6e, 0x97, 0x56,
-		0x36, 0xbb, 0x86, 0xa5, 0x1d, 0x70, 0x06, 0x76, 0x41, 0x1c, 0xae, 0xda, 0x36, 0x45, 0x26, 0x65,
-		0xf2, 0x93, 0xcd, 0xd9, 0x4a, 0x73, 0xa8, 0x8b, 0x2e, 0x1f, 0xdf, 0x45, 0xbc, 0x93, 0xbe, 0x8f,
-		0x7e, 0x10, 0x83, 0x07, 0xfb, 0x27, 0xd4, 0x01, 0x3e, 0x74, 0x8f, 0x3b, 0x9f, 0x5e, 0x83, 0x4c,
-		0x83, 0x7e, 0xcf, 0x7e, 0x1d, 0x1f, 0xa2, 0x05, 0x98, 0xc2, 0xad, 0xf3, 0x17, 0x2e, 0x3c, 0x77,
-		0x99, 0x45, 0xfb, 0xcb, 0x13, 0xb2, 0x10, 0xa0, 0x45, 0xc8, 0xb8, 0x58, 0xb3, 0xcf, 0x5f, 0xb8,
-		0x78, 0xf0, 0x1c, 0x0b, 0xaf, 0x97, 0x27, 0xe4, 0x40, 0x54, 0x4a, 0x93, 0x5e, 0xbf, 0xff, 0xb9,
-		0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6, 0xc8, 0xa7, 0x27, 0x61, 0x39, 0x8c,
-		0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0x9f, 0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6,
-		0x90, 0x95, 0xe2, 0x48, 0x4f, 0x16, 0x7f, 0x25, 0x06, 0xb9, 0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74,
-		0x05, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde, 0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75,
-		0x74, 0x89, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0xf3, 0xaa, 0x11, 0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88,
-		0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad, 0x5b, 0xfc, 0xa3, 0xd5, 0x84, 0x2c,
-		0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c, 0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5,
-		0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x15, 0x98, 0xb2, 0xbb, 0xbb, 0x8a, 0xc8, 0x18,
-		0xd9, 0xf3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06, 0x48, 0xd9, 0xdd, 0x5d, 0x12, 0x2d,
-		0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4, 0xdf, 0x28, 0xf0, 0x1e, 0x28, 0xb6,
-		0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd2, 0x77, 0xa1, 0x12, 0xb2, 0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0x3c,
-		0x80, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0x2f, 0x04, 0xf6, 0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2,
-		0x78, 0x9f, 0x65, 0x95, 0x57, 0x86, 0x46, 0xe7, 0xa5, 0xe3, 0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd,
-		0xd3, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d, 0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xa3,
-		0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a, 0x5e, 0x86, 0xe9, 0x86, 0xea, 0x78,
-		0x4d, 0xec, 0xbd, 0x8c, 0xd5, 0x16, 0x76, 0xa2, 0xab, 0xee, 0xb4, 0x58, 0x75, 0x11, 0x24, 0xe9,
-		0xd2, 0xca, 0x56, 0x1d, 0xfa, 0xbb, 0xb8, 0x0f, 0x49, 0xfa, 0x3e, 0xa4, 0xbf, 0x22, 0x73, 0x04,
-		0x5b, 0x91, 0x49, 0x2e, 0x3d, 0xf4, 0xb0, 0x2b, 0x8e, 0x11, 0xe8, 0x05, 0x7a, 0x41, 0xac, 0xab,
-		0x89, 0xa3, 0xd7, 0x55, 0x1e, 0x88, 0x7c, 0x75, 0x35, 0x60, 0xaa, 0x42, 0x52, 0xf1, 0x5a, 0xcd,
-		0x37, 0x24, 0x16, 0x18, 0x82, 0x36, 0x60, 0xc6, 0x56, 0x1d, 0x8f, 0x7e, 0x1a, 0xb2, 0x4f, 0x7b,
-		0xc1, 0x63, 0x7d, 0xa9, 0x7f, 0xe6, 0x45, 0x3a, 0xcb, 0xef, 0x32, 0x6d, 0x87, 0x85, 0xc5, 0xff,
-		0x98, 0x84, 0x14, 0x77, 0xc6, 0x47, 0x60, 0x8a, 0xbb, 0x95, 0x47, 0xe7, 0x43, 0x2b, 0xfd, 0x0b,
-		0xd3, 0x8a, 0xbf, 0x80, 0x70, 0x3e, 0x81, 0x41, 0x8f, 0x43, 0x5a, 0xdb, 0x57, 0x75, 0x53, 0xd1,
-		0x5b, 0xbc, 0x20, 0xcc, 0xbe, 0xf7, 0xee, 0xd2, 0x54, 0x95, 0xc8, 0xd6, 0x6a, 0xf2, 0x14, 0x6d,
-		0x5c, 0x6b, 0x91, 0x4a, 0x60, 0x1f, 0xeb, 0xed, 0x7d, 0x8f, 0xcf, 0x30, 0x7e, 0x85, 0x5e, 0x84,
-		0x24, 0x09, 0x08, 0xfe, 0xe1, 0xe0, 0x42, 0x5f, 0x85, 0xef, 0x6f, 0xa1, 0x2b, 0x69, 0x72, 0xe3,
-		0x4f, 0xfe, 0xfb, 0xa5, 0x98, 0x4c, 0x11, 0xa8, 0x0a, 0xd3, 0x86, 0xea, 0x7a, 0x0a, 0x5d, 0xc1,
-		0xc8, 0xed, 0x27, 0x29, 0xc5, 0xe9, 0x7e, 0x87, 0x70, 0xc7, 0x72, 0xd3, 0xb3, 0x04, 0xc5, 0x44,
-		0x2d, 0x74, 0x16, 0x24, 0x4a, 0xa2, 0x59, 0x9d, 0x8e, 0xee, 0xb1, 0xda, 0x2a, 0x45, 0xfd, 0x9e,
-		0x27, 0xf2, 0x2a, 0x15, 0xd3, 0x0a, 0xeb, 0x01, 0xc8, 0xd0, 0x4f, 0x95, 0xa8, 0x0a, 0x7b, 0x09,
-		0x37, 0x4d, 0x04, 0xb4, 0xf1, 0x0c, 0xcc, 0x04, 0xf9, 0x91, 0xa9, 0xa4, 0x19, 0x4b, 0x20, 0xa6,
-		0x8a, 0xcf, 0xc2, 0xbc, 0x89, 0x6f, 0xd3, 0xd7, 0x82, 0x23, 0xda, 0x19, 0xaa, 0x8d, 0x48, 0xdb,
-		0x8d, 0x28, 0xe2, 0x31, 0xc8, 0x6b, 0xc2, 0xf9, 0x4c, 0x17, 0xa8, 0xee, 0xb4, 0x2f, 0xa5, 0x6a,
-		0xa7, 0x21, 0xad, 0xda, 0x36, 0x53, 0xc8, 0xf2, 0xfc, 0x68, 0xdb, 0xb4, 0xe9, 0x49, 0x98, 0xa5,
-		0x7d, 0x74, 0xb0, 0xdb, 0x35, 0x3c, 0x4e, 0x92, 0xa3, 0x3a, 0x33, 0xa4, 0x41, 0x66, 0x72, 0xaa,
-		0xfb, 0x08, 0x4c, 0xe3, 0x9b, 0x7a, 0x0b, 0x9b, 0x1a, 0x66, 0x7a, 0xd3, 0x54, 0x2f, 0x27, 0x84,
-		0x54, 0xe9, 0x09, 0xf0, 0xf3, 0x9e, 0x22, 0x72, 0x72, 0x9e, 0xf1, 0x09, 0x79, 0x99, 0x89, 0x8b,
-		0x05, 0x48, 0xd6, 0x54, 0x4f, 0x25, 0x05, 0x86, 0x77, 0x9b, 0x2d, 0x34, 0x39, 0x99, 0xfc, 0x2c,
-		0xbe, 0x1f, 0x87, 0xe4, 0x0d, 0xcb, 0xc3, 0xe8, 0xf9, 0x50, 0x01, 0x98, 0x1f, 0x14, 0xcf, 0x4d,
-		0xbd, 0x6d, 0xe2, 0xd6, 0x86, 0xdb, 0x0e, 0xfd, 0x5f, 0x81, 0x20, 0x9c, 0xe2, 0x91, 0x70, 0x9a,
-		0x87, 0x49, 0xc7, 0xea, 0x9a, 0x2d, 0xf1, 0xfe, 0x2a, 0xbd, 0x40, 0x75, 0x48, 0xfb, 0x51, 0x92,
-		0x1c, 0x15, 0x25, 0x33, 0x24, 0x4a, 0x48, 0x0c, 0x73, 0x81, 0x3c, 0xb5, 0xcb, 0x83, 0xa5, 0x02,
-		0x19, 0x3f, 0x79, 0xf1, 0x68, 0x1b, 0x2f, 0x60, 0x03, 0x18, 0x59, 0x4c, 0xfc, 0xb1, 0xf7, 0x9d,
-		0xc7, 0x22, 0x4e, 0xf2, 0x1b, 0xb8, 0xf7, 0x22, 0x61, 0xc5, 0xff, 0xc7, 0xc1, 0x14, 0xed, 0x57,
-		0x10, 0x56, 0xec, 0xff, 0x1c, 0x3c, 0x08, 0x19, 0x57, 0x6f, 0x9b, 0xaa, 0xd7, 0x75, 0x30, 0x8f,
-		0xbc, 0x40, 0x50, 0xfc, 0x5a, 0x0c, 0x52, 0x2c, 0x92, 0x43, 0x7e, 0x8b, 0x0d, 0xf6, 0x5b, 0x7c,
-		0x98, 0xdf, 0x12, 0xf7, 0xee, 0xb7, 0x32, 0x80, 0x6f, 0x8c, 0xcb, 0x3f, 0x3d, 0x1f, 0x50, 0x31,
-		0x30, 0x13, 0x9b, 0x7a, 0x9b, 0x4f, 0xd4, 0x10, 0xa8, 0xf8, 0xef, 0x62, 0xa4, 0x88, 0xe5, 0xed,
-		0xa8, 0x0c, 0xd3, 0xc2, 0x2e, 0x65, 0xcf, 0x50, 0xdb, 0x3c, 0x76, 0x1e, 0x1a, 0x6a, 0xdc, 0x55,
-		0x43, 0x6d, 0xcb, 0x59, 0x6e, 0x0f, 0xb9, 0x18, 0x3c, 0x0e, 0xf1, 0x21, 0xe3, 0x10, 0x19, 0xf8,
-		0xc4, 0xbd, 0x0d, 0x7c, 0x64, 0x88, 0x92, 0xbd, 0x43, 0xf4, 0xe5, 0x38, 0xdd, 0xcc, 0xd8, 0x96,
-		0xab, 0x1a, 0x3f, 0x8c, 0x19, 0xf1, 0x00, 0x64, 0x6c, 0xcb, 0x50, 0x58, 0x0b, 0x7b, 0xaf, 0x3b,
-		0x6d, 0x5b, 0x86, 0xdc, 0x37, 0xec, 0x93, 0xf7, 0x69, 0xba, 0xa4, 0xee, 0x83, 0xd7, 0xa6, 0x7a,
-		0xbd, 0xe6, 0x40, 0x8e, 0xb9, 0x82, 0xaf, 0x65, 0xcf, 0x12, 0x1f, 0xd0, 0xc5, 0x31, 0xd6, 0xbf,
-		0xf6, 0x32, 0xb3, 0x99, 0xa6, 0xcc, 0xf5, 0x08, 0x82, 0xa5, 0xfe, 0x41, 0xbb, 0xe0, 0x70, 0x58,
-		0xca, 0x5c, 0xaf, 0xf8, 0x97, 0x62, 0x00, 0xeb, 0xc4, 0xb3, 0xb4, 0xbf, 0x64, 0x15, 0x72, 0xa9,
-		0x09, 0x4a, 0xe4, 0xce, 0x8b, 0xc3, 0x06, 0x8d, 0xdf, 0x3f, 0xe7, 0x86, 0xed, 0xae, 0xc2, 0x74,
-		0x10, 0x8c, 0x2e, 0x16, 0xc6, 0x2c, 0x1e, 0x51, 0x55, 0x37, 0xb1, 0x27, 0xe7, 0x6e, 0x86, 0xae,
-		0x8a, 0xff, 0x24, 0x06, 0x19, 0x6a, 0xd3, 0x06, 0xf6, 0xd4, 0xc8, 0x18, 0xc6, 0xee, 0x7d, 0x0c,
-		0x1f, 0x02, 0x60, 0x34, 0xae, 0xfe, 0x16, 0xe6, 0x91, 0x95, 0xa1, 0x92, 0xa6, 0xfe, 0x16, 0x46,
-		0x17, 0x7d, 0x87, 0x27, 0x8e, 0x76, 0xb8, 0xa8, 0xba, 0xb9, 0xdb, 0x4f, 0xc1, 0x14, 0xfd, 0x57,
-		0x4d, 0xb7, 0x5d, 0x5e, 0x48, 0xa7, 0xcc, 0x6e, 0x67, 0xfb, 0xb6, 0x5b, 0x7c, 0x13, 0xa6, 0xb6,
-		0x6f, 0xb3, 0xb3, 0x91, 0x07, 0x20, 0xe3, 0x58, 0x16, 0x5f, 0x93, 0x59, 0x2d, 0x94, 0x26, 0x02,
-		0xba, 0x04, 0x89, 0xf3, 0x80, 0x78, 0x70, 0x1e, 0x10, 0x1c, 0x68, 0x24, 0xc6, 0x3a, 0xd0, 0x78,
-		0xf2, 0xb7, 0x62, 0x90, 0x0d, 0xe5, 0x07, 0xf4, 0x1c, 0x9c, 0xa8, 0xac, 0x6f, 0x55, 0xaf, 0x2b,
-		0x6b, 0x35, 0xe5, 0xea, 0x7a, 0x79, 0x35, 0xf8, 0x72, 0x69, 0xe1, 0xe4, 0x9d, 0xbb, 0xcb, 0x28,
-		0xa4, 0xbb, 0x63, 0xd2, 0x73, 0x7a, 0x74, 0x0e, 0xe6, 0xa3, 0x90, 0x72, 0xa5, 0x59, 0xdf, 0xdc,
-		0x96, 0x62, 0x0b, 0x27, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21, 0xca, 0xbb, 0x2e, 0x36, 0xbd, 0x7e,
-		0x40, 0x75, 0x6b, 0x63, 0x63, 0x6d, 0x5b, 0x8a, 0xf7, 0x01, 0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3,
-		0x80, 0xcd, 0xb5, 0x75, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d, 0xce, 0x87, 0xb4, 0x37, 0x75, 0x63,
-		0x21, 0xfd, 0xf1, 0xcf, 0x2f, 0x4e, 0xfc, 0xd2, 0x2f, 0x2e, 0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39,
-		0x02, 0x3d, 0x0d, 0xa7, 0x9a, 0x6b, 0xab, 0x9b, 0xf5, 0x9a, 0xb2, 0xd1, 0x5c, 0x15, 0x27, 0xdd,
-		0xa2, 0x77, 0x33, 0x77, 0xee, 0x2e, 0x67, 0x79, 0x97, 0x86, 0x69, 0x37, 0xe4, 0xfa, 0x8d, 0xad,
-		0xed, 0xba, 0x14, 0x63, 0xda, 0x0d, 0x07, 0xdf, 0xb4, 0x3c, 0xf6, 0xbf, 0xdc, 0x9e, 0x85, 0xd3,
-		0x03, 0xb4, 0xfd, 0x8e, 0xcd, 0xde, 0xb9, 0xbb, 0x3c, 0xdd, 0x70, 0x30, 0x9b, 0x3f, 0x14, 0xb1,
-		0x02, 0x85, 0x7e, 0xc4, 0x56, 0x63, 0xab, 0x59, 0x5e, 0x97, 0x96, 0x17, 0xa4, 0x3b, 0x77, 0x97,
-		0x73, 0x22, 0x19, 0x12, 0xfd, 0xa0, 0x67, 0x1f, 0xe6, 0x8e, 0xe7, 0xfd, 0xa7, 0xe0, 0x51, 0x7e,
-		0x06, 0xe8, 0x7a, 0xea, 0x81, 0x6e, 0xb6, 0xfd, 0xc3, 0x5b, 0x7e, 0xcd, 0x77, 0x3e, 0x27, 0xf9,
-		0x39, 0xa3, 0x90, 0x8e, 0x38, 0xc2, 0x1d, 0xfa, 0xe4, 0x72, 0x61, 0xc4, 0x43, 0xbd, 0xd1, 0x5b,
-		0xa7, 0xe1, 0xc7, 0xc3, 0x0b, 0x23, 0x0e, 0xa1, 0x17, 0x8e, 0xdc, 0xdc, 0x15, 0x3f, 0x11, 0x83,
-		0xfc, 0xcb, 0xba, 0xeb, 0x59, 0x8e, 0xae, 0xa9, 0x06, 0xfd, 0x5e, 0xe9, 0xe2, 0xb8, 0xb9, 0xb5,
-		0x67, 0xaa, 0xbf, 0x04, 0xa9, 0x9b, 0xaa, 0xc1, 0x92, 0x5a, 0xf8, 0x59, 0x40, 0xaf, 0xfb, 0x82,
-		0xd4, 0x26, 0x08, 0x18, 0xac, 0xf8, 0xa5, 0x38, 0xcc, 0xd0, 0xc9, 0xe0, 0xb2, 0x7f, 0xc5, 0x45,
-		0xf6, 0x58, 0x0d, 0x48, 0x3a, 0xaa, 0xc7, 0x0f, 0x0d, 0x2b, 0x3f, 0xc2, 0xcf, 0x81, 0x1f, 0x1f,
-		0x7d, 0x9a, 0xbb, 0xd2, 0x7f, 0x54, 0x4c, 0x99, 0xd0, 0xab, 0x90, 0xee, 0xa8, 0xb7, 0x15, 0xca,
-		0x1a, 0xbf, 0x0f, 0xac, 0x53, 0x1d, 0xf5, 0x36, 0xb1, 0x15, 0xb5, 0x60, 0x86, 0x10, 0x6b, 0xfb,
-		0xaa, 0xd9, 0xc6, 0x8c, 0x3f, 0x71, 0x1f, 0xf8, 0xa7, 0x3b, 0xea, 0xed, 0x2a, 0xe5, 0x24, 0x77,
-		0x29, 0xa5, 0x3f, 0xf5, 0xce, 0xd2, 0x04, 0x3d, 0x66, 0xff, 0xb5, 0x18, 0x40, 0xe0, 0x2e, 0xf4,
-		0xff, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0, 0x33, 0xc3, 0x06, 0xa2, 0xc7, 0xd9,
-		0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb, 0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76,
-		0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x63, 0x2c, 0xf2, 0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd,
-		0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa, 0x63, 0x99, 0xfa, 0x01, 0x0f, 0xbb,
-		0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0x97, 0x9a, 0xde, 0xa1, 0x38, 0xf1, 0x14, 0xd7, 0x04,
-		0x75, 0x0b, 0xef, 0xba, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9, 0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1,
-		0xbd, 0x43, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xcd, 0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98,
-		0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2, 0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4f,
-		0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25, 0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7e,
-		0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x97, 0x5a, 0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6,
-		0xeb, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x06, 0xc7, 0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b,
-		0x87, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a, 0x3e, 0x24, 0xa3, 0xc5, 0x79, 0x1a,
-		0x94, 0x86, 0x94, 0x88, 0x6f, 0xaa, 0xba, 0x21, 0x3e, 0x40, 0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca,
-		0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x14, 0x57, 0x1c, 0x16, 0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9,
-		0xa6, 0xcc, 0x11, 0x68, 0x1b, 0x52, 0x9e, 0x75, 0x80, 0x4d, 0xee, 0xa4, 0x63, 0x45, 0xf5, 0x80,
-		0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7, 0x59, 0x41, 0xb4, 0xaf, 0x92, 0x7d,
-		0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49, 0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6,
-		0x5f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3, 0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40,
-		0xea, 0x9a, 0xbb, 0x96, 0x49, 0x3f, 0x13, 0xe5, 0xc5, 0x78, 0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc,
-		0x65, 0x56, 0x95, 0x5f, 0x87, 0x7c, 0xa0, 0x4a, 0xe7, 0x4e, 0xe6, 0x18, 0x73, 0x67, 0xda, 0xc7,
-		0x92, 0x56, 0xf4, 0x32, 0x40, 0x30, 0x31, 0xe9, 0xf1, 0x40, 0x76, 0xf8, 0x18, 0x06, 0xb3, 0x5b,
-		0x6c, 0xb3, 0x02, 0x2c, 0x32, 0x60, 0xae, 0xa3, 0x9b, 0x8a, 0x8b, 0x8d, 0x3d, 0x85, 0xbb, 0x8a,
-		0x50, 0x66, 0xef, 0xc3, 0xd0, 0xce, 0x76, 0x74, 0xb3, 0x89, 0x8d, 0xbd, 0x9a, 0x4f, 0x5b, 0xca,
-		0x7d, 0xfc, 0x9d, 0xa5, 0x09, 0x3e, 0x97, 0x26, 0x8a, 0x0d, 0x7a, 0x44, 0xcd, 0xa7, 0x01, 0x76,
-		0xd1, 0x45, 0xc8, 0xa8, 0xe2, 0x82, 0x1e, 0x1c, 0x1c, 0x35, 0x8d, 0x02, 0x55, 0x36, 0x3b, 0xdf,
-		0xfe, 0xb7, 0xcb, 0xb1, 0xe2, 0x2f, 0xc6, 0x20, 0x55, 0xbb, 0xd1, 0x50, 0x75, 0x07, 0xd5, 0x61,
-		0x36, 0x08, 0xa8, 0x71, 0xe7, 0x66, 0x10, 0x83, 0x62, 0x72, 0xd6, 0x87, 0xed, 0x1a, 0x8f, 0xa4,
-		0xe9, 0xdd, 0x4f, 0xf6, 0x74, 0xbc, 0x0e, 0x53, 0xcc, 0x4a, 0x17, 0x95, 0x60, 0xd2, 0x26, 0x3f,
-		0xf8, 0x89, 0xfc, 0xe2, 0xd0, 0x40, 0xa4, 0xfa, 0xfe, 0x09, 0x22, 0x81, 0x14, 0x7f, 0x10, 0x03,
-		0xa8, 0xdd, 0xb8, 0xb1, 0xed, 0xe8, 0xb6, 0x81, 0xbd, 0xfb, 0xd5, 0xe3, 0x75, 0x38, 0x11, 0xda,
-		0x9a, 0x38, 0xda, 0xd8, 0xbd, 0x9e, 0x0b, 0x36, 0x27, 0x8e, 0x36, 0x90, 0xad, 0xe5, 0x7a, 0x3e,
-		0x5b, 0x62, 0x6c, 0xb6, 0x9a, 0xeb, 0x0d, 0x76, 0x63, 0x13, 0xb2, 0x41, 0xf7, 0x5d, 0x54, 0x83,
-		0xb4, 0xc7, 0x7f, 0x73, 0x6f, 0x16, 0x87, 0x7b, 0x53, 0xc0, 0xb8, 0x47, 0x7d, 0x64, 0xf1, 0xff,
-		0x10, 0xa7, 0xfa, 0x11, 0xfb, 0x87, 0x2b, 0x8c, 0x48, 0xee, 0xe5, 0xb9, 0xf1, 0x7e, 0x54, 0x14,
-		0x9c, 0xab, 0xc7, 0xab, 0x1f, 0x8b, 0xc3, 0xdc, 0x8e, 0xc8, 0x36, 0x7f, 0x68, 0x3d, 0xd1, 0x80,
-		0x29, 0x6c, 0x7a, 0x8e, 0x4e, 0x5d, 0x41, 0xc6, 0xfa, 0xd9, 0x61, 0x63, 0x3d, 0xa0, 0x2f, 0xf4,
-		0xff, 0x15, 0x89, 0x73, 0x6d, 0x4e, 0xd3, 0xe3, 0x85, 0x7f, 0x13, 0x87, 0xc2, 0x30, 0x24, 0x3a,
-		0x03, 0x33, 0x9a, 0x83, 0xa9, 0x40, 0x89, 0x1c, 0xae, 0xe5, 0x85, 0x98, 0x27, 0xfd, 0x0d, 0x20,
-		0x05, 0x14, 0x09, 0x2c, 0xa2, 0x7a, 0xec, 0x8a, 0x29, 0x1f, 0x80, 0x69, 0xda, 0xc7, 0x30, 0xa3,
-		0x9b, 0xba, 0xa7, 0xab, 0x86, 0xb2, 0xab, 0x1a, 0xaa, 0xa9, 0xdd, 0x4b, 0x65, 0xd9, 0x9f, 0xa8,
-		0xf3, 0x9c, 0xb4, 0xc2, 0x38, 0xd1, 0x0d, 0x98, 0x12, 0xf4, 0xc9, 0xfb, 0x40, 0x2f, 0xc8, 0x42,
-		0x55, 0xd4, 0xef, 0xc4, 0x61, 0x56, 0xc6, 0xad, 0x3f, 0x5a, 0x6e, 0xfd, 0x51, 0x00, 0x36, 0xe1,
-		0x48, 0x1e, 0xbc, 0x07, 0xcf, 0xf6, 0x4f, 0xe0, 0x0c, 0xe3, 0xab, 0xb9, 0x5e, 0xc8, 0xb7, 0xdf,
-		0x88, 0x43, 0x2e, 0xec, 0xdb, 0x3f, 0x02, 0xeb, 0x02, 0x5a, 0x0b, 0xb2, 0x41, 0x92, 0xff, 0xa7,
-		0xd5, 0x21, 0xd9, 0xa0, 0x2f, 0xea, 0x8e, 0x4e, 0x03, 0xdf, 0x8b, 0x43, 0xaa, 0xa1, 0x3a, 0x6a,
-		0xc7, 0x45, 0xd7, 0xfa, 0x0a, 0x38, 0x71, 0xca, 0xd6, 0xf7, 0xff, 0xb4, 0xf9, 0xa6, 0x9e, 0x85,
-		0xdc, 0xa7, 0x06, 0xd4, 0x6f, 0x8f, 0x41, 0x9e, 0x6c, 0x11, 0x43, 0x0f, 0xe4, 0xe3, 0xf4, 0x31,
-		0x23, 0xd9, 0xe3, 0x05, 0x4f, 0x83, 0xd0, 0x12, 0x64, 0x89, 0x5a, 0x90, 0xe8, 0x88, 0x0e, 0x74,
-		0xd4, 0xdb, 0x75, 0x26, 0x41, 0xcf, 0x00, 0xda, 0xf7, 0x37, 0xed, 0x4a, 0xe0, 0x02, 0xa2, 0x37,
-		0x1b, 0xb4, 0x08, 0xf5, 0x87, 0x00, 0x88, 0x15, 0x0a, 0x7b, 0xc9, 0x8b, 0xed, 0x71, 0x32, 0x44,
-		0x52, 0xa3, 0x2f, 0x7a, 0xfd, 0x04, 0xab, 0x05, 0x7b, 0x76, 0x8f, 0xbc, 0x0c, 0x5f, 0x3f, 0x5e,
-		0xa4, 0x7e, 0xef, 0xdd, 0xa5, 0x85, 0x43, 0xb5, 0x63, 0x94, 0x8a, 0x03, 0x28, 0x8b, 0xb4, 0x36,
-		0x8c, 0xee, 0x3a, 0x43, 0x11, 0xfc, 0xf9, 0x18, 0xa0, 0x20, 0xe5, 0xca, 0xd8, 0xb5, 0xc9, 0xb6,
-		0x86, 0x14, 0xbd, 0xa1, 0x0a, 0x35, 0x76, 0x74, 0xd1, 0x1b, 0xe0, 0x45, 0xd1, 0x1b, 0x9a, 0x11,
-		0x97, 0x83, 0x04, 0x17, 0xe7, 0x63, 0x38, 0xe0, 0x0d, 0xbd, 0x95, 0xaa, 0xa5, 0x0b, 0x74, 0x5f,
-		0x0e, 0x9b, 0x28, 0xfe, 0x4e, 0x0c, 0x4e, 0xf7, 0x45, 0x93, 0x6f, 0xec, 0x1f, 0x03, 0xe4, 0x84,
-		0x1a, 0xf9, 0xbf, 0xcc, 0x63, 0x46, 0x1f, 0x3b, 0x38, 0x67, 0x9d, 0xbe, 0x5c, 0xf9, 0x61, 0xe5,
-		0x68, 0xf6, 0xe6, 0xde, 0x3f, 0x8a, 0xc1, 0x7c, 0xd8, 0x18, 0xbf, 0x5b, 0x9b, 0x90, 0x0b, 0xdb,
-		0xc2, 0x3b, 0xf4, 0xe8, 0x38, 0x1d, 0xe2, 0x7d, 0x89, 0xe0, 0xd1, 0x2b, 0xc1, 0xc4, 0x65, 0x87,
-		0x45, 0xcf, 0x8d, 0xed, 0x1b, 0x61, 0x53, 0xef, 0x04, 0x4e, 0x8a, 0x2a, 0x26, 0xd9, 0xb0, 0x2c,
-		0x03, 0xfd, 0x71, 0x98, 0x35, 0x2d, 0x4f, 0x21, 0x51, 0x8e, 0x5b, 0x0a, 0xdf, 0xb9, 0xb2, 0xec,
-		0xf7, 0xca, 0xf1, 0x5c, 0xf6, 0x9d, 0x77, 0x97, 0xfa, 0xa9, 0x7a, 0xfc, 0x38, 0x63, 0x5a, 0x5e,
-		0x85, 0xb6, 0x6f, 0xb3, 0x7d, 0xad, 0x03, 0xd3, 0xd1, 0x5b, 0xb3, 0x6c, 0xb9, 0x71, 0xec, 0x5b,
-		0x4f, 0x1f, 0x75, 0xdb, 0xdc, 0x6e, 0xe8, 0x9e, 0xec, 0x9d, 0xa6, 0xdf, 0x7f, 0x67, 0x29, 0xf6,
-		0xe4, 0x57, 0x63, 0x00, 0xc1, 0x16, 0x1e, 0x3d, 0x0d, 0xa7, 0x2a, 0x5b, 0x9b, 0x35, 0xa5, 0xb9,
-		0x5d, 0xde, 0xde, 0x69, 0x46, 0xdf, 0x7c, 0x16, 0x67, 0xc2, 0xae, 0x8d, 0x35, 0x7d, 0x4f, 0xc7,
-		0x2d, 0xf4, 0x38, 0xcc, 0x47, 0xb5, 0xc9, 0x55, 0xbd, 0x26, 0xc5, 0x16, 0x72, 0x77, 0xee, 0x2e,
-		0xa7, 0x59, 0x75, 0x84, 0x5b, 0xe8, 0x2c, 0x9c, 0xe8, 0xd7, 0x5b, 0xdb, 0x5c, 0x95, 0xe2, 0x0b,
-		0xd3, 0x77, 0xee, 0x2e, 0x67, 0xfc, 0x32, 0x0a, 0x15, 0x01, 0x85, 0x35, 0x39, 0x5f, 0x62, 0x01,
-		0xee, 0xdc, 0x5d, 0x4e, 0x31, 0xb7, 0x2d, 0x24, 0x3f, 0xfe, 0xf9, 0xc5, 0x89, 0xca, 0xd5, 0xa1,
-		0xa7, 0xbe, 0x4f, 0x1f, 0xe9, 0xb1, 0xdb, 0xfe, 0x49, 0x6e, 0xe4, 0xa8, 0xf7, 0xff, 0x06, 0x00,
-		0x00, 0xff, 0xff, 0xdf, 0xc6, 0xe4, 0x92, 0x04, 0x66, 0x00, 0x00,
+		// 7504 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x70, 0x24, 0xd7,
+		0x75, 0x1e, 0xe6, 0x81, 0xc1, 0xcc, 0xc1, 0x00, 0x68, 0x34, 0xb0, 0xcb, 0x59, 0x90, 0x04, 0xc0,
+		0xe1, 0x6b, 0xf9, 0xc2, 0x92, 0x4b, 0xee, 0x2e, 0x77, 0xd6, 0x12, 0x03, 0x60, 0x66, 0x77, 0xb1,
+		0xc4, 0x63, 0xd8, 0x03, 0x2c, 0x1f, 0x8e, 0xd3, 0xd5, 0xe8, 0xb9, 0x18, 0x34, 0xd1, 0xd3, 0xdd,
+		0xee, 0xee, 0xd9, 0x5d, 0xb0, 0x9c, 0x14, 0x55, 0xca, 0xc3, 0xda, 0x54, 0x12, 0x39, 0x4e, 0xc5,
+		0xb2, 0xac, 0x55, 0x48, 0x4b, 0x89, 0x1c, 0x45, 0x49, 0x2c, 0x4b, 0x51, 0xe2, 0xb8, 0x92, 0x28,
+		0xa9, 0x4a, 0x22, 0xeb, 0x47, 0x4a, 0xf2, 0x8f, 0xd8, 0x4e, 0x39, 0x8c, 0x43, 0xa9, 0x12, 0x45,
+		0x51, 0x62, 0x45, 0x61, 0xaa, 0x92, 0x52, 0x39, 0x95, 0x3a, 0xf7, 0xd1, 0x8f, 0x79, 0xa0, 0x07,
+		0xcc, 0x52, 0x76, 0x95, 0x7e, 0x01, 0xf7, 0xdc, 0xf3, 0x7d, 0x7d, 0xef, 0xb9, 0xe7, 0xde, 0x7b,
+		0xee, 0xb9, 0xdd, 0x03, 0x5f, 0xbc, 0x04, 0x8b, 0x2d, 0xdb, 0x6e, 0x99, 0xe4, 0x8c, 0xe3, 0xda,
+		0xbe, 0xbd, 0xdb, 0xd9, 0x3b, 0xd3, 0x24, 0x9e, 0xee, 0x1a, 0x8e, 0x6f, 0xbb, 0x4b, 0x54, 0x26,
+		0x4f, 0x31, 0x8d, 0x25, 0xa1, 0x51, 0xde, 0x80, 0xe9, 0xcb, 0x86, 0x49, 0xaa, 0x81, 0x62, 0x83,
+		0xf8, 0xf2, 0xf3, 0x90, 0xdd, 0x33, 0x4c, 0x52, 0x4a, 0x2d, 0x66, 0x4e, 0x8f, 0x9f, 0x7d, 0x68,
+		0xa9, 0x0b, 0xb4, 0x14, 0x47, 0xd4, 0x51, 0xac, 0x50, 0x44, 0xf9, 0xdb, 0x59, 0x98, 0xe9, 0x53,
+		0x2b, 0xcb, 0x90, 0xb5, 0xb4, 0x36, 0x32, 0xa6, 0x4e, 0x17, 0x14, 0xfa, 0xbf, 0x5c, 0x82, 0x31,
+		0x47, 0xd3, 0x0f, 0xb4, 0x16, 0x29, 0xa5, 0xa9, 0x58, 0x14, 0xe5, 0x79, 0x80, 0x26, 0x71, 0x88,
+		0xd5, 0x24, 0x96, 0x7e, 0x58, 0xca, 0x2c, 0x66, 0x4e, 0x17, 0x94, 0x88, 0x44, 0x7e, 0x02, 0xa6,
+		0x9d, 0xce, 0xae, 0x69, 0xe8, 0x6a, 0x44, 0x0d, 0x16, 0x33, 0xa7, 0x47, 0x15, 0x89, 0x55, 0x54,
+		0x43, 0xe5, 0x47, 0x61, 0xea, 0x26, 0xd1, 0x0e, 0xa2, 0xaa, 0xe3, 0x54, 0x75, 0x12, 0xc5, 0x11,
+		0xc5, 0x55, 0x28, 0xb6, 0x89, 0xe7, 0x69, 0x2d, 0xa2, 0xfa, 0x87, 0x0e, 0x29, 0x65, 0x69, 0xef,
+		0x17, 0x7b, 0x7a, 0xdf, 0xdd, 0xf3, 0x71, 0x8e, 0xda, 0x3e, 0x74, 0x88, 0xbc, 0x0c, 0x05, 0x62,
+		0x75, 0xda, 0x8c, 0x61, 0x74, 0x80, 0xfd, 0x6a, 0x56, 0xa7, 0xdd, 0xcd, 0x92, 0x47, 0x18, 0xa7,
+		0x18, 0xf3, 0x88, 0x7b, 0xc3, 0xd0, 0x49, 0x29, 0x47, 0x09, 0x1e, 0xed, 0x21, 0x68, 0xb0, 0xfa,
+		0x6e, 0x0e, 0x81, 0x93, 0x57, 0xa1, 0x40, 0x6e, 0xf9, 0xc4, 0xf2, 0x0c, 0xdb, 0x2a, 0x8d, 0x51,
+		0x92, 0x87, 0xfb, 0x8c, 0x22, 0x31, 0x9b, 0xdd, 0x14, 0x21, 0x4e, 0x3e, 0x0f, 0x63, 0xb6, 0xe3,
+		0x1b, 0xb6, 0xe5, 0x95, 0xf2, 0x8b, 0xa9, 0xd3, 0xe3, 0x67, 0xef, 0xeb, 0xeb, 0x08, 0x5b, 0x4c,
+		0x47, 0x11, 0xca, 0xf2, 0x1a, 0x48, 0x9e, 0xdd, 0x71, 0x75, 0xa2, 0xea, 0x76, 0x93, 0xa8, 0x86,
+		0xb5, 0x67, 0x97, 0x0a, 0x94, 0x60, 0xa1, 0xb7, 0x23, 0x54, 0x71, 0xd5, 0x6e, 0x92, 0x35, 0x6b,
+		0xcf, 0x56, 0x26, 0xbd, 0x58, 0x59, 0x3e, 0x09, 0x39, 0xef, 0xd0, 0xf2, 0xb5, 0x5b, 0xa5, 0x22,
+		0xf5, 0x10, 0x5e, 0x2a, 0xff, 0x7a, 0x0e, 0xa6, 0x86, 0x71, 0xb1, 0x4b, 0x30, 0xba, 0x87, 0xbd,
+		0x2c, 0xa5, 0x8f, 0x63, 0x03, 0x86, 0x89, 0x1b, 0x31, 0xf7, 0x3e, 0x8d, 0xb8, 0x0c, 0xe3, 0x16,
+		0xf1, 0x7c, 0xd2, 0x64, 0x1e, 0x91, 0x19, 0xd2, 0xa7, 0x80, 0x81, 0x7a, 0x5d, 0x2a, 0xfb, 0xbe,
+		0x5c, 0xea, 0x15, 0x98, 0x0a, 0x9a, 0xa4, 0xba, 0x9a, 0xd5, 0x12, 0xbe, 0x79, 0x26, 0xa9, 0x25,
+		0x4b, 0x35, 0x81, 0x53, 0x10, 0xa6, 0x4c, 0x92, 0x58, 0x59, 0xae, 0x02, 0xd8, 0x16, 0xb1, 0xf7,
+		0xd4, 0x26, 0xd1, 0xcd, 0x52, 0x7e, 0x80, 0x95, 0xb6, 0x50, 0xa5, 0xc7, 0x4a, 0x36, 0x93, 0xea,
+		0xa6, 0x7c, 0x31, 0x74, 0xb5, 0xb1, 0x01, 0x9e, 0xb2, 0xc1, 0x26, 0x59, 0x8f, 0xb7, 0xed, 0xc0,
+		0xa4, 0x4b, 0xd0, 0xef, 0x49, 0x93, 0xf7, 0xac, 0x40, 0x1b, 0xb1, 0x94, 0xd8, 0x33, 0x85, 0xc3,
+		0x58, 0xc7, 0x26, 0xdc, 0x68, 0x51, 0x7e, 0x10, 0x02, 0x81, 0x4a, 0xdd, 0x0a, 0xe8, 0x2a, 0x54,
+		0x14, 0xc2, 0x4d, 0xad, 0x4d, 0xe6, 0xde, 0x80, 0xc9, 0xb8, 0x79, 0xe4, 0x59, 0x18, 0xf5, 0x7c,
+		0xcd, 0xf5, 0xa9, 0x17, 0x8e, 0x2a, 0xac, 0x20, 0x4b, 0x90, 0x21, 0x56, 0x93, 0xae, 0x72, 0xa3,
+		0x0a, 0xfe, 0x2b, 0xff, 0x89, 0xb0, 0xc3, 0x19, 0xda, 0xe1, 0x47, 0x7a, 0x47, 0x34, 0xc6, 0xdc,
+		0xdd, 0xef, 0xb9, 0x0b, 0x30, 0x11, 0xeb, 0xc0, 0xb0, 0x8f, 0x2e, 0xff, 0x0c, 0x9c, 0xe8, 0x4b,
+		0x2d, 0xbf, 0x02, 0xb3, 0x1d, 0xcb, 0xb0, 0x7c, 0xe2, 0x3a, 0x2e, 0x41, 0x8f, 0x65, 0x8f, 0x2a,
+		0xfd, 0xe7, 0xb1, 0x01, 0x3e, 0xb7, 0x13, 0xd5, 0x66, 0x2c, 0xca, 0x4c, 0xa7, 0x57, 0xf8, 0x78,
+		0x21, 0xff, 0x9d, 0x31, 0xe9, 0xcd, 0x37, 0xdf, 0x7c, 0x33, 0x5d, 0xfe, 0xe7, 0x39, 0x98, 0xed,
+		0x37, 0x67, 0xfa, 0x4e, 0xdf, 0x93, 0x90, 0xb3, 0x3a, 0xed, 0x5d, 0xe2, 0x52, 0x23, 0x8d, 0x2a,
+		0xbc, 0x24, 0x2f, 0xc3, 0xa8, 0xa9, 0xed, 0x12, 0xb3, 0x94, 0x5d, 0x4c, 0x9d, 0x9e, 0x3c, 0xfb,
+		0xc4, 0x50, 0xb3, 0x72, 0x69, 0x1d, 0x21, 0x0a, 0x43, 0xca, 0x1f, 0x86, 0x2c, 0x5f, 0xa2, 0x91,
+		0xe1, 0xf1, 0xe1, 0x18, 0x70, 0x2e, 0x29, 0x14, 0x27, 0xdf, 0x0b, 0x05, 0xfc, 0xcb, 0x7c, 0x23,
+		0x47, 0xdb, 0x9c, 0x47, 0x01, 0xfa, 0x85, 0x3c, 0x07, 0x79, 0x3a, 0x4d, 0x9a, 0x44, 0x6c, 0x6d,
+		0x41, 0x19, 0x1d, 0xab, 0x49, 0xf6, 0xb4, 0x8e, 0xe9, 0xab, 0x37, 0x34, 0xb3, 0x43, 0xa8, 0xc3,
+		0x17, 0x94, 0x22, 0x17, 0x5e, 0x47, 0x99, 0xbc, 0x00, 0xe3, 0x6c, 0x56, 0x19, 0x56, 0x93, 0xdc,
+		0xa2, 0xab, 0xe7, 0xa8, 0xc2, 0x26, 0xda, 0x1a, 0x4a, 0xf0, 0xf1, 0xaf, 0x7b, 0xb6, 0x25, 0x5c,
+		0x93, 0x3e, 0x02, 0x05, 0xf4, 0xf1, 0x17, 0xba, 0x17, 0xee, 0xfb, 0xfb, 0x77, 0xaf, 0x67, 0x2e,
+		0x3d, 0x0a, 0x53, 0x54, 0xe3, 0x59, 0x3e, 0xf4, 0x9a, 0x59, 0x9a, 0x5e, 0x4c, 0x9d, 0xce, 0x2b,
+		0x93, 0x4c, 0xbc, 0xc5, 0xa5, 0xe5, 0xaf, 0xa4, 0x21, 0x4b, 0x17, 0x96, 0x29, 0x18, 0xdf, 0x7e,
+		0xb5, 0x5e, 0x53, 0xab, 0x5b, 0x3b, 0x2b, 0xeb, 0x35, 0x29, 0x25, 0x4f, 0x02, 0x50, 0xc1, 0xe5,
+		0xf5, 0xad, 0xe5, 0x6d, 0x29, 0x1d, 0x94, 0xd7, 0x36, 0xb7, 0xcf, 0x3f, 0x27, 0x65, 0x02, 0xc0,
+		0x0e, 0x13, 0x64, 0xa3, 0x0a, 0xcf, 0x9e, 0x95, 0x46, 0x65, 0x09, 0x8a, 0x8c, 0x60, 0xed, 0x95,
+		0x5a, 0xf5, 0xfc, 0x73, 0x52, 0x2e, 0x2e, 0x79, 0xf6, 0xac, 0x34, 0x26, 0x4f, 0x40, 0x81, 0x4a,
+		0x56, 0xb6, 0xb6, 0xd6, 0xa5, 0x7c, 0xc0, 0xd9, 0xd8, 0x56, 0xd6, 0x36, 0xaf, 0x48, 0x85, 0x80,
+		0xf3, 0x8a, 0xb2, 0xb5, 0x53, 0x97, 0x20, 0x60, 0xd8, 0xa8, 0x35, 0x1a, 0xcb, 0x57, 0x6a, 0xd2,
+		0x78, 0xa0, 0xb1, 0xf2, 0xea, 0x76, 0xad, 0x21, 0x15, 0x63, 0xcd, 0x7a, 0xf6, 0xac, 0x34, 0x11,
+		0x3c, 0xa2, 0xb6, 0xb9, 0xb3, 0x21, 0x4d, 0xca, 0xd3, 0x30, 0xc1, 0x1e, 0x21, 0x1a, 0x31, 0xd5,
+		0x25, 0x3a, 0xff, 0x9c, 0x24, 0x85, 0x0d, 0x61, 0x2c, 0xd3, 0x31, 0xc1, 0xf9, 0xe7, 0x24, 0xb9,
+		0xbc, 0x0a, 0xa3, 0xd4, 0x0d, 0x65, 0x19, 0x26, 0xd7, 0x97, 0x57, 0x6a, 0xeb, 0xea, 0x56, 0x7d,
+		0x7b, 0x6d, 0x6b, 0x73, 0x79, 0x5d, 0x4a, 0x85, 0x32, 0xa5, 0xf6, 0xd2, 0xce, 0x9a, 0x52, 0xab,
+		0x4a, 0xe9, 0xa8, 0xac, 0x5e, 0x5b, 0xde, 0xae, 0x55, 0xa5, 0x4c, 0x59, 0x87, 0xd9, 0x7e, 0x0b,
+		0x6a, 0xdf, 0x29, 0x14, 0xf1, 0x85, 0xf4, 0x00, 0x5f, 0xa0, 0x5c, 0xdd, 0xbe, 0x50, 0xfe, 0x56,
+		0x1a, 0x66, 0xfa, 0x6c, 0x2a, 0x7d, 0x1f, 0xf2, 0x02, 0x8c, 0x32, 0x5f, 0x66, 0xdb, 0xec, 0x63,
+		0x7d, 0x77, 0x27, 0xea, 0xd9, 0x3d, 0x5b, 0x2d, 0xc5, 0x45, 0x43, 0x8d, 0xcc, 0x80, 0x50, 0x03,
+		0x29, 0x7a, 0x1c, 0xf6, 0xa7, 0x7a, 0x16, 0x7f, 0xb6, 0x3f, 0x9e, 0x1f, 0x66, 0x7f, 0xa4, 0xb2,
+		0xe3, 0x6d, 0x02, 0xa3, 0x7d, 0x36, 0x81, 0x4b, 0x30, 0xdd, 0x43, 0x34, 0xf4, 0x62, 0xfc, 0xd1,
+		0x14, 0x94, 0x06, 0x19, 0x27, 0x61, 0x49, 0x4c, 0xc7, 0x96, 0xc4, 0x4b, 0xdd, 0x16, 0x7c, 0x60,
+		0xf0, 0x20, 0xf4, 0x8c, 0xf5, 0xe7, 0x52, 0x70, 0xb2, 0x7f, 0x48, 0xd9, 0xb7, 0x0d, 0x1f, 0x86,
+		0x5c, 0x9b, 0xf8, 0xfb, 0xb6, 0x08, 0xab, 0x1e, 0xe9, 0xb3, 0x59, 0x63, 0x75, 0xf7, 0x60, 0x73,
+		0x94, 0x7c, 0xb1, 0xbb, 0xad, 0x0b, 0x83, 0x02, 0xdc, 0x9e, 0x96, 0x7e, 0x2c, 0x0d, 0x27, 0xfa,
+		0x92, 0xf7, 0x6d, 0xe8, 0xfd, 0x00, 0x86, 0xe5, 0x74, 0x7c, 0x16, 0x3a, 0xb1, 0x95, 0xb8, 0x40,
+		0x25, 0x74, 0xf1, 0xc2, 0x55, 0xb6, 0xe3, 0x07, 0xf5, 0x19, 0x5a, 0x0f, 0x4c, 0x44, 0x15, 0x9e,
+		0x0f, 0x1b, 0x9a, 0xa5, 0x0d, 0x9d, 0x1f, 0xd0, 0xd3, 0x1e, 0xc7, 0x7c, 0x1a, 0x24, 0xdd, 0x34,
+		0x88, 0xe5, 0xab, 0x9e, 0xef, 0x12, 0xad, 0x6d, 0x58, 0x2d, 0xba, 0xd5, 0xe4, 0x2b, 0xa3, 0x7b,
+		0x9a, 0xe9, 0x11, 0x65, 0x8a, 0x55, 0x37, 0x44, 0x2d, 0x22, 0xa8, 0x03, 0xb9, 0x11, 0x44, 0x2e,
+		0x86, 0x60, 0xd5, 0x01, 0xa2, 0xfc, 0x73, 0x05, 0x18, 0x8f, 0x04, 0xe0, 0xf2, 0x03, 0x50, 0x7c,
+		0x5d, 0xbb, 0xa1, 0xa9, 0xe2, 0x50, 0xc5, 0x2c, 0x31, 0x8e, 0xb2, 0x3a, 0x13, 0xc9, 0x4f, 0xc3,
+		0x2c, 0x55, 0xb1, 0x3b, 0x3e, 0x71, 0x55, 0xdd, 0xd4, 0x3c, 0x8f, 0x1a, 0x2d, 0x4f, 0x55, 0x65,
+		0xac, 0xdb, 0xc2, 0xaa, 0x55, 0x51, 0x23, 0x9f, 0x83, 0x19, 0x8a, 0x68, 0x77, 0x4c, 0xdf, 0x70,
+		0x4c, 0xa2, 0xe2, 0x31, 0xcf, 0x2b, 0x41, 0xb4, 0x65, 0xd3, 0xa8, 0xb1, 0xc1, 0x15, 0xb0, 0x45,
+		0x9e, 0x5c, 0x85, 0xfb, 0x29, 0xac, 0x45, 0x2c, 0xe2, 0x6a, 0x3e, 0x51, 0xc9, 0x4f, 0x77, 0x34,
+		0xd3, 0x53, 0x35, 0xab, 0xa9, 0xee, 0x6b, 0xde, 0x7e, 0x69, 0x16, 0x09, 0x56, 0xd2, 0xa5, 0x94,
+		0x72, 0x0a, 0x15, 0xaf, 0x70, 0xbd, 0x1a, 0x55, 0x5b, 0xb6, 0x9a, 0x57, 0x35, 0x6f, 0x5f, 0xae,
+		0xc0, 0x49, 0xca, 0xe2, 0xf9, 0xae, 0x61, 0xb5, 0x54, 0x7d, 0x9f, 0xe8, 0x07, 0x6a, 0xc7, 0xdf,
+		0x7b, 0xbe, 0x74, 0x6f, 0xf4, 0xf9, 0xb4, 0x85, 0x0d, 0xaa, 0xb3, 0x8a, 0x2a, 0x3b, 0xfe, 0xde,
+		0xf3, 0x72, 0x03, 0x8a, 0x38, 0x18, 0x6d, 0xe3, 0x0d, 0xa2, 0xee, 0xd9, 0x2e, 0xdd, 0x43, 0x27,
+		0xfb, 0x2c, 0x4d, 0x11, 0x0b, 0x2e, 0x6d, 0x71, 0xc0, 0x86, 0xdd, 0x24, 0x95, 0xd1, 0x46, 0xbd,
+		0x56, 0xab, 0x2a, 0xe3, 0x82, 0xe5, 0xb2, 0xed, 0xa2, 0x43, 0xb5, 0xec, 0xc0, 0xc0, 0xe3, 0xcc,
+		0xa1, 0x5a, 0xb6, 0x30, 0xef, 0x39, 0x98, 0xd1, 0x75, 0xd6, 0x67, 0x43, 0x57, 0xf9, 0x61, 0xcc,
+		0x2b, 0x49, 0x31, 0x63, 0xe9, 0xfa, 0x15, 0xa6, 0xc0, 0x7d, 0xdc, 0x93, 0x2f, 0xc2, 0x89, 0xd0,
+		0x58, 0x51, 0xe0, 0x74, 0x4f, 0x2f, 0xbb, 0xa1, 0xe7, 0x60, 0xc6, 0x39, 0xec, 0x05, 0xca, 0xb1,
+		0x27, 0x3a, 0x87, 0xdd, 0xb0, 0x0b, 0x30, 0xeb, 0xec, 0x3b, 0xbd, 0xb8, 0xc7, 0xa3, 0x38, 0xd9,
+		0xd9, 0x77, 0xba, 0x81, 0x0f, 0xd3, 0x93, 0xb9, 0x4b, 0x74, 0xcd, 0x27, 0xcd, 0xd2, 0x3d, 0x51,
+		0xf5, 0x48, 0x85, 0xbc, 0x04, 0x92, 0xae, 0xab, 0xc4, 0xd2, 0x76, 0x4d, 0xa2, 0x6a, 0x2e, 0xb1,
+		0x34, 0xaf, 0xb4, 0x40, 0x95, 0xb3, 0xbe, 0xdb, 0x21, 0xca, 0xa4, 0xae, 0xd7, 0x68, 0xe5, 0x32,
+		0xad, 0x93, 0x1f, 0x87, 0x69, 0x7b, 0xf7, 0x75, 0x9d, 0x79, 0xa4, 0xea, 0xb8, 0x64, 0xcf, 0xb8,
+		0x55, 0x7a, 0x88, 0x9a, 0x77, 0x0a, 0x2b, 0xa8, 0x3f, 0xd6, 0xa9, 0x58, 0x7e, 0x0c, 0x24, 0xdd,
+		0xdb, 0xd7, 0x5c, 0x87, 0x2e, 0xc9, 0x9e, 0xa3, 0xe9, 0xa4, 0xf4, 0x30, 0x53, 0x65, 0xf2, 0x4d,
+		0x21, 0xc6, 0x19, 0xe1, 0xdd, 0x34, 0xf6, 0x7c, 0xc1, 0xf8, 0x28, 0x9b, 0x11, 0x54, 0xc6, 0xd9,
+		0x4e, 0x83, 0x84, 0x96, 0x88, 0x3d, 0xf8, 0x34, 0x55, 0x9b, 0x74, 0xf6, 0x9d, 0xe8, 0x73, 0x1f,
+		0x84, 0x09, 0x67, 0x3f, 0xfa, 0xd0, 0xc7, 0x58, 0xe0, 0xe6, 0xec, 0x47, 0x9e, 0xf8, 0x1c, 0x9c,
+		0x44, 0xa5, 0x36, 0xf1, 0xb5, 0xa6, 0xe6, 0x6b, 0x11, 0xed, 0x27, 0xa9, 0x36, 0x9a, 0x7d, 0x83,
+		0x57, 0xc6, 0xda, 0xe9, 0x76, 0x76, 0x0f, 0x03, 0xc7, 0x7a, 0x8a, 0xb5, 0x13, 0x65, 0xc2, 0xb5,
+		0x3e, 0xb0, 0xe0, 0xbc, 0x5c, 0x81, 0x62, 0xd4, 0xef, 0xe5, 0x02, 0x30, 0xcf, 0x97, 0x52, 0x18,
+		0x04, 0xad, 0x6e, 0x55, 0x31, 0x7c, 0x79, 0xad, 0x26, 0xa5, 0x31, 0x8c, 0x5a, 0x5f, 0xdb, 0xae,
+		0xa9, 0xca, 0xce, 0xe6, 0xf6, 0xda, 0x46, 0x4d, 0xca, 0x44, 0x02, 0xfb, 0x6b, 0xd9, 0xfc, 0x23,
+		0xd2, 0xa3, 0xe5, 0x6f, 0xa6, 0x61, 0x32, 0x7e, 0x52, 0x93, 0x7f, 0x02, 0xee, 0x11, 0x69, 0x15,
+		0x8f, 0xf8, 0xea, 0x4d, 0xc3, 0xa5, 0x13, 0xb2, 0xad, 0xb1, 0xcd, 0x31, 0xf0, 0x9f, 0x59, 0xae,
+		0xd5, 0x20, 0xfe, 0xcb, 0x86, 0x8b, 0xd3, 0xad, 0xad, 0xf9, 0xf2, 0x3a, 0x2c, 0x58, 0xb6, 0xea,
+		0xf9, 0x9a, 0xd5, 0xd4, 0xdc, 0xa6, 0x1a, 0x26, 0xb4, 0x54, 0x4d, 0xd7, 0x89, 0xe7, 0xd9, 0x6c,
+		0x23, 0x0c, 0x58, 0xee, 0xb3, 0xec, 0x06, 0x57, 0x0e, 0x77, 0x88, 0x65, 0xae, 0xda, 0xe5, 0xbe,
+		0x99, 0x41, 0xee, 0x7b, 0x2f, 0x14, 0xda, 0x9a, 0xa3, 0x12, 0xcb, 0x77, 0x0f, 0x69, 0x7c, 0x9e,
+		0x57, 0xf2, 0x6d, 0xcd, 0xa9, 0x61, 0xf9, 0x47, 0x72, 0x4c, 0xba, 0x96, 0xcd, 0xe7, 0xa5, 0xc2,
+		0xb5, 0x6c, 0xbe, 0x20, 0x41, 0xf9, 0xdd, 0x0c, 0x14, 0xa3, 0xf1, 0x3a, 0x1e, 0x7f, 0x74, 0xba,
+		0x63, 0xa5, 0xe8, 0x9a, 0xf6, 0xe0, 0x91, 0xd1, 0xfd, 0xd2, 0x2a, 0x6e, 0x65, 0x95, 0x1c, 0x0b,
+		0x8e, 0x15, 0x86, 0xc4, 0x30, 0x02, 0x9d, 0x8d, 0xb0, 0x60, 0x24, 0xaf, 0xf0, 0x92, 0x7c, 0x05,
+		0x72, 0xaf, 0x7b, 0x94, 0x3b, 0x47, 0xb9, 0x1f, 0x3a, 0x9a, 0xfb, 0x5a, 0x83, 0x92, 0x17, 0xae,
+		0x35, 0xd4, 0xcd, 0x2d, 0x65, 0x63, 0x79, 0x5d, 0xe1, 0x70, 0xf9, 0x14, 0x64, 0x4d, 0xed, 0x8d,
+		0xc3, 0xf8, 0xa6, 0x47, 0x45, 0xc3, 0x0e, 0xc2, 0x29, 0xc8, 0x62, 0x82, 0x2e, 0xbe, 0xd5, 0x50,
+		0xd1, 0x07, 0x38, 0x19, 0xce, 0xc0, 0x28, 0xb5, 0x97, 0x0c, 0xc0, 0x2d, 0x26, 0x8d, 0xc8, 0x79,
+		0xc8, 0xae, 0x6e, 0x29, 0x38, 0x21, 0x24, 0x28, 0x32, 0xa9, 0x5a, 0x5f, 0xab, 0xad, 0xd6, 0xa4,
+		0x74, 0xf9, 0x1c, 0xe4, 0x98, 0x11, 0x70, 0xb2, 0x04, 0x66, 0x90, 0x46, 0x78, 0x91, 0x73, 0xa4,
+		0x44, 0xed, 0xce, 0xc6, 0x4a, 0x4d, 0x91, 0xd2, 0xf1, 0xa1, 0xce, 0x4a, 0xa3, 0x65, 0x0f, 0x8a,
+		0xd1, 0x38, 0xfc, 0x47, 0x73, 0x18, 0xff, 0x6a, 0x0a, 0xc6, 0x23, 0x71, 0x35, 0x06, 0x44, 0x9a,
+		0x69, 0xda, 0x37, 0x55, 0xcd, 0x34, 0x34, 0x8f, 0xbb, 0x06, 0x50, 0xd1, 0x32, 0x4a, 0x86, 0x1d,
+		0xba, 0x1f, 0xd1, 0x14, 0x19, 0x95, 0x72, 0xe5, 0x4f, 0xa7, 0x40, 0xea, 0x0e, 0x6c, 0xbb, 0x9a,
+		0x99, 0xfa, 0xa3, 0x6c, 0x66, 0xf9, 0x53, 0x29, 0x98, 0x8c, 0x47, 0xb3, 0x5d, 0xcd, 0x7b, 0xe0,
+		0x8f, 0xb4, 0x79, 0xbf, 0x9f, 0x86, 0x89, 0x58, 0x0c, 0x3b, 0x6c, 0xeb, 0x7e, 0x1a, 0xa6, 0x8d,
+		0x26, 0x69, 0x3b, 0xb6, 0x8f, 0xc9, 0x73, 0xd5, 0x24, 0x37, 0x88, 0x59, 0x2a, 0xd3, 0x45, 0xe3,
+		0xcc, 0xd1, 0x51, 0xf2, 0xd2, 0x5a, 0x88, 0x5b, 0x47, 0x58, 0x65, 0x66, 0xad, 0x5a, 0xdb, 0xa8,
+		0x6f, 0x6d, 0xd7, 0x36, 0x57, 0x5f, 0x55, 0x77, 0x36, 0x5f, 0xdc, 0xdc, 0x7a, 0x79, 0x53, 0x91,
+		0x8c, 0x2e, 0xb5, 0x0f, 0x70, 0xda, 0xd7, 0x41, 0xea, 0x6e, 0x94, 0x7c, 0x0f, 0xf4, 0x6b, 0x96,
+		0x34, 0x22, 0xcf, 0xc0, 0xd4, 0xe6, 0x96, 0xda, 0x58, 0xab, 0xd6, 0xd4, 0xda, 0xe5, 0xcb, 0xb5,
+		0xd5, 0xed, 0x06, 0xcb, 0x7b, 0x04, 0xda, 0xdb, 0xb1, 0x09, 0x5e, 0xfe, 0x64, 0x06, 0x66, 0xfa,
+		0xb4, 0x44, 0x5e, 0xe6, 0x27, 0x16, 0x76, 0x88, 0x7a, 0x6a, 0x98, 0xd6, 0x2f, 0x61, 0xcc, 0x50,
+		0xd7, 0x5c, 0x9f, 0x1f, 0x70, 0x1e, 0x03, 0xb4, 0x92, 0xe5, 0x1b, 0x7b, 0x06, 0x71, 0x79, 0x3e,
+		0x89, 0x1d, 0x63, 0xa6, 0x42, 0x39, 0x4b, 0x29, 0x3d, 0x09, 0xb2, 0x63, 0x7b, 0x86, 0x6f, 0xdc,
+		0xc0, 0x94, 0xbc, 0x48, 0x3e, 0xe1, 0xb1, 0x26, 0xab, 0x48, 0xa2, 0x66, 0xcd, 0xf2, 0x03, 0x6d,
+		0x8b, 0xb4, 0xb4, 0x2e, 0x6d, 0x5c, 0xcc, 0x33, 0x8a, 0x24, 0x6a, 0x02, 0xed, 0x07, 0xa0, 0xd8,
+		0xb4, 0x3b, 0x18, 0xeb, 0x31, 0x3d, 0xdc, 0x3b, 0x52, 0xca, 0x38, 0x93, 0x05, 0x2a, 0x3c, 0x8a,
+		0x0f, 0xb3, 0x5e, 0x45, 0x65, 0x9c, 0xc9, 0x98, 0xca, 0xa3, 0x30, 0xa5, 0xb5, 0x5a, 0x2e, 0x92,
+		0x0b, 0x22, 0x76, 0x2e, 0x99, 0x0c, 0xc4, 0x54, 0x71, 0xee, 0x1a, 0xe4, 0x85, 0x1d, 0x70, 0xab,
+		0x46, 0x4b, 0xa8, 0x0e, 0x3b, 0x6c, 0xa7, 0x31, 0x11, 0x66, 0x89, 0xca, 0x07, 0xa0, 0x68, 0x78,
+		0x6a, 0x98, 0xc4, 0x4f, 0x2f, 0xa6, 0x4f, 0xe7, 0x95, 0x71, 0xc3, 0x0b, 0x12, 0xa0, 0xe5, 0xcf,
+		0xa5, 0x61, 0x32, 0x7e, 0x09, 0x21, 0x57, 0x21, 0x6f, 0xda, 0xba, 0x46, 0x5d, 0x8b, 0xdd, 0x80,
+		0x9d, 0x4e, 0xb8, 0xb7, 0x58, 0x5a, 0xe7, 0xfa, 0x4a, 0x80, 0x9c, 0xfb, 0x37, 0x29, 0xc8, 0x0b,
+		0xb1, 0x7c, 0x12, 0xb2, 0x8e, 0xe6, 0xef, 0x53, 0xba, 0xd1, 0x95, 0xb4, 0x94, 0x52, 0x68, 0x19,
+		0xe5, 0x9e, 0xa3, 0x59, 0xa5, 0x74, 0x28, 0xc7, 0x32, 0x8e, 0xab, 0x49, 0xb4, 0x26, 0x3d, 0xf4,
+		0xd8, 0xed, 0x36, 0xb1, 0x7c, 0x4f, 0x8c, 0x2b, 0x97, 0xaf, 0x72, 0x31, 0xde, 0x85, 0xf9, 0xae,
+		0x66, 0x98, 0x31, 0xdd, 0x2c, 0xd5, 0x95, 0x44, 0x45, 0xa0, 0x5c, 0x81, 0x53, 0x82, 0xb7, 0x49,
+		0x7c, 0x4d, 0xdf, 0x27, 0xcd, 0x10, 0x94, 0xa3, 0xc9, 0x8d, 0x7b, 0xb8, 0x42, 0x95, 0xd7, 0x0b,
+		0x6c, 0xf9, 0x9b, 0x29, 0x98, 0x16, 0xc7, 0xb4, 0x66, 0x60, 0xac, 0x0d, 0x00, 0xcd, 0xb2, 0x6c,
+		0x3f, 0x6a, 0xae, 0x5e, 0x57, 0xee, 0xc1, 0x2d, 0x2d, 0x07, 0x20, 0x25, 0x42, 0x30, 0xd7, 0x06,
+		0x08, 0x6b, 0x06, 0x9a, 0x6d, 0x01, 0xc6, 0xf9, 0x0d, 0x13, 0xbd, 0xa6, 0x64, 0x07, 0x7b, 0x60,
+		0x22, 0x3c, 0xcf, 0x61, 0xfa, 0x65, 0x97, 0xb4, 0x0c, 0x8b, 0xe7, 0x8d, 0x59, 0x41, 0xa4, 0x5f,
+		0xb2, 0x41, 0xfa, 0x65, 0xe5, 0xcf, 0xc0, 0x8c, 0x6e, 0xb7, 0xbb, 0x9b, 0xbb, 0x22, 0x75, 0x25,
+		0x17, 0xbc, 0xab, 0xa9, 0xd7, 0x9e, 0xe2, 0x4a, 0x2d, 0xdb, 0xd4, 0xac, 0xd6, 0x92, 0xed, 0xb6,
+		0xc2, 0x6b, 0x56, 0x8c, 0x78, 0xbc, 0xc8, 0x65, 0xab, 0xb3, 0xfb, 0xbf, 0x53, 0xa9, 0x5f, 0x4e,
+		0x67, 0xae, 0xd4, 0x57, 0x3e, 0x9f, 0x9e, 0xbb, 0xc2, 0x80, 0x75, 0x61, 0x0c, 0x85, 0xec, 0x99,
+		0x44, 0xc7, 0x0e, 0xc2, 0x77, 0x9f, 0x80, 0xd9, 0x96, 0xdd, 0xb2, 0x29, 0xd3, 0x19, 0xfc, 0x8f,
+		0xdf, 0xd3, 0x16, 0x02, 0xe9, 0x5c, 0xe2, 0xa5, 0x6e, 0x65, 0x13, 0x66, 0xb8, 0xb2, 0x4a, 0x2f,
+		0x8a, 0xd8, 0x31, 0x46, 0x3e, 0x32, 0x87, 0x56, 0xfa, 0xe2, 0xb7, 0xe9, 0xf6, 0xad, 0x4c, 0x73,
+		0x28, 0xd6, 0xb1, 0x93, 0x4e, 0x45, 0x81, 0x13, 0x31, 0x3e, 0x36, 0x49, 0x89, 0x9b, 0xc0, 0xf8,
+		0x2f, 0x39, 0xe3, 0x4c, 0x84, 0xb1, 0xc1, 0xa1, 0x95, 0x55, 0x98, 0x38, 0x0e, 0xd7, 0xbf, 0xe2,
+		0x5c, 0x45, 0x12, 0x25, 0xb9, 0x02, 0x53, 0x94, 0x44, 0xef, 0x78, 0xbe, 0xdd, 0xa6, 0x2b, 0xe0,
+		0xd1, 0x34, 0xff, 0xfa, 0xdb, 0x6c, 0xd6, 0x4c, 0x22, 0x6c, 0x35, 0x40, 0x55, 0x2a, 0x40, 0xef,
+		0xc6, 0xf0, 0xce, 0x2a, 0x81, 0xe1, 0x6b, 0xbc, 0x21, 0x81, 0x7e, 0xe5, 0x3a, 0xcc, 0xe2, 0xff,
+		0x74, 0x81, 0x8a, 0xb6, 0x24, 0x39, 0xe1, 0x56, 0xfa, 0xe6, 0x47, 0xd9, 0xc4, 0x9c, 0x09, 0x08,
+		0x22, 0x6d, 0x8a, 0x8c, 0x62, 0x8b, 0xf8, 0x3e, 0x71, 0x3d, 0x55, 0x33, 0xfb, 0x35, 0x2f, 0x92,
+		0xb1, 0x28, 0xfd, 0xe2, 0xf7, 0xe2, 0xa3, 0x78, 0x85, 0x21, 0x97, 0x4d, 0xb3, 0xb2, 0x03, 0xf7,
+		0xf4, 0xf1, 0x8a, 0x21, 0x38, 0x3f, 0xc9, 0x39, 0x67, 0x7b, 0x3c, 0x03, 0x69, 0xeb, 0x20, 0xe4,
+		0xc1, 0x58, 0x0e, 0xc1, 0xf9, 0x4b, 0x9c, 0x53, 0xe6, 0x58, 0x31, 0xa4, 0xc8, 0x78, 0x0d, 0xa6,
+		0x6f, 0x10, 0x77, 0xd7, 0xf6, 0x78, 0x96, 0x68, 0x08, 0xba, 0x4f, 0x71, 0xba, 0x29, 0x0e, 0xa4,
+		0x69, 0x23, 0xe4, 0xba, 0x08, 0xf9, 0x3d, 0x4d, 0x27, 0x43, 0x50, 0xdc, 0xe1, 0x14, 0x63, 0xa8,
+		0x8f, 0xd0, 0x65, 0x28, 0xb6, 0x6c, 0xbe, 0x47, 0x25, 0xc3, 0x3f, 0xcd, 0xe1, 0xe3, 0x02, 0xc3,
+		0x29, 0x1c, 0xdb, 0xe9, 0x98, 0xb8, 0x81, 0x25, 0x53, 0xfc, 0x0d, 0x41, 0x21, 0x30, 0x9c, 0xe2,
+		0x18, 0x66, 0x7d, 0x4b, 0x50, 0x78, 0x11, 0x7b, 0xbe, 0x80, 0x97, 0x47, 0xe6, 0xa1, 0x6d, 0x0d,
+		0xd3, 0x88, 0xb7, 0x39, 0x03, 0x70, 0x08, 0x12, 0x5c, 0x82, 0xc2, 0xb0, 0x03, 0xf1, 0x37, 0xbf,
+		0x27, 0xa6, 0x87, 0x18, 0x81, 0x2b, 0x30, 0x25, 0x16, 0x28, 0xbc, 0x6c, 0x4e, 0xa6, 0xf8, 0x5b,
+		0x9c, 0x62, 0x32, 0x02, 0xe3, 0xdd, 0xf0, 0x89, 0xe7, 0xb7, 0xc8, 0x30, 0x24, 0x9f, 0x13, 0xdd,
+		0xe0, 0x10, 0x6e, 0xca, 0x5d, 0x62, 0xe9, 0xfb, 0xc3, 0x31, 0xfc, 0x8a, 0x30, 0xa5, 0xc0, 0x20,
+		0xc5, 0x2a, 0x4c, 0xb4, 0x35, 0xd7, 0xdb, 0xd7, 0xcc, 0xa1, 0x86, 0xe3, 0x6f, 0x73, 0x8e, 0x62,
+		0x00, 0xe2, 0x16, 0xe9, 0x58, 0xc7, 0xa1, 0xf9, 0xbc, 0xb0, 0x48, 0xc7, 0x8a, 0x11, 0xd5, 0x61,
+		0xd6, 0xf3, 0x69, 0x4a, 0xed, 0x38, 0x6c, 0x7f, 0x47, 0x4c, 0x3d, 0x86, 0xdd, 0x88, 0x32, 0x5e,
+		0x82, 0x82, 0x67, 0xbc, 0x31, 0x14, 0xcd, 0x17, 0xc4, 0x48, 0x53, 0x00, 0x82, 0x5f, 0x85, 0x53,
+		0x7d, 0xb7, 0x89, 0x21, 0xc8, 0xfe, 0x2e, 0x27, 0x3b, 0xd9, 0x67, 0xab, 0xe0, 0x4b, 0xc2, 0x71,
+		0x29, 0xff, 0x9e, 0x58, 0x12, 0x48, 0x17, 0x57, 0x1d, 0x4f, 0x0d, 0x9e, 0xb6, 0x77, 0x3c, 0xab,
+		0xfd, 0x7d, 0x61, 0x35, 0x86, 0x8d, 0x59, 0x6d, 0x1b, 0x4e, 0x72, 0xc6, 0xe3, 0x8d, 0xeb, 0xaf,
+		0x8a, 0x85, 0x95, 0xa1, 0x77, 0xe2, 0xa3, 0xfb, 0x93, 0x30, 0x17, 0x98, 0x53, 0x84, 0xa7, 0x9e,
+		0x8a, 0x79, 0xa8, 0x64, 0xe6, 0x2f, 0x72, 0x66, 0xb1, 0xe2, 0x07, 0xf1, 0xad, 0xb7, 0xa1, 0x39,
+		0x48, 0xfe, 0x0a, 0x94, 0x04, 0x79, 0xc7, 0x72, 0x89, 0x6e, 0xb7, 0x2c, 0xe3, 0x0d, 0xd2, 0x1c,
+		0x82, 0xfa, 0xd7, 0xba, 0x86, 0x6a, 0x27, 0x02, 0x47, 0xe6, 0x35, 0x90, 0x82, 0x58, 0x45, 0x35,
+		0xda, 0x8e, 0xed, 0xfa, 0x09, 0x8c, 0x5f, 0x12, 0x23, 0x15, 0xe0, 0xd6, 0x28, 0xac, 0x52, 0x03,
+		0x76, 0xcf, 0x3c, 0xac, 0x4b, 0x7e, 0x99, 0x13, 0x4d, 0x84, 0x28, 0xbe, 0x70, 0xe8, 0x76, 0xdb,
+		0xd1, 0xdc, 0x61, 0xd6, 0xbf, 0x7f, 0x20, 0x16, 0x0e, 0x0e, 0xe1, 0x0b, 0x07, 0x46, 0x74, 0xb8,
+		0xdb, 0x0f, 0xc1, 0xf0, 0x15, 0xb1, 0x70, 0x08, 0x0c, 0xa7, 0x10, 0x01, 0xc3, 0x10, 0x14, 0xff,
+		0x50, 0x50, 0x08, 0x0c, 0x52, 0xbc, 0x14, 0x6e, 0xb4, 0x2e, 0x69, 0x19, 0x9e, 0xef, 0xb2, 0xa0,
+		0xf8, 0x68, 0xaa, 0x7f, 0xf4, 0xbd, 0x78, 0x10, 0xa6, 0x44, 0xa0, 0xb8, 0x12, 0xf1, 0x24, 0x2b,
+		0x3d, 0x33, 0x25, 0x37, 0xec, 0xd7, 0xc5, 0x4a, 0x14, 0x81, 0x61, 0xdb, 0x22, 0x11, 0x22, 0x9a,
+		0x5d, 0xc7, 0x93, 0xc2, 0x10, 0x74, 0xff, 0xb8, 0xab, 0x71, 0x0d, 0x81, 0x45, 0xce, 0x48, 0xfc,
+		0xd3, 0xb1, 0x0e, 0xc8, 0xe1, 0x50, 0xde, 0xf9, 0x1b, 0x5d, 0xf1, 0xcf, 0x0e, 0x43, 0xb2, 0x35,
+		0x64, 0xaa, 0x2b, 0x9e, 0x92, 0x93, 0xde, 0x2a, 0x2a, 0x7d, 0xe4, 0x3d, 0xde, 0xdf, 0x78, 0x38,
+		0x55, 0x59, 0x07, 0x89, 0x4b, 0xc2, 0x00, 0x36, 0x91, 0xec, 0xa3, 0xef, 0x05, 0x7e, 0x1e, 0x8b,
+		0x79, 0x2a, 0x97, 0x61, 0x22, 0x16, 0xf0, 0x24, 0x53, 0xfd, 0x59, 0x4e, 0x55, 0x8c, 0xc6, 0x3b,
+		0x95, 0x73, 0x90, 0xc5, 0xe0, 0x25, 0x19, 0xfe, 0xe7, 0x38, 0x9c, 0xaa, 0x57, 0x3e, 0x04, 0x79,
+		0x11, 0xb4, 0x24, 0x43, 0xff, 0x3c, 0x87, 0x06, 0x10, 0x84, 0x8b, 0x80, 0x25, 0x19, 0xfe, 0x17,
+		0x04, 0x5c, 0x40, 0x10, 0x3e, 0xbc, 0x09, 0xbf, 0xfa, 0x17, 0xb3, 0x0c, 0x2e, 0x20, 0x15, 0xbc,
+		0xe7, 0x66, 0x91, 0x4a, 0x32, 0xfa, 0x63, 0xfc, 0xe1, 0x02, 0x51, 0xb9, 0x00, 0xa3, 0x43, 0x1a,
+		0xfc, 0x2f, 0x71, 0x28, 0xd3, 0xaf, 0xac, 0xc2, 0x78, 0x24, 0x3a, 0x49, 0x86, 0xff, 0x65, 0x0e,
+		0x8f, 0xa2, 0xb0, 0xe9, 0x3c, 0x3a, 0x49, 0x26, 0xf8, 0x2b, 0xa2, 0xe9, 0x1c, 0x81, 0x66, 0x13,
+		0x81, 0x49, 0x32, 0xfa, 0xe3, 0xc2, 0xea, 0x02, 0x52, 0x79, 0x01, 0x0a, 0xc1, 0x66, 0x93, 0x8c,
+		0xff, 0x39, 0x8e, 0x0f, 0x31, 0x68, 0x81, 0x8e, 0x75, 0x0c, 0x8a, 0xbf, 0x2a, 0x2c, 0x10, 0x41,
+		0xe1, 0x34, 0xea, 0x0e, 0x60, 0x92, 0x99, 0x7e, 0x5e, 0x4c, 0xa3, 0xae, 0xf8, 0x05, 0x47, 0x93,
+		0xae, 0xf9, 0xc9, 0x14, 0x7f, 0x4d, 0x8c, 0x26, 0xd5, 0xc7, 0x66, 0x74, 0x47, 0x04, 0xc9, 0x1c,
+		0xbf, 0x20, 0x9a, 0xd1, 0x15, 0x10, 0x54, 0xea, 0x20, 0xf7, 0x46, 0x03, 0xc9, 0x7c, 0x9f, 0xe0,
+		0x7c, 0xd3, 0x3d, 0xc1, 0x40, 0xe5, 0x65, 0x38, 0xd9, 0x3f, 0x12, 0x48, 0x66, 0xfd, 0xc5, 0xf7,
+		0xba, 0xce, 0x6e, 0xd1, 0x40, 0xa0, 0xb2, 0x0d, 0xb3, 0xfd, 0xa2, 0x80, 0x64, 0xda, 0x4f, 0xbe,
+		0x17, 0x5f, 0xb8, 0xa3, 0x41, 0x40, 0x65, 0x19, 0x20, 0xdc, 0x80, 0x93, 0xb9, 0x3e, 0xc5, 0xb9,
+		0x22, 0x20, 0x9c, 0x1a, 0x7c, 0xff, 0x4d, 0xc6, 0xdf, 0x11, 0x53, 0x83, 0x23, 0x70, 0x6a, 0x88,
+		0xad, 0x37, 0x19, 0xfd, 0x69, 0x31, 0x35, 0x04, 0x04, 0x3d, 0x3b, 0xb2, 0xbb, 0x25, 0x33, 0xbc,
+		0x2d, 0x3c, 0x3b, 0x82, 0xaa, 0x6c, 0xc2, 0x74, 0xcf, 0x86, 0x98, 0x4c, 0xf5, 0xcb, 0x9c, 0x4a,
+		0xea, 0xde, 0x0f, 0xa3, 0x9b, 0x17, 0xdf, 0x0c, 0x93, 0xd9, 0x3e, 0xd3, 0xb5, 0x79, 0xf1, 0xbd,
+		0xb0, 0x72, 0x09, 0xf2, 0x56, 0xc7, 0x34, 0x71, 0xf2, 0xc8, 0x47, 0xbf, 0x09, 0x58, 0xfa, 0x2f,
+		0x3f, 0xe4, 0xd6, 0x11, 0x80, 0xca, 0x39, 0x18, 0x25, 0xed, 0x5d, 0xd2, 0x4c, 0x42, 0x7e, 0xf7,
+		0x87, 0x62, 0xc1, 0x44, 0xed, 0xca, 0x0b, 0x00, 0x2c, 0x35, 0x42, 0x2f, 0x03, 0x13, 0xb0, 0xff,
+		0xf5, 0x87, 0xfc, 0xd5, 0x9b, 0x10, 0x12, 0x12, 0xb0, 0x17, 0x79, 0x8e, 0x26, 0xf8, 0x5e, 0x9c,
+		0x80, 0x8e, 0xc8, 0x45, 0x18, 0xc3, 0x17, 0x22, 0x7d, 0xad, 0x95, 0x84, 0xfe, 0x6f, 0x1c, 0x2d,
+		0xf4, 0xd1, 0x60, 0x6d, 0xdb, 0x25, 0xbe, 0xd6, 0xf2, 0x92, 0xb0, 0xff, 0x9d, 0x63, 0x03, 0x00,
+		0x82, 0x75, 0xcd, 0xf3, 0x87, 0xe9, 0xf7, 0x1f, 0x08, 0xb0, 0x00, 0x60, 0xa3, 0xf1, 0xff, 0x03,
+		0x72, 0x98, 0x84, 0xfd, 0xbe, 0x68, 0x34, 0xd7, 0xaf, 0x7c, 0x08, 0x0a, 0xf8, 0x2f, 0x7b, 0x9f,
+		0x2e, 0x01, 0xfc, 0x3f, 0x38, 0x38, 0x44, 0xe0, 0x93, 0x3d, 0xbf, 0xe9, 0x1b, 0xc9, 0xc6, 0xfe,
+		0x01, 0x1f, 0x69, 0xa1, 0x5f, 0x59, 0x86, 0x71, 0xcf, 0x6f, 0x36, 0x3b, 0x3c, 0x3e, 0x4d, 0x80,
+		0xff, 0xcf, 0x1f, 0x06, 0x29, 0x8b, 0x00, 0x83, 0xa3, 0x7d, 0xf3, 0xc0, 0x77, 0x6c, 0x7a, 0xe1,
+		0x91, 0xc4, 0xf0, 0x1e, 0x67, 0x88, 0x40, 0x2a, 0xab, 0x50, 0xc4, 0xbe, 0xb8, 0xc4, 0x21, 0xf4,
+		0x76, 0x2a, 0x81, 0xe2, 0x7f, 0x71, 0x03, 0xc4, 0x40, 0x2b, 0x3f, 0xf5, 0xb5, 0x77, 0xe7, 0x53,
+		0xdf, 0x78, 0x77, 0x3e, 0xf5, 0xfb, 0xef, 0xce, 0xa7, 0x3e, 0xfe, 0xad, 0xf9, 0x91, 0x6f, 0x7c,
+		0x6b, 0x7e, 0xe4, 0x77, 0xbe, 0x35, 0x3f, 0xd2, 0x3f, 0x4b, 0x0c, 0x57, 0xec, 0x2b, 0x36, 0xcb,
+		0x0f, 0xbf, 0x56, 0x6e, 0x19, 0xfe, 0x7e, 0x67, 0x77, 0x49, 0xb7, 0xdb, 0x34, 0x8d, 0x1b, 0x66,
+		0x6b, 0x83, 0x43, 0x0e, 0x7c, 0x24, 0x0d, 0xa7, 0x18, 0x47, 0x58, 0xab, 0x59, 0x87, 0x03, 0xbe,
+		0xcc, 0x99, 0xeb, 0x9b, 0x18, 0x2e, 0x5f, 0x85, 0xcc, 0xb2, 0x75, 0x28, 0x9f, 0x62, 0x6b, 0x9e,
+		0xda, 0x71, 0x4d, 0xfe, 0x9e, 0xd7, 0x18, 0x96, 0x77, 0x5c, 0x13, 0x73, 0xdf, 0xe2, 0x65, 0x4c,
+		0xbc, 0x62, 0x61, 0x85, 0x8a, 0xf4, 0x89, 0xb7, 0x16, 0x46, 0x7e, 0xf5, 0xad, 0x85, 0x91, 0xef,
+		0xbf, 0xbd, 0x30, 0xf2, 0xe6, 0xef, 0x2d, 0x8e, 0xac, 0x1c, 0x74, 0xf7, 0xf6, 0xab, 0x89, 0x3d,
+		0xce, 0x2f, 0x5b, 0x87, 0xb4, 0xc3, 0xf5, 0xd4, 0x6b, 0xa3, 0xf8, 0x3c, 0x4f, 0x24, 0xb9, 0xe7,
+		0xbb, 0x93, 0xdc, 0x2f, 0x13, 0xd3, 0x7c, 0xd1, 0xb2, 0x6f, 0x5a, 0x78, 0x37, 0xee, 0xed, 0xe6,
+		0xd8, 0x0b, 0xc4, 0xf0, 0xf3, 0x69, 0x98, 0xef, 0xc9, 0x67, 0x73, 0x2f, 0x18, 0xf4, 0x89, 0x52,
+		0x05, 0xf2, 0x55, 0xe1, 0x5c, 0x25, 0xfc, 0x36, 0x46, 0xb7, 0xad, 0xa6, 0x47, 0xbb, 0x9d, 0x51,
+		0x44, 0x11, 0xbb, 0x6d, 0x69, 0x96, 0xed, 0xf1, 0xf7, 0x22, 0x59, 0x61, 0xe5, 0x97, 0x52, 0xc7,
+		0x1b, 0xd3, 0x09, 0xf1, 0x24, 0xd1, 0xcd, 0x67, 0x12, 0xd3, 0xfe, 0x07, 0xd8, 0xcb, 0xa0, 0x13,
+		0xb1, 0xd4, 0xff, 0xb0, 0x56, 0xf9, 0x85, 0x34, 0x2c, 0x74, 0x5b, 0x05, 0xa7, 0x96, 0xe7, 0x6b,
+		0x6d, 0x67, 0x90, 0x59, 0x2e, 0x41, 0x61, 0x5b, 0xe8, 0x1c, 0xdb, 0x2e, 0x77, 0x8e, 0x69, 0x97,
+		0xc9, 0xe0, 0x51, 0xc2, 0x30, 0x67, 0x87, 0x34, 0x4c, 0xd0, 0x8f, 0xf7, 0x65, 0x99, 0xff, 0x93,
+		0x83, 0x53, 0xba, 0xed, 0xb5, 0x6d, 0x4f, 0x65, 0x53, 0x81, 0x15, 0xb8, 0x4d, 0x8a, 0xd1, 0xaa,
+		0xe4, 0x8b, 0x92, 0xf2, 0x8b, 0x30, 0xb3, 0x86, 0xcb, 0x05, 0x1e, 0x83, 0xc2, 0x2b, 0x9e, 0xbe,
+		0xaf, 0x8e, 0x2e, 0xc6, 0x22, 0x7e, 0x7e, 0xc5, 0x14, 0x15, 0x95, 0x3f, 0x92, 0x02, 0xa9, 0xa1,
+		0x6b, 0xa6, 0xe6, 0xfe, 0xff, 0x52, 0xc9, 0x17, 0x00, 0xe8, 0x27, 0x47, 0xe1, 0x37, 0x42, 0x93,
+		0x67, 0x4b, 0x4b, 0xd1, 0xce, 0x2d, 0xb1, 0x27, 0xd1, 0x0f, 0x10, 0x0a, 0x54, 0x17, 0xff, 0x7d,
+		0xfc, 0x15, 0x80, 0xb0, 0x42, 0xbe, 0x17, 0xee, 0x69, 0xac, 0x2e, 0xaf, 0x2f, 0x2b, 0x2a, 0x7b,
+		0x97, 0x7d, 0xb3, 0x51, 0xaf, 0xad, 0xae, 0x5d, 0x5e, 0xab, 0x55, 0xa5, 0x11, 0xf9, 0x24, 0xc8,
+		0xd1, 0xca, 0xe0, 0xc5, 0x94, 0x13, 0x30, 0x1d, 0x95, 0xb3, 0x17, 0xe2, 0xd3, 0x18, 0x2a, 0x1a,
+		0x6d, 0xc7, 0x24, 0xf4, 0xee, 0x4f, 0x35, 0x84, 0xd5, 0x92, 0xa3, 0x90, 0xdf, 0xfc, 0xb7, 0xec,
+		0x25, 0xe9, 0x99, 0x10, 0x1e, 0xd8, 0xbc, 0xb2, 0x0e, 0xd3, 0xf8, 0xda, 0x96, 0x13, 0xa3, 0x4c,
+		0x58, 0xab, 0x91, 0x90, 0xde, 0x66, 0x72, 0x64, 0xc8, 0x76, 0x01, 0x72, 0x1e, 0xed, 0x7d, 0x12,
+		0xc5, 0xd7, 0x39, 0x05, 0x57, 0xaf, 0x58, 0x30, 0x8d, 0xa1, 0x1f, 0x66, 0x88, 0xc2, 0x66, 0x1c,
+		0x9d, 0x68, 0xf8, 0x27, 0x5f, 0x7a, 0x9a, 0xde, 0x6d, 0x3e, 0x10, 0x1f, 0x96, 0x3e, 0xee, 0xa4,
+		0x48, 0x9c, 0x3b, 0x6c, 0x28, 0x81, 0x49, 0xf1, 0x3c, 0xde, 0xe0, 0xa3, 0x1f, 0xf6, 0x4f, 0xf9,
+		0xc3, 0xe6, 0xfb, 0xf9, 0x40, 0xe4, 0x49, 0x13, 0x9c, 0x95, 0x55, 0xac, 0xd4, 0x06, 0xcd, 0xe9,
+		0xd7, 0x9e, 0x88, 0x6c, 0x4f, 0x8c, 0x92, 0xff, 0x79, 0x8a, 0x32, 0x5f, 0x8a, 0x3e, 0x26, 0x98,
+		0x7b, 0xbf, 0x9d, 0x81, 0x79, 0xae, 0xbc, 0xab, 0x79, 0xe4, 0xcc, 0x8d, 0x67, 0x76, 0x89, 0xaf,
+		0x3d, 0x73, 0x46, 0xb7, 0x0d, 0xb1, 0x56, 0xcf, 0xf0, 0xe9, 0x88, 0xf5, 0x4b, 0xbc, 0xbe, 0xff,
+		0xc6, 0x35, 0x37, 0x78, 0x1a, 0x97, 0x77, 0x20, 0xbb, 0x6a, 0x1b, 0x16, 0x2e, 0x55, 0x4d, 0x62,
+		0xd9, 0x6d, 0x3e, 0x7b, 0x58, 0x41, 0x7e, 0x06, 0x72, 0x5a, 0xdb, 0xee, 0x58, 0x3e, 0x9b, 0x39,
+		0x2b, 0xa7, 0xbe, 0xf6, 0xce, 0xc2, 0xc8, 0xbf, 0x7b, 0x67, 0x21, 0xb3, 0x66, 0xf9, 0xbf, 0xf5,
+		0xe5, 0xa7, 0x80, 0x53, 0xad, 0x59, 0xbe, 0xc2, 0x15, 0x2b, 0xd9, 0xef, 0xbc, 0xb5, 0x90, 0x2a,
+		0xbf, 0x02, 0x63, 0x55, 0xa2, 0xbf, 0x1f, 0xe6, 0x2a, 0xd1, 0x23, 0xcc, 0x55, 0xa2, 0x77, 0x31,
+		0x5f, 0x80, 0xfc, 0x9a, 0xe5, 0xb3, 0xf7, 0xce, 0x9f, 0x80, 0x8c, 0x61, 0xb1, 0x57, 0x19, 0x8f,
+		0x6c, 0x1b, 0x6a, 0x21, 0xb0, 0x4a, 0xf4, 0x00, 0xd8, 0x24, 0x7a, 0x29, 0x95, 0xf4, 0x68, 0xd4,
+		0x5a, 0xa9, 0xfe, 0xce, 0x7f, 0x9c, 0x1f, 0x79, 0xf3, 0xdd, 0xf9, 0x91, 0x81, 0x43, 0x5c, 0x1e,
+		0x38, 0xc4, 0x5e, 0xf3, 0x80, 0xad, 0xc8, 0xc1, 0xc8, 0x7e, 0x3e, 0x0b, 0xf7, 0xd3, 0xcf, 0x91,
+		0xdc, 0xb6, 0x61, 0xf9, 0x67, 0x74, 0xf7, 0xd0, 0xf1, 0x69, 0xc8, 0x62, 0xef, 0xf1, 0x81, 0x9d,
+		0x0e, 0xab, 0x97, 0x58, 0xf5, 0x80, 0x78, 0x64, 0x0f, 0x46, 0xeb, 0x88, 0x43, 0x13, 0xfb, 0xb6,
+		0xaf, 0x99, 0x7c, 0xff, 0x61, 0x05, 0x94, 0xb2, 0x4f, 0x98, 0xd2, 0x4c, 0x6a, 0x88, 0xaf, 0x97,
+		0x4c, 0xa2, 0xed, 0xb1, 0x37, 0xc1, 0x33, 0x34, 0x4c, 0xc9, 0xa3, 0x80, 0xbe, 0xf4, 0x3d, 0x0b,
+		0xa3, 0x5a, 0x87, 0xbd, 0xc4, 0x90, 0xc1, 0xf8, 0x85, 0x16, 0xca, 0x2f, 0xc2, 0x18, 0xbf, 0x4a,
+		0xc5, 0x6b, 0xfc, 0x03, 0x72, 0x48, 0x9f, 0x53, 0x54, 0xf0, 0x5f, 0x79, 0x09, 0x46, 0x69, 0xe3,
+		0xf9, 0x27, 0x2e, 0xa5, 0xa5, 0x9e, 0xd6, 0x2f, 0xd1, 0x46, 0x2a, 0x4c, 0xad, 0x7c, 0x0d, 0xf2,
+		0x55, 0xbb, 0x6d, 0x58, 0x76, 0x9c, 0xad, 0xc0, 0xd8, 0x68, 0x9b, 0x9d, 0x0e, 0xf7, 0x0a, 0x85,
+		0x15, 0xf0, 0x8d, 0x49, 0xf6, 0x65, 0x00, 0x7f, 0x11, 0x83, 0x97, 0xca, 0xab, 0x30, 0x46, 0xb9,
+		0xb7, 0x1c, 0x5c, 0xfc, 0x83, 0xd7, 0x32, 0x0b, 0xfc, 0x3b, 0x31, 0x4e, 0x9f, 0x0e, 0x1b, 0x2b,
+		0x43, 0xb6, 0xa9, 0xf9, 0x1a, 0xef, 0x37, 0xfd, 0xbf, 0xfc, 0x61, 0xc8, 0x73, 0x12, 0x4f, 0x3e,
+		0x0b, 0x19, 0xdb, 0xf1, 0xf8, 0xab, 0x14, 0x73, 0x83, 0xba, 0xb2, 0xe5, 0xac, 0x64, 0xd1, 0x67,
+		0x14, 0x54, 0x5e, 0x51, 0x06, 0xba, 0xc5, 0xf3, 0x11, 0xb7, 0x88, 0x0c, 0x79, 0xe4, 0x5f, 0x36,
+		0xa4, 0x3d, 0xee, 0x10, 0x38, 0xcb, 0xdb, 0x69, 0x98, 0x8f, 0xd4, 0xde, 0x20, 0xae, 0x67, 0xd8,
+		0x16, 0xf3, 0x28, 0xee, 0x2d, 0x72, 0xa4, 0x91, 0xbc, 0x7e, 0x80, 0xbb, 0x7c, 0x08, 0x32, 0xcb,
+		0x8e, 0x83, 0x1f, 0xc8, 0xd1, 0xb2, 0x6e, 0x33, 0x7f, 0xc9, 0x2a, 0x41, 0x19, 0xeb, 0x3c, 0x7b,
+		0xcf, 0xbf, 0xa9, 0xb9, 0xc1, 0xc7, 0x73, 0xa2, 0x5c, 0xbe, 0x08, 0x85, 0x55, 0xdb, 0xf2, 0x88,
+		0xe5, 0x75, 0x68, 0x64, 0xb3, 0x6b, 0xda, 0xfa, 0x01, 0x67, 0x60, 0x05, 0x34, 0xb8, 0xe6, 0x38,
+		0x14, 0x99, 0x55, 0xf0, 0x5f, 0x36, 0x67, 0x57, 0x1a, 0x03, 0x4d, 0x74, 0xf1, 0xf8, 0x26, 0xe2,
+		0x9d, 0x0c, 0x6c, 0xf4, 0x87, 0x29, 0xb8, 0xaf, 0x77, 0x42, 0x1d, 0x90, 0x43, 0xef, 0xb8, 0xf3,
+		0xe9, 0x15, 0x28, 0xd4, 0xe9, 0x17, 0xec, 0x2f, 0x92, 0x43, 0x79, 0x0e, 0xc6, 0x48, 0xf3, 0xec,
+		0xb9, 0x73, 0xcf, 0x5c, 0x64, 0xde, 0x7e, 0x75, 0x44, 0x11, 0x02, 0x79, 0x1e, 0x0a, 0x1e, 0xd1,
+		0x9d, 0xb3, 0xe7, 0xce, 0x1f, 0x3c, 0xc3, 0xdc, 0xeb, 0xea, 0x88, 0x12, 0x8a, 0x2a, 0x79, 0xec,
+		0xf5, 0x77, 0xde, 0x5e, 0x48, 0xad, 0x8c, 0x42, 0xc6, 0xeb, 0xb4, 0x3f, 0x50, 0x1f, 0xf9, 0xe4,
+		0x28, 0x2c, 0x46, 0x91, 0x34, 0xfe, 0xbb, 0xa1, 0x99, 0x46, 0x53, 0x0b, 0x7f, 0x7b, 0x40, 0x8a,
+		0xd8, 0x80, 0x6a, 0x0c, 0xd8, 0x29, 0x8e, 0xb4, 0x64, 0xf9, 0xd7, 0x52, 0x50, 0xbc, 0x2e, 0x98,
+		0xf1, 0xc7, 0x0a, 0x2e, 0x01, 0x04, 0x4f, 0x12, 0xd3, 0xe6, 0xde, 0xa5, 0xee, 0x67, 0x2d, 0x05,
+		0x18, 0x25, 0xa2, 0x2e, 0x5f, 0xa0, 0x8e, 0xe8, 0xd8, 0x1e, 0xff, 0xa0, 0x2a, 0x01, 0x1a, 0x28,
+		0xe3, 0x0b, 0x72, 0x74, 0x85, 0x53, 0x6f, 0xd8, 0x3e, 0xbe, 0x31, 0xe0, 0xd8, 0x37, 0xf9, 0x67,
+		0xaa, 0x19, 0x45, 0xa2, 0x35, 0xd7, 0x69, 0x45, 0x1d, 0xe5, 0xd8, 0xe8, 0x42, 0xc0, 0x82, 0xc1,
+		0xba, 0xd6, 0x6c, 0xba, 0xc4, 0xf3, 0xf8, 0x22, 0x26, 0x8a, 0xf8, 0x15, 0x97, 0xd3, 0xd9, 0x55,
+		0xc5, 0x8a, 0x81, 0xdf, 0xc1, 0xf5, 0x99, 0xff, 0xc2, 0x3f, 0xf8, 0x0a, 0x90, 0x73, 0x3a, 0xbb,
+		0xe8, 0x2d, 0x0f, 0x40, 0xb1, 0x4f, 0x63, 0xc6, 0x6f, 0x84, 0xed, 0xa0, 0x3f, 0x9c, 0xc0, 0x7b,
+		0xa0, 0x3a, 0xae, 0x61, 0xbb, 0x86, 0x7f, 0x48, 0xdf, 0x87, 0xca, 0x28, 0x92, 0xa8, 0xa8, 0x73,
+		0x79, 0xf9, 0x00, 0xa6, 0x1a, 0x34, 0x88, 0x0b, 0x5b, 0x7e, 0x2e, 0x6c, 0x5f, 0x2a, 0xb9, 0x7d,
+		0x03, 0x5b, 0x96, 0xee, 0x69, 0xd9, 0xca, 0x4b, 0x03, 0xbd, 0xf3, 0xc2, 0xf1, 0xbd, 0x33, 0xbe,
+		0xdb, 0xfd, 0xc1, 0x29, 0xb8, 0xaf, 0xbb, 0x32, 0xb6, 0x7c, 0x0d, 0xeb, 0x98, 0x49, 0x67, 0xb4,
+		0xb9, 0xa3, 0x37, 0xd5, 0xb9, 0x84, 0x65, 0x74, 0x2e, 0x71, 0x0a, 0x95, 0x2f, 0xc2, 0x04, 0xbe,
+		0xd8, 0xd8, 0x20, 0xfe, 0x55, 0xa2, 0x35, 0x89, 0x1b, 0xdf, 0x75, 0x27, 0xc4, 0xae, 0x2b, 0x43,
+		0x96, 0x6e, 0xad, 0x6c, 0xd7, 0xa1, 0xff, 0x97, 0xf7, 0x21, 0x8b, 0xd0, 0x70, 0x47, 0xe6, 0x08,
+		0x5a, 0x40, 0xe9, 0xee, 0xa1, 0x4f, 0x3c, 0x91, 0x34, 0xa0, 0x05, 0xf9, 0x39, 0xb1, 0xaf, 0x66,
+		0x8e, 0xde, 0x57, 0xb9, 0x23, 0xf2, 0xdd, 0xd5, 0x84, 0xb1, 0x15, 0x5c, 0x8a, 0xd7, 0xaa, 0x41,
+		0x43, 0x52, 0x61, 0x43, 0xe4, 0x0d, 0x98, 0x72, 0x34, 0xd7, 0xa7, 0x1f, 0x83, 0xec, 0xd3, 0x5e,
+		0x70, 0x5f, 0x5f, 0xe8, 0x9d, 0x79, 0xb1, 0xce, 0xf2, 0xa7, 0x4c, 0x38, 0x51, 0x61, 0xf9, 0x3f,
+		0x65, 0x21, 0xc7, 0x8d, 0xf1, 0x21, 0x18, 0xe3, 0x66, 0xe5, 0xde, 0x79, 0xff, 0x52, 0xef, 0xc6,
+		0xb4, 0x14, 0x6c, 0x20, 0x9c, 0x4f, 0x60, 0xe4, 0x47, 0x20, 0xaf, 0xef, 0x6b, 0x86, 0xa5, 0x1a,
+		0x4d, 0x1e, 0x10, 0x8e, 0xbf, 0xfb, 0xce, 0xc2, 0xd8, 0x2a, 0xca, 0xd6, 0xaa, 0xca, 0x18, 0xad,
+		0x5c, 0x6b, 0x62, 0x24, 0xb0, 0x4f, 0x8c, 0xd6, 0xbe, 0xcf, 0x67, 0x18, 0x2f, 0xe1, 0xaf, 0xa6,
+		0xa0, 0x43, 0xf0, 0x4f, 0x05, 0xe7, 0x7a, 0x22, 0xfc, 0xe0, 0x08, 0xbd, 0x92, 0xc7, 0x07, 0x7f,
+		0xfc, 0x3f, 0x2c, 0xa4, 0x14, 0x8a, 0x90, 0x57, 0x61, 0xc2, 0xd4, 0x3c, 0x5f, 0xa5, 0x3b, 0x18,
+		0x3e, 0x7e, 0x94, 0x52, 0x9c, 0xea, 0x35, 0x08, 0x37, 0x2c, 0x6f, 0xfa, 0x38, 0xa2, 0x98, 0xa8,
+		0x89, 0x5f, 0x32, 0x51, 0x12, 0x7c, 0x9f, 0xd3, 0xf0, 0x59, 0x6c, 0x95, 0xa3, 0x76, 0x9f, 0x44,
+		0xf9, 0x2a, 0x15, 0xd3, 0x08, 0xeb, 0x5e, 0x28, 0xd0, 0x8f, 0x93, 0xa8, 0x0a, 0x7b, 0x11, 0x37,
+		0x8f, 0x02, 0x5a, 0xf9, 0x28, 0x4c, 0x85, 0xeb, 0x23, 0x53, 0xc9, 0x33, 0x96, 0x50, 0x4c, 0x15,
+		0x9f, 0x86, 0x59, 0x8b, 0xdc, 0xf2, 0xd5, 0x50, 0xcc, 0xb4, 0x0b, 0x54, 0x5b, 0xc6, 0xba, 0xeb,
+		0x71, 0xc4, 0xc3, 0x30, 0xa9, 0x0b, 0xe3, 0x33, 0x5d, 0xa0, 0xba, 0x13, 0x81, 0x94, 0xaa, 0x9d,
+		0x82, 0xbc, 0xe6, 0x38, 0x4c, 0x61, 0x9c, 0xaf, 0x8f, 0x8e, 0x43, 0xab, 0x1e, 0x87, 0x69, 0xda,
+		0x47, 0x97, 0x78, 0x1d, 0xd3, 0xe7, 0x24, 0x45, 0xaa, 0x33, 0x85, 0x15, 0x0a, 0x93, 0x53, 0xdd,
+		0x07, 0x61, 0x82, 0xdc, 0x30, 0x9a, 0xc4, 0xd2, 0x09, 0xd3, 0x9b, 0xa0, 0x7a, 0x45, 0x21, 0xa4,
+		0x4a, 0x8f, 0x41, 0xb0, 0xee, 0xa9, 0x62, 0x4d, 0x9e, 0x64, 0x7c, 0x42, 0xbe, 0xcc, 0xc4, 0xe5,
+		0x12, 0x64, 0xab, 0x9a, 0xaf, 0x61, 0x80, 0xe1, 0xdf, 0x62, 0x1b, 0x4d, 0x51, 0xc1, 0x7f, 0xcb,
+		0xdf, 0x49, 0x43, 0xf6, 0xba, 0xed, 0x13, 0xf9, 0xd9, 0x48, 0x00, 0x38, 0xd9, 0xcf, 0x9f, 0x1b,
+		0x46, 0xcb, 0x22, 0xcd, 0x0d, 0xaf, 0x15, 0xf9, 0x25, 0x81, 0xd0, 0x9d, 0xd2, 0x31, 0x77, 0x9a,
+		0x85, 0x51, 0xd7, 0xee, 0x58, 0x4d, 0xf1, 0x0e, 0x2b, 0x2d, 0xc8, 0x35, 0xc8, 0x07, 0x5e, 0x92,
+		0x4d, 0xf2, 0x92, 0x29, 0xf4, 0x12, 0xf4, 0x61, 0x2e, 0x50, 0xc6, 0x76, 0xb9, 0xb3, 0xac, 0x40,
+		0x21, 0x58, 0xbc, 0x4a, 0xa3, 0xc7, 0x70, 0xd8, 0x10, 0x86, 0x9b, 0x49, 0x30, 0xf6, 0x81, 0xf1,
+		0x98, 0xc7, 0x49, 0x41, 0x05, 0xb7, 0x5e, 0xcc, 0xad, 0xf8, 0xaf, 0x1a, 0x8c, 0xd1, 0x7e, 0x85,
+		0x6e, 0xc5, 0x7e, 0xd9, 0xe0, 0x3e, 0x7c, 0x25, 0xa9, 0x65, 0x69, 0x7e, 0xc7, 0x25, 0xdc, 0xf3,
+		0x42, 0x01, 0x7e, 0xb1, 0x92, 0x63, 0x9e, 0x1c, 0xb1, 0x5b, 0xaa, 0xbf, 0xdd, 0xd2, 0x83, 0xec,
+		0x96, 0x79, 0xff, 0x76, 0x5b, 0x06, 0x08, 0x1a, 0xe3, 0xf1, 0x8f, 0xcd, 0xfb, 0x44, 0x0c, 0xac,
+		0x89, 0x0d, 0xa3, 0xc5, 0x27, 0x6a, 0x04, 0x54, 0xfe, 0xf7, 0x29, 0x28, 0x04, 0xf5, 0xf2, 0x32,
+		0x4c, 0x88, 0x76, 0xa9, 0x7b, 0xa6, 0xd6, 0xe2, 0xbe, 0x73, 0xff, 0xc0, 0xc6, 0x5d, 0x36, 0xb5,
+		0x96, 0x32, 0xce, 0xdb, 0x83, 0x85, 0xfe, 0xe3, 0x90, 0x1e, 0x30, 0x0e, 0xb1, 0x81, 0xcf, 0xbc,
+		0xbf, 0x81, 0x8f, 0x0d, 0x51, 0xb6, 0x7b, 0x88, 0xbe, 0x94, 0xa6, 0x87, 0x19, 0xc7, 0xf6, 0x34,
+		0xf3, 0x47, 0x31, 0x23, 0xee, 0x85, 0x82, 0x63, 0x9b, 0x2a, 0xab, 0x61, 0xef, 0x76, 0xe7, 0x1d,
+		0xdb, 0x54, 0x7a, 0x86, 0x7d, 0xf4, 0x2e, 0x4d, 0x97, 0xdc, 0x5d, 0xb0, 0xda, 0x58, 0xb7, 0xd5,
+		0x5c, 0x28, 0x32, 0x53, 0xf0, 0xbd, 0xec, 0x69, 0xb4, 0x01, 0xfe, 0x57, 0x4a, 0xf5, 0xee, 0xbd,
+		0xac, 0xd9, 0x4c, 0x53, 0xc9, 0xed, 0x07, 0x08, 0xb6, 0xf4, 0x97, 0xd2, 0x83, 0x10, 0xcc, 0xed,
+		0x14, 0xae, 0x57, 0xfe, 0xeb, 0x29, 0x80, 0x75, 0xb4, 0x2c, 0xed, 0x2f, 0xee, 0x42, 0x1e, 0x6d,
+		0x82, 0x1a, 0x7b, 0xf2, 0xfc, 0xa0, 0x41, 0xe3, 0xcf, 0x2f, 0x7a, 0xd1, 0x76, 0xaf, 0xc2, 0x44,
+		0xe8, 0x8c, 0x1e, 0x11, 0x8d, 0x99, 0x3f, 0x22, 0xaa, 0x6e, 0x10, 0x5f, 0x29, 0xde, 0x88, 0x94,
+		0xca, 0xff, 0x22, 0x05, 0x05, 0xda, 0x26, 0xfc, 0x54, 0x36, 0x36, 0x86, 0xa9, 0xf7, 0x3f, 0x86,
+		0xf7, 0x03, 0x30, 0x1a, 0xbc, 0xa0, 0xe5, 0x9e, 0x55, 0xa0, 0x12, 0xbc, 0x76, 0x95, 0xcf, 0x07,
+		0x06, 0xcf, 0x1c, 0x6d, 0x70, 0x11, 0x75, 0x73, 0xb3, 0xdf, 0x03, 0x63, 0xf4, 0xc7, 0x99, 0x6e,
+		0x79, 0x3c, 0x90, 0xc6, 0x5f, 0x64, 0xd8, 0xbe, 0xe5, 0x95, 0x5f, 0x87, 0xb1, 0xed, 0x5b, 0x2c,
+		0x37, 0x72, 0x2f, 0x14, 0x5c, 0xdb, 0xe6, 0x7b, 0x32, 0x8b, 0x85, 0xf2, 0x28, 0xa0, 0x5b, 0x90,
+		0xc8, 0x07, 0xa4, 0xc3, 0x7c, 0x40, 0x98, 0xd0, 0xc8, 0x0c, 0x95, 0xd0, 0x78, 0xfc, 0xb7, 0x53,
+		0x30, 0x1e, 0x59, 0x1f, 0xe4, 0x67, 0xe0, 0xc4, 0xca, 0xfa, 0xd6, 0xea, 0x8b, 0xea, 0x5a, 0x55,
+		0xbd, 0xbc, 0xbe, 0x7c, 0x25, 0xfc, 0x7a, 0x69, 0xee, 0xe4, 0xed, 0x3b, 0x8b, 0x72, 0x44, 0x77,
+		0xc7, 0xa2, 0x79, 0x7a, 0xf9, 0x0c, 0xcc, 0xc6, 0x21, 0xcb, 0x2b, 0x0d, 0xfc, 0x94, 0x29, 0x35,
+		0x77, 0xe2, 0xf6, 0x9d, 0xc5, 0xe9, 0x08, 0x62, 0x79, 0xd7, 0x23, 0x96, 0xdf, 0x0b, 0x58, 0xdd,
+		0xda, 0xd8, 0x58, 0xdb, 0x96, 0xd2, 0x3d, 0x00, 0xbe, 0x60, 0x3f, 0x06, 0xd3, 0x71, 0xc0, 0xe6,
+		0xda, 0xba, 0x94, 0x99, 0x93, 0x6f, 0xdf, 0x59, 0x9c, 0x8c, 0x68, 0x6f, 0x1a, 0xe6, 0x5c, 0xfe,
+		0x67, 0x3f, 0x33, 0x3f, 0xf2, 0x2b, 0x9f, 0x9d, 0x4f, 0x61, 0xcf, 0x26, 0x62, 0x6b, 0x84, 0xfc,
+		0x24, 0xdc, 0xd3, 0x58, 0xbb, 0xb2, 0x59, 0xab, 0xaa, 0x1b, 0x8d, 0x2b, 0x22, 0xd3, 0x2d, 0x7a,
+		0x37, 0x75, 0xfb, 0xce, 0xe2, 0x38, 0xef, 0xd2, 0x20, 0xed, 0xba, 0x52, 0xbb, 0xbe, 0xb5, 0x5d,
+		0x93, 0x52, 0x4c, 0xbb, 0xee, 0x92, 0x1b, 0xb6, 0xcf, 0x7e, 0xbd, 0xed, 0x69, 0x38, 0xd5, 0x47,
+		0x3b, 0xe8, 0xd8, 0xf4, 0xed, 0x3b, 0x8b, 0x13, 0x75, 0x97, 0xb0, 0xf9, 0x43, 0x11, 0x4b, 0x50,
+		0xea, 0x45, 0x6c, 0xd5, 0xb7, 0x1a, 0xcb, 0xeb, 0xd2, 0xe2, 0x9c, 0x74, 0xfb, 0xce, 0x62, 0x51,
+		0x2c, 0x86, 0xa8, 0x1f, 0xf6, 0xec, 0x83, 0x3c, 0xf1, 0x7c, 0xf1, 0x49, 0x78, 0x88, 0xe7, 0x00,
+		0x3d, 0x5f, 0x3b, 0x30, 0xac, 0x56, 0x90, 0xbc, 0xe5, 0x65, 0x7e, 0xf2, 0x39, 0xc9, 0xb4, 0x96,
+		0x84, 0x34, 0x21, 0x85, 0x3b, 0xf0, 0xf6, 0x72, 0x2e, 0xe1, 0x52, 0x2f, 0xf9, 0xe8, 0x34, 0x38,
+		0x3d, 0x3c, 0x97, 0x90, 0x84, 0x9e, 0x3b, 0xf2, 0x70, 0x57, 0xfe, 0x58, 0x0a, 0x26, 0xaf, 0x1a,
+		0x9e, 0x6f, 0xbb, 0x86, 0xae, 0x99, 0xf4, 0x9b, 0xa5, 0xf3, 0xc3, 0xae, 0xad, 0x5d, 0x53, 0xfd,
+		0x05, 0xc8, 0xdd, 0xd0, 0x4c, 0xb6, 0xa8, 0x45, 0xef, 0x02, 0xba, 0xcd, 0x17, 0x2e, 0x6d, 0x82,
+		0x80, 0xc1, 0xca, 0x5f, 0x48, 0xc3, 0x14, 0x9d, 0x0c, 0x1e, 0xfb, 0xf1, 0x2d, 0x3c, 0x63, 0xd5,
+		0x21, 0xeb, 0x6a, 0x3e, 0x4f, 0x1a, 0xae, 0xfc, 0x04, 0xcf, 0x03, 0x3f, 0x92, 0x9c, 0xcd, 0x5d,
+		0xea, 0x4d, 0x15, 0x53, 0x26, 0xf9, 0x65, 0xc8, 0xb7, 0xb5, 0x5b, 0x2a, 0x65, 0x4d, 0xdf, 0x05,
+		0xd6, 0xb1, 0xb6, 0x76, 0x0b, 0xdb, 0x2a, 0x37, 0x61, 0x0a, 0x89, 0xf5, 0x7d, 0xcd, 0x6a, 0x11,
+		0xc6, 0x9f, 0xb9, 0x0b, 0xfc, 0x13, 0x6d, 0xed, 0xd6, 0x2a, 0xe5, 0xc4, 0xa7, 0x54, 0xf2, 0x78,
+		0x53, 0x4d, 0xd3, 0xec, 0xbf, 0x91, 0x02, 0x08, 0xcd, 0x25, 0xff, 0x49, 0x90, 0xf4, 0xa0, 0x44,
+		0x1f, 0xef, 0xf1, 0x01, 0x7c, 0x74, 0xd0, 0x40, 0x74, 0x19, 0x9b, 0x6d, 0xcc, 0xdf, 0x78, 0x67,
+		0x21, 0xa5, 0x4c, 0xe9, 0x5d, 0xe3, 0x50, 0x83, 0xf1, 0x8e, 0xd3, 0xd4, 0x7c, 0xa2, 0xd2, 0x43,
+		0x5c, 0xfa, 0x18, 0x9b, 0x3c, 0x30, 0x20, 0x56, 0x45, 0x5a, 0xff, 0x85, 0x14, 0x8c, 0x57, 0x23,
+		0x97, 0x7c, 0x25, 0x18, 0x6b, 0xdb, 0x96, 0x71, 0xc0, 0xdd, 0xae, 0xa0, 0x88, 0x22, 0x66, 0x3c,
+		0xd9, 0xd7, 0x9a, 0xfe, 0xa1, 0xc8, 0x78, 0x8a, 0x32, 0xa2, 0x6e, 0x92, 0x5d, 0xcf, 0x10, 0xb6,
+		0x56, 0x44, 0x11, 0x8f, 0x2e, 0x1e, 0xd1, 0x3b, 0x98, 0xaa, 0x51, 0x75, 0xdb, 0xf2, 0x35, 0xdd,
+		0xe7, 0xdf, 0xfd, 0x4d, 0x09, 0xf9, 0x2a, 0x13, 0x23, 0x49, 0x93, 0xf8, 0x9a, 0x61, 0x7a, 0x25,
+		0x76, 0x11, 0x26, 0x8a, 0x91, 0xe6, 0xfe, 0x66, 0x2e, 0x9a, 0xa2, 0x5a, 0x05, 0xc9, 0x76, 0x88,
+		0x1b, 0x0b, 0x29, 0x99, 0x87, 0x96, 0x7e, 0xeb, 0xcb, 0x4f, 0xcd, 0x72, 0x73, 0xf3, 0xa0, 0x92,
+		0xbd, 0xd8, 0xaa, 0x4c, 0x09, 0x04, 0x17, 0xcb, 0xaf, 0x82, 0x14, 0x9c, 0xec, 0x54, 0xa7, 0xb3,
+		0x1b, 0xa6, 0xb5, 0x66, 0x7b, 0xec, 0xba, 0x6c, 0x1d, 0xae, 0x94, 0xbe, 0x1e, 0x52, 0x87, 0xb9,
+		0x24, 0x4c, 0x24, 0x4d, 0x05, 0x3c, 0x75, 0x4a, 0x83, 0x21, 0xe2, 0xeb, 0x9a, 0x61, 0x8a, 0x8f,
+		0xd0, 0x15, 0x5e, 0x92, 0x2b, 0x90, 0xf3, 0x7c, 0xcd, 0xef, 0x78, 0xfc, 0xa7, 0xe1, 0xca, 0x83,
+		0x3c, 0x63, 0xc5, 0xb6, 0x9a, 0x0d, 0xaa, 0xa9, 0x70, 0x84, 0xbc, 0x0d, 0x39, 0xdf, 0x3e, 0x20,
+		0x16, 0x37, 0xd2, 0xb1, 0xbc, 0xba, 0xcf, 0x5d, 0x14, 0xe3, 0x92, 0x5b, 0x20, 0x35, 0x89, 0x49,
+		0x5a, 0x2c, 0x20, 0xda, 0xd7, 0xf0, 0xdc, 0x90, 0xbb, 0x0b, 0xb3, 0x66, 0x2a, 0x60, 0x6d, 0x50,
+		0x52, 0xf9, 0xc5, 0xf8, 0x35, 0x33, 0xfb, 0x1d, 0xc5, 0x07, 0x07, 0xf5, 0x3f, 0xe2, 0x99, 0x22,
+		0x99, 0x10, 0x41, 0xa3, 0x73, 0x75, 0xac, 0x5d, 0xdb, 0xa2, 0x9f, 0x8a, 0xf2, 0x60, 0x3c, 0x4f,
+		0xc3, 0x9b, 0xa9, 0x40, 0x7e, 0x95, 0x8a, 0xe5, 0x17, 0x61, 0x32, 0x54, 0xa5, 0x73, 0xa7, 0x70,
+		0x8c, 0xb9, 0x33, 0x11, 0x60, 0xb1, 0x56, 0xbe, 0x0a, 0x10, 0x4e, 0x4c, 0x9a, 0x1e, 0x18, 0x3f,
+		0x5b, 0x4e, 0x9e, 0xdd, 0xe2, 0x98, 0x15, 0x62, 0x65, 0x13, 0x66, 0xda, 0x86, 0xa5, 0x7a, 0xc4,
+		0xdc, 0x53, 0xb9, 0xa9, 0x90, 0x72, 0xfc, 0x2e, 0x0c, 0xed, 0x74, 0xdb, 0xb0, 0x1a, 0xc4, 0xdc,
+		0xab, 0x06, 0xb4, 0x95, 0xe2, 0xcf, 0xbe, 0xb5, 0x30, 0xc2, 0xe7, 0xd2, 0x48, 0xb9, 0x4e, 0x53,
+		0xd4, 0x7c, 0x1a, 0x10, 0x4f, 0x3e, 0x0f, 0x05, 0x4d, 0x14, 0x68, 0xe2, 0xe0, 0xa8, 0x69, 0x14,
+		0xaa, 0xb2, 0xd9, 0xf9, 0xe6, 0xef, 0x2d, 0xa6, 0xca, 0x9f, 0x4d, 0x41, 0xae, 0x7a, 0xbd, 0xae,
+		0x19, 0xae, 0x5c, 0xc3, 0xcb, 0x6b, 0xe1, 0x50, 0xc3, 0xce, 0xcd, 0xd0, 0x07, 0xc5, 0xe4, 0xac,
+		0x0d, 0x3a, 0x35, 0x1e, 0x49, 0xd3, 0x7d, 0x9e, 0xec, 0xea, 0x78, 0x0d, 0xc6, 0x58, 0x2b, 0xf1,
+		0x53, 0xe3, 0x51, 0x07, 0xff, 0x29, 0xa5, 0x62, 0x57, 0xd9, 0xbd, 0x8e, 0x48, 0xf5, 0x83, 0x0c,
+		0x22, 0x42, 0xca, 0x7f, 0x98, 0x02, 0xa8, 0x5e, 0xbf, 0xbe, 0xed, 0x1a, 0x8e, 0x49, 0xfc, 0xbb,
+		0xd5, 0xe3, 0x75, 0x38, 0x11, 0xf6, 0xd8, 0x73, 0xf5, 0xa1, 0x7b, 0x3d, 0x13, 0x1e, 0x4e, 0x5c,
+		0xbd, 0x2f, 0x5b, 0xd3, 0xf3, 0x03, 0xb6, 0xcc, 0xd0, 0x6c, 0x55, 0xcf, 0xef, 0x6f, 0xc6, 0x06,
+		0x8c, 0x87, 0xdd, 0xc7, 0x1f, 0xd3, 0xca, 0xfb, 0xfc, 0x7f, 0x6e, 0xcd, 0xf2, 0x60, 0x6b, 0x0a,
+		0x18, 0xb7, 0x68, 0x80, 0x2c, 0xff, 0x5f, 0x34, 0x6a, 0xe0, 0xb1, 0x7f, 0xbc, 0xdc, 0x08, 0xd7,
+		0x5e, 0xbe, 0x36, 0xde, 0x8d, 0x88, 0x82, 0x73, 0x75, 0x59, 0xf5, 0xa3, 0x69, 0xfc, 0x1d, 0x06,
+		0xbe, 0xda, 0xfc, 0xb1, 0xb5, 0x44, 0x1d, 0xc6, 0x88, 0xe5, 0xbb, 0x06, 0x35, 0x05, 0x8e, 0xf5,
+		0xd3, 0x83, 0xc6, 0xba, 0x4f, 0x5f, 0xe8, 0x2f, 0x14, 0x89, 0xbc, 0x36, 0xa7, 0xe9, 0xb2, 0xc2,
+		0x67, 0x33, 0x50, 0x1a, 0x84, 0xc4, 0x2c, 0x9d, 0xee, 0x12, 0x2a, 0x50, 0x63, 0xc9, 0xb5, 0x49,
+		0x21, 0xe6, 0x8b, 0xfe, 0x06, 0x60, 0x00, 0x85, 0x8e, 0x85, 0xaa, 0xc7, 0x8e, 0x98, 0x26, 0x43,
+		0x30, 0x56, 0xcb, 0x04, 0xa6, 0x0c, 0xcb, 0xf0, 0x0d, 0xcd, 0x54, 0x77, 0x35, 0x53, 0xb3, 0xf4,
+		0xf7, 0x13, 0x59, 0xf6, 0x2e, 0xd4, 0x93, 0x9c, 0x74, 0x85, 0x71, 0xca, 0xd7, 0x61, 0x4c, 0xd0,
+		0x67, 0xef, 0x02, 0xbd, 0x20, 0x93, 0xaf, 0x80, 0x6c, 0xbb, 0x46, 0xcb, 0xb0, 0x34, 0x53, 0x0d,
+		0xfc, 0xa2, 0x34, 0x9a, 0x30, 0xf6, 0xd3, 0x02, 0x53, 0x15, 0x90, 0x48, 0x38, 0xf6, 0xbb, 0x69,
+		0x98, 0x56, 0x48, 0xf3, 0xc7, 0x6b, 0x7c, 0x7e, 0x12, 0x80, 0xcd, 0x5c, 0x5c, 0x50, 0x4b, 0xd9,
+		0xbb, 0xb0, 0x12, 0x14, 0x18, 0x5f, 0xd5, 0xf3, 0x23, 0xb6, 0xfd, 0x7a, 0x1a, 0x8a, 0x51, 0xdb,
+		0xfe, 0x18, 0x6c, 0x30, 0xf2, 0x5a, 0xb8, 0xac, 0x64, 0xf9, 0x8f, 0xb4, 0x0e, 0x58, 0x56, 0x7a,
+		0xbc, 0xee, 0xe8, 0xf5, 0xe4, 0x07, 0x69, 0xc8, 0xd5, 0x35, 0x57, 0x6b, 0x7b, 0xf2, 0xb5, 0x9e,
+		0x48, 0x50, 0xa4, 0xeb, 0x7a, 0x7e, 0x8a, 0x9b, 0x67, 0x07, 0x98, 0xcb, 0x7d, 0xa2, 0x4f, 0x20,
+		0xf8, 0x30, 0x4c, 0xe2, 0x59, 0x33, 0x72, 0xb3, 0x9f, 0xa6, 0xf7, 0x95, 0x78, 0x58, 0x0c, 0xaf,
+		0x95, 0xf0, 0x97, 0x40, 0x50, 0x2d, 0x5c, 0x31, 0x51, 0x07, 0xda, 0xda, 0xad, 0x1a, 0x93, 0xc8,
+		0x4f, 0x81, 0xbc, 0x1f, 0x9c, 0xfe, 0xd5, 0xd0, 0x04, 0xa8, 0x37, 0x1d, 0xd6, 0x08, 0x75, 0x4c,
+		0x12, 0xda, 0x56, 0x53, 0x65, 0x6f, 0x8b, 0xb1, 0xc3, 0x52, 0x01, 0x25, 0x55, 0x14, 0xc8, 0x3f,
+		0xc3, 0x82, 0xca, 0xae, 0x63, 0x28, 0x8f, 0xe7, 0xd7, 0x8f, 0xe7, 0xa9, 0x3f, 0x78, 0x67, 0x61,
+		0xee, 0x50, 0x6b, 0x9b, 0x95, 0x72, 0x1f, 0xca, 0x32, 0x0d, 0x32, 0xe3, 0xc7, 0xd7, 0x88, 0x07,
+		0x7f, 0x26, 0x05, 0x72, 0xb8, 0x76, 0x2b, 0xc4, 0x73, 0x6c, 0xcb, 0xa3, 0xd1, 0x73, 0x24, 0xd4,
+		0x4d, 0x1d, 0x1d, 0x3d, 0x87, 0x78, 0x11, 0x3d, 0x47, 0x66, 0xc4, 0xc5, 0x70, 0xa5, 0x4c, 0xf3,
+		0x31, 0xec, 0xf3, 0xaa, 0xdf, 0x12, 0xbe, 0x5c, 0x27, 0xdc, 0x83, 0xeb, 0x07, 0xad, 0x1c, 0x29,
+		0xff, 0x6e, 0x0a, 0x4e, 0xf5, 0x78, 0x53, 0xd0, 0xd8, 0x3f, 0x05, 0xb2, 0x1b, 0xa9, 0xe4, 0xbf,
+		0xb6, 0xc7, 0x1a, 0x7d, 0x6c, 0xe7, 0x9c, 0x76, 0xbb, 0x2b, 0x3e, 0xa8, 0xc5, 0x9e, 0xbf, 0x02,
+		0xf8, 0xcf, 0x52, 0x30, 0x1b, 0x6d, 0x4c, 0xd0, 0xad, 0x4d, 0x28, 0x46, 0xdb, 0xc2, 0x3b, 0xf4,
+		0xd0, 0x30, 0x1d, 0xe2, 0x7d, 0x89, 0xe1, 0xe5, 0x97, 0xc2, 0x89, 0xcb, 0xb2, 0x4e, 0xcf, 0x0c,
+		0x6d, 0x1b, 0xd1, 0xa6, 0xee, 0x09, 0x9c, 0x15, 0xe1, 0x50, 0xb6, 0x6e, 0xdb, 0xa6, 0xfc, 0xa7,
+		0x61, 0xda, 0xb2, 0x7d, 0x15, 0xbd, 0x9c, 0x34, 0x55, 0x7e, 0x04, 0x66, 0xab, 0xdf, 0x4b, 0xc7,
+		0x33, 0xd9, 0x77, 0xdf, 0x59, 0xe8, 0xa5, 0xea, 0xb2, 0xe3, 0x94, 0x65, 0xfb, 0x2b, 0xb4, 0x7e,
+		0x9b, 0x56, 0xcb, 0x2e, 0x4c, 0xc4, 0x1f, 0xcd, 0x56, 0xcb, 0x8d, 0x63, 0x3f, 0x7a, 0xe2, 0xa8,
+		0xc7, 0x16, 0x77, 0x23, 0xcf, 0x64, 0x2f, 0x47, 0x7d, 0xff, 0xad, 0x85, 0xd4, 0xe3, 0x5f, 0x49,
+		0x01, 0x84, 0xb9, 0x00, 0x4c, 0x17, 0xaf, 0x6c, 0x6d, 0x56, 0xd5, 0xc6, 0xf6, 0xf2, 0xf6, 0x4e,
+		0x23, 0xfe, 0x0a, 0xb5, 0x48, 0x2e, 0x7b, 0x0e, 0xd1, 0xf1, 0x87, 0xb5, 0x9a, 0xf2, 0x23, 0x30,
+		0x1b, 0xd7, 0xc6, 0x12, 0xfe, 0x72, 0xe6, 0x5c, 0xf1, 0xf6, 0x9d, 0xc5, 0x3c, 0x0b, 0xb3, 0x08,
+		0x5e, 0xcd, 0x9f, 0xe8, 0xd5, 0xc3, 0xd7, 0xaf, 0xd3, 0x73, 0x13, 0xb7, 0xef, 0x2c, 0x16, 0x82,
+		0x78, 0x4c, 0x2e, 0x83, 0x1c, 0xd5, 0xe4, 0x7c, 0x99, 0x39, 0xb8, 0x7d, 0x67, 0x31, 0xc7, 0xcc,
+		0x36, 0x97, 0xc5, 0x14, 0xf2, 0xca, 0xe5, 0x81, 0xe9, 0xe3, 0x27, 0x8f, 0xb4, 0xd8, 0xad, 0x20,
+		0x25, 0x1c, 0xcb, 0x19, 0xff, 0xbf, 0x01, 0x00, 0xb0, 0x4c, 0x81, 0xc4, 0x3f, 0x66, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1865,6 +1877,9 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if !this.Balance.Equal(that1.Balance) {
 		return false
 	}
+	if this.OriginalDelegator != that1.OriginalDelegator {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntry) Equal(that interface{}) bool {
@@ -2617,6 +2632,13 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.OriginalDelegator) > 0 {
+		i -= len(m.OriginalDelegator)
+		copy(dAtA[i:], m.OriginalDelegator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OriginalDelegator)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.Balance.Size()
 		i -= size
@@ -3269,6 +3291,10 @@ func (m *UnbondingDelegationEntry) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.OriginalDelegator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -5350,6 +5376,38 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDelegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalDelegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// FlagMintTo is the flag of the account receiving the minted tokens.
+const FlagMintTo = "mint-to"
+
+// GetTxCmd returns the transaction commands for the tokenfactory module.
+func GetTxCmd() *cobra.Command {
+	tokenFactoryTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Tokenfactory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenFactoryTxCmd.AddCommand(
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewForceTransferCmd(),
+		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+	)
+
+	return tokenFactoryTxCmd
+}
+
+// NewCreateDenomCmd returns a CLI command handler for creating a MsgCreateDenom
+// transaction.
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create the denom factory/{sender}/{subdenom}, paying the denom creation fee",
+		Example: fmt.Sprintf(`%s tx %s create-denom mytoken --from mykey`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint tokens of a factory denom administrated by the sender, to the sender or to the --mint-to account",
+		Example: fmt.Sprintf(`%s tx %s mint 1000factory/cosmos1.../mytoken --mint-to cosmos1... --from mykey`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			mintTo, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress().String(), amount)
+			msg.MintToAddress = mintTo
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintTo, "", "The account receiving the minted tokens, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn tokens of a factory denom held by its admin, the sender",
+		Example: fmt.Sprintf(`%s tx %s burn 1000factory/cosmos1.../mytoken --from mykey`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewForceTransferCmd returns a CLI command handler for creating a
+// MsgForceTransfer transaction.
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [from_address] [to_address]",
+		Short: "Transfer tokens of a factory denom administrated by the sender between two accounts",
+		Long: strings.TrimSpace(`Transfer tokens of a factory denom administrated by the sender between two accounts.
+The transfer fails unless the chain enables the force transfers.
+`),
+		Example: fmt.Sprintf(`%s tx %s force-transfer 1000factory/cosmos1.../mytoken cosmos1... cosmos1... --from mykey`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(clientCtx.GetFromAddress().String(), amount, args[1], args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminCmd returns a CLI command handler for creating a
+// MsgChangeAdmin transaction.
+func NewChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new_admin]",
+		Short: "Change the admin of a factory denom administrated by the sender",
+		Long: strings.TrimSpace(`Change the admin of a factory denom administrated by the sender.
+The admin role is renounced if the new admin is an empty string, after which the
+tokens of the denom can't be minted nor burnt anymore.
+`),
+		Example: fmt.Sprintf(`%s tx %s change-admin factory/cosmos1.../mytoken cosmos1... --from mykey
+%s tx %s change-admin factory/cosmos1.../mytoken "" --from mykey`,
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataCmd returns a CLI command handler for creating a
+// MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Short: "Set the bank metadata of a factory denom administrated by the sender",
+		Long: strings.TrimSpace(`Set the bank metadata of a factory denom administrated by the sender, read
+from a JSON file. The base of the metadata is the factory denom.
+`),
+		Example: fmt.Sprintf(`%s tx %s set-denom-metadata metadata.json --from mykey
+
+Where metadata.json contains:
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/cosmos1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/cosmos1.../mytoken",
+  "display": "mytoken",
+  "name": "My token",
+  "symbol": "MTK"
+}`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress().String(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, of which the
+// creator becomes the admin, charging the denom creation fee to the creator.
+// The bank metadata of the denom is set with a single denom unit.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+	})
+	k.createFactoryDenom(ctx, creator, denom, types.DenomAuthorityMetadata{Admin: creator.String()})
+
+	return denom, ctx.EventManager().EmitTypedEvent(&types.EventCreateDenom{Denom: denom, Creator: creator.String()})
+}
+
+// createFactoryDenom stores a factory denom with its authorities and indexes it
+// by creator.
+func (k Keeper) createFactoryDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) {
+	k.setAuthorityMetadata(ctx, denom, metadata)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreatorDenomKey(creator, denom), []byte{})
+}
+
+// GetDenomsFromCreator returns the factory denoms created by an account in
+// ascending order.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.CreatorDenomsPrefix(creator)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+
+	return denoms
+}
+
+// IsAdmin returns an error unless the account is the admin of the factory
+// denom.
+func (k Keeper) IsAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != addr.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", addr, denom)
+	}
+
+	return nil
+}
+
+// Mint mints tokens of a factory denom to an account, on behalf of the admin of
+// the denom.
+func (k Keeper) Mint(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin, to sdk.AccAddress) error {
+	if err := k.IsAdmin(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		Admin:         admin.String(),
+		Amount:        amount.String(),
+		MintToAddress: to.String(),
+	})
+}
+
+// Burn burns tokens of a factory denom held by the admin of the denom.
+func (k Keeper) Burn(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.IsAdmin(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, admin, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Admin:  admin.String(),
+		Amount: amount.String(),
+	})
+}
+
+// ForceTransfer transfers tokens of a factory denom between two accounts, on
+// behalf of the admin of the denom. It fails unless the force transfers are
+// enabled by the config of the module, and the tokens of the blocked accounts,
+// such as the module accounts, can't be transferred.
+func (k Keeper) ForceTransfer(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin, from, to sdk.AccAddress) error {
+	if !k.config.EnableForceTransfer {
+		return types.ErrForceTransferDisabled
+	}
+	if err := k.IsAdmin(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if k.bankKeeper.BlockedAddr(addr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive or send funds", addr)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForceTransfer{
+		Admin:               admin.String(),
+		Amount:              amount.String(),
+		TransferFromAddress: from.String(),
+		TransferToAddress:   to.String(),
+	})
+}
+
+// ChangeAdmin changes the admin of a factory denom, on behalf of its current
+// admin. The admin role is renounced if the new admin is empty.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.IsAdmin(ctx, denom, admin); err != nil {
+		return err
+	}
+
+	metadata := types.DenomAuthorityMetadata{}
+	if !newAdmin.Empty() {
+		metadata.Admin = newAdmin.String()
+	}
+	k.setAuthorityMetadata(ctx, denom, metadata)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChangeAdmin{Denom: denom, NewAdmin: metadata.Admin})
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom, on behalf of the
+// admin of the denom.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.IsAdmin(ctx, metadata.Base, admin); err != nil {
+		return err
+	}
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSetDenomMetadata{Denom: metadata.Base})
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis new tokenfactory genesis
+func (keeper Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// create the module account with its permissions
+	keeper.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, denom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.createFactoryDenom(ctx, creator, denom.Denom, denom.AuthorityMetadata)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var denoms []types.GenesisDenom
+	keeper.IterateFactoryDenoms(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false
+	})
+
+	return types.NewGenesisState(keeper.GetParams(ctx), denoms)
+}
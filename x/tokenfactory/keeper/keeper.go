@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+
+	config types.Config
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, ak types.AccountKeeper,
+	bk types.BankKeeper, dk types.DistrKeeper, config types.Config, authority string,
+) Keeper {
+	// ensure the tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		config:        config,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/tokenfactory module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/tokenfactory module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetAuthorityMetadata returns the authorities of a factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrap(types.ErrDenomNotFound, denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, nil
+}
+
+// setAuthorityMetadata sets the authorities of a factory denom.
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// IterateFactoryDenoms iterates over the factory denoms in ascending order,
+// along with their authorities, until cb returns true.
+func (k Keeper) IterateFactoryDenoms(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomAuthorityMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		denom := string(iterator.Key()[len(types.DenomAuthorityMetadataPrefix):])
+		if cb(denom, metadata) {
+			break
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app                *runtime.App
+	ctx                sdk.Context
+	cdc                codec.Codec
+	interfaceRegistry  codectypes.InterfaceRegistry
+	accountKeeper      authkeeper.AccountKeeper
+	bankKeeper         bankkeeper.Keeper
+	distrKeeper        distrkeeper.Keeper
+	tokenFactoryKeeper keeper.Keeper
+	msgServer          types.MsgServer
+	queryClient        types.QueryClient
+
+	addrs []sdk.AccAddress
+	fee   sdk.Coins
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&s.cdc,
+		&s.interfaceRegistry,
+		&s.accountKeeper,
+		&s.bankKeeper,
+		&s.distrKeeper,
+		&s.tokenFactoryKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.interfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.tokenFactoryKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+
+	s.fee = types.DefaultParams().DenomCreationFee
+	s.addrs = simtestutil.CreateIncrementalAccounts(3)
+	for _, addr := range s.addrs {
+		s.Require().NoError(banktestutil.FundAccount(s.bankKeeper, s.ctx, addr, s.fee.Add(s.fee...)))
+	}
+}
+
+// createDenom creates the denom of subdenom mytoken administrated by the
+// given account.
+func (s *KeeperTestSuite) createDenom(creator sdk.AccAddress) string {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "mytoken"))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	creator := s.addrs[0]
+	balance := s.bankKeeper.GetAllBalances(s.ctx, creator)
+	communityPool := s.distrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom := s.createDenom(creator)
+	s.Require().Equal("factory/"+creator.String()+"/mytoken", denom)
+
+	// the fee is sent to the community pool
+	s.Require().Equal(balance.Sub(s.fee...), s.bankKeeper.GetAllBalances(s.ctx, creator))
+	s.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(s.fee...)...), s.distrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	// the creator is the admin
+	authority, err := s.queryClient.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), authority.AuthorityMetadata.Admin)
+
+	metadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(banktypes.Metadata{Base: denom, DenomUnits: []*banktypes.DenomUnit{{Denom: denom}}}, metadata)
+
+	// the denom can't be created twice
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "mytoken"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the fee is required
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "other"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "third"))
+	s.Require().ErrorContains(err, "failed to pay the denom creation fee")
+
+	// creating a denom is free without fee
+	s.Require().NoError(s.tokenFactoryKeeper.SetParams(s.ctx, types.NewParams(nil)))
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "third"))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom, "factory/" + creator.String() + "/other", "factory/" + creator.String() + "/third"}, res.Denoms)
+
+	res, err = s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Denoms)
+
+	_, err = s.queryClient.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + creator.String() + "/absent"})
+	s.Require().ErrorContains(err, "denom not found")
+}
+
+func (s *KeeperTestSuite) TestMintBurn() {
+	admin, other := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.Mint(goCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(goCtx, &types.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 50), MintToAddress: other.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), s.bankKeeper.GetBalance(s.ctx, admin, denom).Amount.Int64())
+	s.Require().Equal(int64(50), s.bankKeeper.GetBalance(s.ctx, other, denom).Amount.Int64())
+
+	// only the admin can mint and burn
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(other.String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 30)))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(70), s.bankKeeper.GetBalance(s.ctx, admin, denom).Amount.Int64())
+	s.Require().Equal(int64(120), s.bankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+
+	// the admin burns its own tokens only
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().Error(err)
+
+	// the tokens of the other denoms can't be minted
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin("factory/"+other.String()+"/mytoken", 100)))
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+}
+
+func (s *KeeperTestSuite) TestForceTransfer() {
+	admin, holder, other := s.addrs[0], s.addrs[1], s.addrs[2]
+	denom := s.createDenom(admin)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin(denom, 100), holder))
+
+	_, err := s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(denom, 40), holder.String(), other.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(60), s.bankKeeper.GetBalance(s.ctx, holder, denom).Amount.Int64())
+	s.Require().Equal(int64(40), s.bankKeeper.GetBalance(s.ctx, other, denom).Amount.Int64())
+
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(holder.String(), sdk.NewInt64Coin(denom, 40), other.String(), holder.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the module accounts are blocked
+	moduleAddr := s.accountKeeper.GetModuleAddress(types.ModuleName)
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(denom, 10), holder.String(), moduleAddr.String()))
+	s.Require().ErrorContains(err, "is not allowed to receive or send funds")
+
+	// the force transfers are disabled by default
+	k := keeper.NewKeeper(s.cdc, s.app.UnsafeFindStoreKey(types.StoreKey), s.accountKeeper, s.bankKeeper, s.distrKeeper, types.DefaultConfig(), s.tokenFactoryKeeper.GetAuthority())
+	err = k.ForceTransfer(s.ctx, admin, sdk.NewInt64Coin(denom, 10), holder, other)
+	s.Require().ErrorIs(err, types.ErrForceTransferDisabled)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	admin, newAdmin := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(admin.String(), denom, newAdmin.String()))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin(denom, 1), admin), types.ErrUnauthorized)
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, newAdmin, sdk.NewInt64Coin(denom, 1), newAdmin))
+
+	// the denom is still listed by its creator
+	s.Require().Equal([]string{denom}, s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, admin))
+
+	// the admin role can be renounced
+	_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(newAdmin.String(), denom, ""))
+	s.Require().NoError(err)
+	metadata, err := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomAuthorityMetadata{}, metadata)
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Mint(s.ctx, newAdmin, sdk.NewInt64Coin(denom, 1), newAdmin), types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	admin := s.addrs[0]
+	denom := s.createDenom(admin)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	metadata := banktypes.Metadata{
+		Description: "My token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "mytoken", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "mytoken",
+		Name:    "My token",
+		Symbol:  "MTK",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.addrs[1].String(), metadata))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().NoError(err)
+	stored, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, stored)
+
+	// the metadata of the other denoms can't be set
+	metadata.Base = sdk.DefaultBondDenom
+	metadata.DenomUnits[0].Denom = sdk.DefaultBondDenom
+	_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: s.addrs[0].String(), Params: params})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: s.tokenFactoryKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	admin := s.addrs[0]
+	denom := s.createDenom(admin)
+	s.Require().NoError(s.tokenFactoryKeeper.ChangeAdmin(s.ctx, admin, denom, s.addrs[1]))
+
+	genesis := s.tokenFactoryKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(types.DefaultParams(), genesis.Params)
+	s.Require().Equal([]types.GenesisDenom{
+		{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.addrs[1].String()}},
+	}, genesis.FactoryDenoms)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+
+	s.SetupTest()
+	s.tokenFactoryKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.tokenFactoryKeeper.ExportGenesis(s.ctx))
+	s.Require().Equal([]string{denom}, s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, admin))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/tokenfactory MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDenom creates a factory denom administrated by the sender.
+func (ms msgServer) CreateDenom(goCtx context.Context, req *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom, err := ms.Keeper.CreateDenom(ctx, sender, req.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints tokens of a factory denom to the given account, or to the sender.
+func (ms msgServer) Mint(goCtx context.Context, req *types.MsgMint) (*types.MsgMintResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	to := sender
+	if req.MintToAddress != "" {
+		if to, err = sdk.AccAddressFromBech32(req.MintToAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.Mint(ctx, sender, req.Amount, to); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns tokens of a factory denom held by the sender.
+func (ms msgServer) Burn(goCtx context.Context, req *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.Burn(ctx, sender, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ForceTransfer transfers tokens of a factory denom between two accounts.
+func (ms msgServer) ForceTransfer(goCtx context.Context, req *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	from, err := sdk.AccAddressFromBech32(req.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(req.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ForceTransfer(ctx, sender, req.Amount, from, to); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// ChangeAdmin changes the admin of a factory denom.
+func (ms msgServer) ChangeAdmin(goCtx context.Context, req *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	var newAdmin sdk.AccAddress
+	if req.NewAdmin != "" {
+		if newAdmin, err = sdk.AccAddressFromBech32(req.NewAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ChangeAdmin(ctx, sender, req.Denom, newAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom.
+func (ms msgServer) SetDenomMetadata(goCtx context.Context, req *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetDenomMetadata(ctx, sender, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// UpdateParams updates the x/tokenfactory module parameters.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,124 @@
+package testutil
+
+import (
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/module"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	_ "github.com/cosmos/cosmos-sdk/x/mint"
+	_ "github.com/cosmos/cosmos-sdk/x/params"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+	_ "github.com/cosmos/cosmos-sdk/x/tokenfactory"
+
+	"cosmossdk.io/core/appconfig"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
+	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
+	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	tokenfactorymodulev1 "cosmossdk.io/api/cosmos/tokenfactory/module/v1"
+	txmodulev1 "cosmossdk.io/api/cosmos/tx/module/v1"
+)
+
+// AppConfig is the config of an app with the tokenfactory module, in which the
+// force transfers are enabled.
+var AppConfig = appconfig.Compose(&appv1alpha1.Config{
+	Modules: []*appv1alpha1.ModuleConfig{
+		{
+			Name: "runtime",
+			Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+				AppName: "TokenFactoryApp",
+				BeginBlockers: []string{
+					minttypes.ModuleName,
+					distrtypes.ModuleName,
+					stakingtypes.ModuleName,
+					authtypes.ModuleName,
+					banktypes.ModuleName,
+					genutiltypes.ModuleName,
+					paramstypes.ModuleName,
+					types.ModuleName,
+				},
+				EndBlockers: []string{
+					stakingtypes.ModuleName,
+					authtypes.ModuleName,
+					banktypes.ModuleName,
+					distrtypes.ModuleName,
+					minttypes.ModuleName,
+					genutiltypes.ModuleName,
+					paramstypes.ModuleName,
+					types.ModuleName,
+				},
+				InitGenesis: []string{
+					authtypes.ModuleName,
+					banktypes.ModuleName,
+					distrtypes.ModuleName,
+					stakingtypes.ModuleName,
+					minttypes.ModuleName,
+					genutiltypes.ModuleName,
+					paramstypes.ModuleName,
+					types.ModuleName,
+				},
+			}),
+		},
+		{
+			Name: authtypes.ModuleName,
+			Config: appconfig.WrapAny(&authmodulev1.Module{
+				Bech32Prefix: "cosmos",
+				ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+					{Account: authtypes.FeeCollectorName},
+					{Account: distrtypes.ModuleName},
+					{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
+					{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+					{Account: types.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+				},
+			}),
+		},
+		{
+			Name:   banktypes.ModuleName,
+			Config: appconfig.WrapAny(&bankmodulev1.Module{}),
+		},
+		{
+			Name:   stakingtypes.ModuleName,
+			Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
+		},
+		{
+			Name:   paramstypes.ModuleName,
+			Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
+		},
+		{
+			Name:   "tx",
+			Config: appconfig.WrapAny(&txmodulev1.Module{}),
+		},
+		{
+			Name:   genutiltypes.ModuleName,
+			Config: appconfig.WrapAny(&genutilmodulev1.Module{}),
+		},
+		{
+			Name:   distrtypes.ModuleName,
+			Config: appconfig.WrapAny(&distrmodulev1.Module{}),
+		},
+		{
+			Name:   minttypes.ModuleName,
+			Config: appconfig.WrapAny(&mintmodulev1.Module{}),
+		},
+		{
+			Name:   types.ModuleName,
+			Config: appconfig.WrapAny(&tokenfactorymodulev1.Module{EnableForceTransfer: true}),
+		},
+	},
+})
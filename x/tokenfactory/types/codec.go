@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec
+	// so that this can later be used to properly serialize MsgGrant and MsgExec
+	// instances.
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec.
+// The messages are registered under the shorter tf prefix, the amino names
+// being limited to 39 characters.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/tf/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/tf/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/tf/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/tf/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "cosmos-sdk/tf/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/tf/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/tf/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/tf/MsgUpdateParams")
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgForceTransfer{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
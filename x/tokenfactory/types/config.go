@@ -0,0 +1,15 @@
+package types
+
+// Config is a config struct used for intialising the tokenfactory module to
+// avoid using globals.
+type Config struct {
+	// EnableForceTransfer allows the admins of the denoms to transfer their
+	// tokens from any account.
+	EnableForceTransfer bool
+}
+
+// DefaultConfig returns the default config for tokenfactory, which disables
+// force transfers.
+func DefaultConfig() Config {
+	return Config{}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the first part of the factory denoms.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory denom factory/{creator}/{subdenom} of the
+// given creator and subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom length must be between 1 and %d, got %d", MaxSubdenomLength, len(subdenom))
+	}
+	if strings.Contains(subdenom, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom %s cannot contain a slash", subdenom)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidSubdenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a factory denom, or
+// an error if the denom is not a valid factory denom.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator of denom %s: %s", denom, err)
+	}
+
+	if _, err := GetTokenDenom(parts[1], parts[2]); err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid denom %s: %s", denom, err)
+	}
+
+	return creator, parts[2], nil
+}
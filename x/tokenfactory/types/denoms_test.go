@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestDenoms(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := map[string]struct {
+		subdenom string
+		err      string
+	}{
+		"valid":              {subdenom: "mytoken"},
+		"valid symbols":      {subdenom: "my.token-1_a:b"},
+		"max length":         {subdenom: strings.Repeat("a", types.MaxSubdenomLength)},
+		"empty":              {subdenom: "", err: "subdenom length must be between 1 and 44, got 0"},
+		"too long":           {subdenom: strings.Repeat("a", types.MaxSubdenomLength+1), err: "got 45"},
+		"slash":              {subdenom: "my/token", err: "cannot contain a slash"},
+		"invalid characters": {subdenom: "my token", err: "invalid denom"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(creator, tc.subdenom)
+			if tc.err != "" {
+				require.ErrorIs(t, err, types.ErrInvalidSubdenom)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+creator+"/"+tc.subdenom, denom)
+
+			addr, subdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, addr.String())
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+
+	for _, denom := range []string{
+		"stake",
+		"factory/" + creator,
+		"other/" + creator + "/mytoken",
+		"factory/creator/mytoken",
+		"factory/" + creator + "/my/token",
+		"factory/" + creator + "/",
+	} {
+		_, _, err := types.DeconstructDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+}
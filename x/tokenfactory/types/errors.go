@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists           = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrDenomNotFound         = sdkerrors.Register(ModuleName, 3, "denom not found")
+	ErrInvalidDenom          = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrInvalidSubdenom       = sdkerrors.Register(ModuleName, 5, "invalid subdenom")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 6, "unauthorized account")
+	ErrForceTransferDisabled = sdkerrors.Register(ModuleName, 7, "force transfers are disabled")
+)
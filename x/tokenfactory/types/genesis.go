@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the authorities of a denom, the admin being either empty
+// or a valid address.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return fmt.Errorf("invalid admin address %s: %w", m.Admin, err)
+	}
+
+	return nil
+}
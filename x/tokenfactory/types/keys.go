@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key of the module parameters.
+	ParamsKey = []byte{0x00}
+
+	// DenomAuthorityMetadataPrefix is the prefix of the authorities of the
+	// factory denoms, by denom.
+	DenomAuthorityMetadataPrefix = []byte{0x01}
+
+	// CreatorDenomPrefix is the prefix of the index of the factory denoms by
+	// creator.
+	CreatorDenomPrefix = []byte{0x02}
+)
+
+// DenomAuthorityMetadataKey returns the key of the authorities of a factory
+// denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(append([]byte{}, DenomAuthorityMetadataPrefix...), denom...)
+}
+
+// CreatorDenomsPrefix returns the prefix of the factory denoms created by an
+// account.
+func CreatorDenomsPrefix(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, CreatorDenomPrefix...), address.MustLengthPrefix(creator)...)
+}
+
+// CreatorDenomKey returns the key indexing a factory denom by its creator.
+func CreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(CreatorDenomsPrefix(creator), denom...)
+}
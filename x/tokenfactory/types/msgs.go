@@ -0,0 +1,241 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateDenom message.
+func (m *MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	_, err := GetTokenDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+// NewMsgMint creates a new MsgMint instance minting to the sender.
+func NewMsgMint(sender string, amount sdk.Coin) *MsgMint {
+	return &MsgMint{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgMint message.
+func (m *MsgMint) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return sdkerrors.Wrap(err, "invalid mint to address")
+		}
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgBurn message.
+func (m *MsgBurn) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// NewMsgForceTransfer creates a new MsgForceTransfer instance.
+func NewMsgForceTransfer(sender string, amount sdk.Coin, from, to string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender,
+		Amount:              amount,
+		TransferFromAddress: from,
+		TransferToAddress:   to,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgForceTransfer message.
+func (m *MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgForceTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.TransferFromAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid transfer from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.TransferToAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid transfer to address")
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgChangeAdmin message.
+func (m *MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if m.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+			return sdkerrors.Wrap(err, "invalid new admin address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance.
+func NewMsgSetDenomMetadata(sender string, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetDenomMetadata message.
+func (m *MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if err := m.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(m.Metadata.Base)
+	return err
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// validateFactoryCoin checks that the coin is a positive amount of a factory
+// denom.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns default x/tokenfactory module parameters.
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
+	}
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	return p.DenomCreationFee.Validate()
+}